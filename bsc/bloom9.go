@@ -40,3 +40,29 @@ func (b Bloom) MarshalText() ([]byte, error) {
 func (b *Bloom) UnmarshalText(input []byte) error {
 	return UnmarshalFixedText("Bloom", input, b[:])
 }
+
+// Add adds the data, which is the address or a topic of a log, to the bloom filter.
+func (b *Bloom) Add(d []byte) {
+	i1, v1, i2, v2, i3, v3 := bloomValues(d)
+	b[i1] |= v1
+	b[i2] |= v2
+	b[i3] |= v3
+}
+
+// Test checks whether the data may be in the bloom filter, it is false only if the data is definitely not in it.
+func (b Bloom) Test(d []byte) bool {
+	i1, v1, i2, v2, i3, v3 := bloomValues(d)
+	return v1 == v1&b[i1] && v2 == v2&b[i2] && v3 == v3&b[i3]
+}
+
+// bloomValues returns the bytes (index-value pairs) to set for the given data
+func bloomValues(d []byte) (uint, byte, uint, byte, uint, byte) {
+	h := Keccak256(d)
+	v1 := byte(1 << (h[1] & 0x7))
+	v2 := byte(1 << (h[3] & 0x7))
+	v3 := byte(1 << (h[5] & 0x7))
+	i1 := BloomByteLength - uint((uint(h[0])<<8|uint(h[1]))&2047)>>3 - 1
+	i2 := BloomByteLength - uint((uint(h[2])<<8|uint(h[3]))&2047)>>3 - 1
+	i3 := BloomByteLength - uint((uint(h[4])<<8|uint(h[5]))&2047)>>3 - 1
+	return i1, v1, i2, v2, i3, v3
+}
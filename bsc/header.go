@@ -192,6 +192,33 @@ func SealHash(header *Header) (hash Hash) {
 	return hash
 }
 
+// Hash returns the block hash, which is the keccak256 hash of the RLP encoding of the whole header.
+func (h *Header) Hash() (hash Hash) {
+	hasher := sha3.NewLegacyKeccak256()
+	err := rlp.Encode(hasher, []interface{}{
+		h.ParentHash,
+		h.UncleHash,
+		h.Coinbase,
+		h.Root,
+		h.TxHash,
+		h.ReceiptHash,
+		h.Bloom,
+		big.NewInt(h.Difficulty),
+		big.NewInt(h.Number),
+		h.GasLimit,
+		h.GasUsed,
+		h.Time,
+		h.Extra,
+		h.MixDigest,
+		h.Nonce,
+	})
+	if err != nil {
+		panic("can't encode: " + err.Error())
+	}
+	hasher.Sum(hash[:0])
+	return hash
+}
+
 func encodeSigHeader(w io.Writer, header *Header) {
 	err := rlp.Encode(w, []interface{}{
 		header.ParentHash,
@@ -38,4 +38,6 @@ func TestHeader_UnmarshalJSON(t *testing.T) {
 	signer, err := h.ExtractSignerFromHeader()
 	require.NoError(t, err)
 	require.Equal(t, "0xB12fA6F899a16C156B67dBcb124d3733E72A164E", signer.String())
+
+	require.Equal(t, "14c62182b7138b45c400afccbebda3c68a78ca7a6100d3e1fe9e1e8e71ef2b66", hex.EncodeToString(h.Hash().Bytes()))
 }
@@ -0,0 +1,216 @@
+package bsc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// The header format of Parlia, the consensus engine of BSC:
+// the extra-data of a header is vanity || validators || seal, and the validators are only included in the checkpoint
+// headers, which are the headers at the height of multiples of the epoch length.
+const (
+	DefaultEpoch uint64 = 200
+
+	extraVanity = 32
+
+	// DiffInTurn is the block difficulty of the blocks signed in turn
+	DiffInTurn int64 = 2
+	// DiffNoTurn is the block difficulty of the blocks signed out of turn
+	DiffNoTurn int64 = 1
+)
+
+var (
+	errMissingVanity      = errors.New("extra-data 32 byte vanity prefix missing")
+	errExtraValidators    = errors.New("non-checkpoint block contains extra validator list")
+	errInvalidValidators  = errors.New("invalid validator list on checkpoint block")
+	errUnauthorizedSigner = errors.New("unauthorized validator")
+	errRecentlySigned     = errors.New("recently signed")
+)
+
+// RecentSigner is the validator signed the block at the height
+type RecentSigner struct {
+	Number uint64  `json:"number"`
+	Signer Address `json:"signer"`
+}
+
+// Snapshot is the state of the Parlia consensus at a header trusted by the light client
+type Snapshot struct {
+	Epoch  uint64 `json:"epoch"`
+	Number uint64 `json:"number"`
+	Hash   Hash   `json:"hash"`
+	Time   uint64 `json:"time"`
+
+	// Validators are the validators sorted by address, which take turns to sign the blocks
+	Validators []Address `json:"validators"`
+	// PendingValidators are the validators of the last checkpoint, they take effect after len(Validators)/2 blocks
+	PendingValidators []Address `json:"pending_validators"`
+	// Recents are the validators signed the recent blocks, they can not sign again in len(Validators)/2+1 blocks
+	Recents []RecentSigner `json:"recents"`
+}
+
+// ParseValidators returns the sorted validators in the extra-data of a checkpoint header
+func ParseValidators(header *Header) ([]Address, error) {
+	if len(header.Extra) < extraVanity {
+		return nil, errMissingVanity
+	}
+	if len(header.Extra) < extraVanity+extraSeal {
+		return nil, errors.New("extra-data 65 byte signature suffix missing")
+	}
+	validatorBytes := header.Extra[extraVanity : len(header.Extra)-extraSeal]
+	if len(validatorBytes) == 0 || len(validatorBytes)%AddressLength != 0 {
+		return nil, errInvalidValidators
+	}
+	validators := make([]Address, len(validatorBytes)/AddressLength)
+	for i := range validators {
+		copy(validators[i][:], validatorBytes[i*AddressLength:(i+1)*AddressLength])
+	}
+	sortAddresses(validators)
+	return validators, nil
+}
+
+// NewSnapshot creates the snapshot trusting the checkpoint header
+func NewSnapshot(checkpoint *Header, epoch uint64) (*Snapshot, error) {
+	if epoch == 0 {
+		return nil, errors.New("epoch length should be positive")
+	}
+	if checkpoint.Number < 0 || uint64(checkpoint.Number)%epoch != 0 {
+		return nil, fmt.Errorf("block %d is not a checkpoint", checkpoint.Number)
+	}
+	validators, err := ParseValidators(checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		Epoch:      epoch,
+		Number:     uint64(checkpoint.Number),
+		Hash:       checkpoint.Hash(),
+		Time:       checkpoint.Time,
+		Validators: validators,
+	}, nil
+}
+
+func (s *Snapshot) copy() *Snapshot {
+	cpy := *s
+	cpy.Validators = make([]Address, len(s.Validators))
+	copy(cpy.Validators, s.Validators)
+	if s.PendingValidators != nil {
+		cpy.PendingValidators = make([]Address, len(s.PendingValidators))
+		copy(cpy.PendingValidators, s.PendingValidators)
+	}
+	cpy.Recents = make([]RecentSigner, len(s.Recents))
+	copy(cpy.Recents, s.Recents)
+	return &cpy
+}
+
+// IsValidator returns whether the address is in the current validator set
+func (s *Snapshot) IsValidator(addr Address) bool {
+	for _, val := range s.Validators {
+		if val == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// InTurn returns whether the validator is in turn to sign the block at the height
+func (s *Snapshot) InTurn(number uint64, validator Address) bool {
+	return s.Validators[number%uint64(len(s.Validators))] == validator
+}
+
+// Apply verifies the headers following the snapshot one by one and returns the snapshot at the last header,
+// the snapshot itself is not modified.
+func (s *Snapshot) Apply(headers []*Header) (*Snapshot, error) {
+	snap := s.copy()
+	for _, header := range headers {
+		if err := snap.apply(header); err != nil {
+			return nil, err
+		}
+	}
+	return snap, nil
+}
+
+func (s *Snapshot) apply(header *Header) error {
+	if header.Number < 0 || uint64(header.Number) != s.Number+1 {
+		return fmt.Errorf("block %d is not the next block of %d", header.Number, s.Number)
+	}
+	number := uint64(header.Number)
+	if header.ParentHash != s.Hash {
+		return fmt.Errorf("the parent hash of block %d mismatches", number)
+	}
+	if header.Time <= s.Time {
+		return fmt.Errorf("the timestamp of block %d is not after its parent", number)
+	}
+	if len(header.Extra) < extraVanity {
+		return errMissingVanity
+	}
+	if len(header.Extra) < extraVanity+extraSeal {
+		return errors.New("extra-data 65 byte signature suffix missing")
+	}
+	isCheckpoint := number%s.Epoch == 0
+	if !isCheckpoint && len(header.Extra) != extraVanity+extraSeal {
+		return errExtraValidators
+	}
+
+	signer, err := header.ExtractSignerFromHeader()
+	if err != nil {
+		return err
+	}
+	if !s.IsValidator(signer) {
+		return fmt.Errorf("%s: %s at block %d", errUnauthorizedSigner.Error(), signer.String(), number)
+	}
+	limit := uint64(len(s.Validators)/2 + 1)
+	for _, recent := range s.Recents {
+		if recent.Signer == signer && recent.Number+limit > number {
+			return fmt.Errorf("%s: %s at block %d", errRecentlySigned.Error(), signer.String(), number)
+		}
+	}
+	expectedDiff := DiffNoTurn
+	if s.InTurn(number, signer) {
+		expectedDiff = DiffInTurn
+	}
+	if header.Difficulty != expectedDiff {
+		return fmt.Errorf("wrong difficulty of block %d, expected %d, got %d", number, expectedDiff, header.Difficulty)
+	}
+
+	// the validator can not sign again until it is removed from the recents
+	recents := make([]RecentSigner, 0, len(s.Recents)+1)
+	for _, recent := range s.Recents {
+		if recent.Number+limit > number {
+			recents = append(recents, recent)
+		}
+	}
+	s.Recents = append(recents, RecentSigner{Number: number, Signer: signer})
+
+	if isCheckpoint {
+		validators, err := ParseValidators(header)
+		if err != nil {
+			return err
+		}
+		s.PendingValidators = validators
+	}
+	if s.PendingValidators != nil && number%s.Epoch == uint64(len(s.Validators)/2) {
+		newLimit := uint64(len(s.PendingValidators)/2 + 1)
+		recents := make([]RecentSigner, 0, len(s.Recents))
+		for _, recent := range s.Recents {
+			if recent.Number+newLimit > number {
+				recents = append(recents, recent)
+			}
+		}
+		s.Recents = recents
+		s.Validators = s.PendingValidators
+		s.PendingValidators = nil
+	}
+
+	s.Number = number
+	s.Hash = header.Hash()
+	s.Time = header.Time
+	return nil
+}
+
+func sortAddresses(addrs []Address) {
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+}
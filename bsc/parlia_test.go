@@ -0,0 +1,161 @@
+package bsc
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+)
+
+type testValidator struct {
+	key  *btcec.PrivateKey
+	addr Address
+}
+
+func genTestValidators(t *testing.T, n int) []testValidator {
+	vals := make([]testValidator, n)
+	for i := range vals {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.NoError(t, err)
+		vals[i].key = key
+		copy(vals[i].addr[:], Keccak256(key.PubKey().SerializeUncompressed()[1:])[12:])
+	}
+	return vals
+}
+
+func genTestHeader(t *testing.T, parent *Header, signer testValidator, difficulty int64, validators []testValidator) *Header {
+	header := &Header{
+		Number:     0,
+		Difficulty: difficulty,
+		Time:       1600000000,
+	}
+	if parent != nil {
+		header.Number = parent.Number + 1
+		header.ParentHash = parent.Hash()
+		header.Time = parent.Time + 3
+	}
+	extra := make([]byte, extraVanity)
+	for _, val := range validators {
+		extra = append(extra, val.addr[:]...)
+	}
+	header.Extra = append(extra, make([]byte, extraSeal)...)
+
+	sig, err := btcec.SignCompact(btcec.S256(), signer.key, SealHash(header).Bytes(), false)
+	require.NoError(t, err)
+	// convert the [v || r || s] of btcec to the [r || s || v] of ethereum
+	copy(header.Extra[len(header.Extra)-extraSeal:], append(sig[1:], sig[0]-27))
+	return header
+}
+
+// genInTurnHeader generates the next header signed in turn by the sorted validators
+func genInTurnHeader(t *testing.T, parent *Header, sorted []testValidator, epoch uint64, checkpointVals []testValidator) *Header {
+	number := uint64(parent.Number + 1)
+	signer := sorted[number%uint64(len(sorted))]
+	var vals []testValidator
+	if number%epoch == 0 {
+		vals = checkpointVals
+	}
+	return genTestHeader(t, parent, signer, DiffInTurn, vals)
+}
+
+func sortTestValidators(vals []testValidator) []testValidator {
+	addrs := make([]Address, len(vals))
+	for i, val := range vals {
+		addrs[i] = val.addr
+	}
+	sortAddresses(addrs)
+	sorted := make([]testValidator, 0, len(vals))
+	for _, addr := range addrs {
+		for _, val := range vals {
+			if val.addr == addr {
+				sorted = append(sorted, val)
+			}
+		}
+	}
+	return sorted
+}
+
+func TestSnapshotApply(t *testing.T) {
+	vals := sortTestValidators(genTestValidators(t, 3))
+	genesis := genTestHeader(t, nil, vals[0], DiffInTurn, vals)
+
+	_, err := NewSnapshot(genTestHeader(t, genesis, vals[1], DiffInTurn, nil), DefaultEpoch)
+	require.Error(t, err)
+
+	snap, err := NewSnapshot(genesis, DefaultEpoch)
+	require.NoError(t, err)
+	require.Len(t, snap.Validators, 3)
+	require.Equal(t, genesis.Hash(), snap.Hash)
+
+	headers := []*Header{genInTurnHeader(t, genesis, vals, DefaultEpoch, nil)}
+	for i := 0; i < 4; i++ {
+		headers = append(headers, genInTurnHeader(t, headers[len(headers)-1], vals, DefaultEpoch, nil))
+	}
+	newSnap, err := snap.Apply(headers)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, newSnap.Number)
+	require.Equal(t, headers[4].Hash(), newSnap.Hash)
+	// the snapshot applied is not changed
+	require.EqualValues(t, 0, snap.Number)
+
+	// not linked to the snapshot
+	_, err = snap.Apply(headers[1:])
+	require.Error(t, err)
+
+	// the block signed out of turn should have the no turn difficulty
+	_, err = snap.Apply([]*Header{genTestHeader(t, genesis, vals[2], DiffInTurn, nil)})
+	require.Error(t, err)
+	outOfTurn := genTestHeader(t, genesis, vals[2], DiffNoTurn, nil)
+	_, err = snap.Apply([]*Header{outOfTurn})
+	require.NoError(t, err)
+
+	// vals[2] can not sign the next block again
+	_, err = snap.Apply([]*Header{outOfTurn, genTestHeader(t, outOfTurn, vals[2], DiffInTurn, nil)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), errRecentlySigned.Error())
+
+	// unauthorized signer
+	outsider := genTestValidators(t, 1)[0]
+	_, err = snap.Apply([]*Header{genTestHeader(t, genesis, outsider, DiffNoTurn, nil)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), errUnauthorizedSigner.Error())
+
+	// non-checkpoint block with validators
+	_, err = snap.Apply([]*Header{genTestHeader(t, genesis, vals[1], DiffInTurn, vals)})
+	require.Equal(t, errExtraValidators, err)
+}
+
+func TestSnapshotValidatorsChange(t *testing.T) {
+	var epoch uint64 = 4
+	vals := sortTestValidators(genTestValidators(t, 3))
+	added := genTestValidators(t, 1)[0]
+	newVals := sortTestValidators(append([]testValidator{added}, vals...))
+	genesis := genTestHeader(t, nil, vals[0], DiffInTurn, vals)
+	snap, err := NewSnapshot(genesis, epoch)
+	require.NoError(t, err)
+
+	parent := genesis
+	for i := 0; i < 4; i++ {
+		header := genInTurnHeader(t, parent, vals, epoch, newVals)
+		snap, err = snap.Apply([]*Header{header})
+		require.NoError(t, err)
+		parent = header
+	}
+	// the checkpoint at 4 includes the new validators, which take effect after len(vals)/2 blocks
+	require.Len(t, snap.Validators, 3)
+	require.Len(t, snap.PendingValidators, 4)
+
+	header := genInTurnHeader(t, parent, vals, epoch, nil)
+	snap, err = snap.Apply([]*Header{header})
+	require.NoError(t, err)
+	require.Len(t, snap.Validators, 4)
+	require.Nil(t, snap.PendingValidators)
+
+	// the added validator can sign blocks from now on
+	diff := DiffNoTurn
+	if snap.InTurn(6, added.addr) {
+		diff = DiffInTurn
+	}
+	_, err = snap.Apply([]*Header{genTestHeader(t, header, added, diff, nil)})
+	require.NoError(t, err)
+}
@@ -0,0 +1,136 @@
+package bsc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+)
+
+const (
+	// ReceiptStatusFailed is the status of the receipt of a failed transaction
+	ReceiptStatusFailed = uint64(0)
+	// ReceiptStatusSuccessful is the status of the receipt of a successful transaction
+	ReceiptStatusSuccessful = uint64(1)
+)
+
+// Log is the event emitted by a contract in a transaction
+type Log struct {
+	Address Address
+	Topics  []Hash
+	Data    []byte
+}
+
+// Receipt is the receipt of a transaction in the consensus encoding, which is the value in the receipt trie
+type Receipt struct {
+	// Type is 0 for the legacy transactions, or the type of the typed transactions of EIP-2718
+	Type              uint8
+	PostStateOrStatus []byte
+	CumulativeGasUsed uint64
+	Bloom             Bloom
+	Logs              []Log
+}
+
+type receiptRLP struct {
+	PostStateOrStatus []byte
+	CumulativeGasUsed uint64
+	Bloom             Bloom
+	Logs              []Log
+}
+
+// DecodeReceipt decodes the receipt in the consensus encoding, and checks its logs against its bloom
+func DecodeReceipt(bz []byte) (*Receipt, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty receipt")
+	}
+	receipt := &Receipt{}
+	// the typed receipt is the type byte followed by the RLP encoding, and the RLP encoding of the legacy receipt is a list
+	if bz[0] <= 0x7f {
+		receipt.Type = bz[0]
+		bz = bz[1:]
+	}
+	var dec receiptRLP
+	if err := rlp.DecodeBytes(bz, &dec); err != nil {
+		return nil, err
+	}
+	receipt.PostStateOrStatus = dec.PostStateOrStatus
+	receipt.CumulativeGasUsed = dec.CumulativeGasUsed
+	receipt.Bloom = dec.Bloom
+	receipt.Logs = dec.Logs
+
+	for i, log := range receipt.Logs {
+		if !receipt.Bloom.Test(log.Address[:]) {
+			return nil, fmt.Errorf("address of log %d is not in the bloom", i)
+		}
+		for _, topic := range log.Topics {
+			if !receipt.Bloom.Test(topic[:]) {
+				return nil, fmt.Errorf("topic of log %d is not in the bloom", i)
+			}
+		}
+	}
+	return receipt, nil
+}
+
+// Succeeded returns whether the transaction succeeded, the receipts before Byzantium have the post state
+// instead of the status and are regarded successful
+func (r *Receipt) Succeeded() bool {
+	if len(r.PostStateOrStatus) == HashLength {
+		return true
+	}
+	return len(r.PostStateOrStatus) == 1 && uint64(r.PostStateOrStatus[0]) == ReceiptStatusSuccessful
+}
+
+// ReceiptKey returns the key of the receipt of the transaction in the receipt trie
+func ReceiptKey(txIndex uint64) []byte {
+	key, err := rlp.EncodeToBytes(txIndex)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// VerifyReceiptProof returns the receipt of the transaction proved against the receipt root of a header
+func VerifyReceiptProof(receiptRoot Hash, txIndex uint64, db ProofDB) (*Receipt, error) {
+	value, err := VerifyProof(receiptRoot, ReceiptKey(txIndex), db)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("receipt of transaction %d is absent", txIndex)
+	}
+	return DecodeReceipt(value)
+}
+
+// VerifyReceiptsProof returns all the receipts of a block of txCount transactions, the ProofDB should prove the
+// receipts of the transactions and the absence of the receipt following them, so no receipt is omitted
+func VerifyReceiptsProof(receiptRoot Hash, txCount uint64, db ProofDB) ([]*Receipt, error) {
+	// txCount is not trusted, the receipts are not preallocated
+	var receipts []*Receipt
+	for i := uint64(0); i < txCount; i++ {
+		receipt, err := VerifyReceiptProof(receiptRoot, i, db)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	value, err := VerifyProof(receiptRoot, ReceiptKey(txCount), db)
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		return nil, fmt.Errorf("the block has more than %d transactions", txCount)
+	}
+	return receipts, nil
+}
+
+// VerifyLogProof returns the log of the transaction proved against the receipt root of a header
+func VerifyLogProof(receiptRoot Hash, txIndex uint64, logIndex uint64, db ProofDB) (*Log, error) {
+	receipt, err := VerifyReceiptProof(receiptRoot, txIndex, db)
+	if err != nil {
+		return nil, err
+	}
+	if logIndex >= uint64(len(receipt.Logs)) {
+		return nil, fmt.Errorf("transaction %d has only %d logs", txIndex, len(receipt.Logs))
+	}
+	return &receipt.Logs[logIndex], nil
+}
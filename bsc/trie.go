@@ -0,0 +1,153 @@
+package bsc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+)
+
+// EmptyRootHash is the root hash of an empty Merkle-Patricia trie
+var EmptyRootHash = BytesToHash(Keccak256(rlp.EmptyString))
+
+// ProofDB is the set of the Merkle-Patricia trie nodes of proofs, keyed by the keccak256 hash of the RLP encoding of the node.
+// The nodes of several proofs against the same root can share one ProofDB.
+type ProofDB map[Hash][]byte
+
+func NewProofDB(nodes [][]byte) ProofDB {
+	db := make(ProofDB, len(nodes))
+	for _, node := range nodes {
+		db[BytesToHash(Keccak256(node))] = node
+	}
+	return db
+}
+
+// VerifyProof returns the value of the key in the trie of the root hash proved by the nodes in the ProofDB.
+// The value is nil if the nodes prove the key is absent in the trie.
+func VerifyProof(root Hash, key []byte, db ProofDB) ([]byte, error) {
+	if root == EmptyRootHash {
+		return nil, nil
+	}
+	nibbles := keybytesToHex(key)
+	node, ok := db[root]
+	if !ok {
+		return nil, fmt.Errorf("bad proof node 0: missing node %x", root.Bytes())
+	}
+	for depth := 0; ; depth++ {
+		elems, _, err := rlp.SplitList(node)
+		if err != nil {
+			return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+		}
+		count, err := rlp.CountValues(elems)
+		if err != nil {
+			return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+		}
+
+		switch count {
+		case 2:
+			compactKey, rest, err := rlp.SplitString(elems)
+			if err != nil {
+				return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+			}
+			keyNibbles, isLeaf := compactToHex(compactKey)
+			if isLeaf {
+				if !bytes.Equal(keyNibbles, nibbles) {
+					return nil, nil
+				}
+				value, _, err := rlp.SplitString(rest)
+				if err != nil {
+					return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+				}
+				return value, nil
+			}
+			if len(nibbles) < len(keyNibbles) || !bytes.Equal(keyNibbles, nibbles[:len(keyNibbles)]) {
+				return nil, nil
+			}
+			nibbles = nibbles[len(keyNibbles):]
+			node, err = resolveChild(rest, db)
+			if err != nil {
+				return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+			}
+		case 17:
+			children := elems
+			if len(nibbles) == 0 {
+				// the value is in the 17th element
+				for i := 0; i < 16; i++ {
+					if _, _, children, err = rlp.Split(children); err != nil {
+						return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+					}
+				}
+				value, _, err := rlp.SplitString(children)
+				if err != nil {
+					return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+				}
+				if len(value) == 0 {
+					return nil, nil
+				}
+				return value, nil
+			}
+			for i := byte(0); i < nibbles[0]; i++ {
+				if _, _, children, err = rlp.Split(children); err != nil {
+					return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+				}
+			}
+			nibbles = nibbles[1:]
+			node, err = resolveChild(children, db)
+			if err != nil {
+				return nil, fmt.Errorf("bad proof node %d: %s", depth, err.Error())
+			}
+		default:
+			return nil, fmt.Errorf("bad proof node %d: invalid number of list elements: %d", depth, count)
+		}
+		if node == nil {
+			return nil, nil
+		}
+	}
+}
+
+// resolveChild returns the RLP encoding of the child node referred by the first RLP element, which is either the hash
+// of the child or the child itself if it is embedded in the parent. It returns nil if the child is empty.
+func resolveChild(b []byte, db ProofDB) ([]byte, error) {
+	kind, content, rest, err := rlp.Split(b)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case kind == rlp.List:
+		return b[:len(b)-len(rest)], nil
+	case len(content) == 0:
+		return nil, nil
+	case len(content) == HashLength:
+		node, ok := db[BytesToHash(content)]
+		if !ok {
+			return nil, fmt.Errorf("missing node %x", content)
+		}
+		return node, nil
+	default:
+		return nil, errors.New("invalid node reference")
+	}
+}
+
+func keybytesToHex(str []byte) []byte {
+	nibbles := make([]byte, len(str)*2)
+	for i, b := range str {
+		nibbles[i*2] = b / 16
+		nibbles[i*2+1] = b % 16
+	}
+	return nibbles
+}
+
+// compactToHex decodes the hex prefix encoding of the key of a short node
+func compactToHex(compact []byte) ([]byte, bool) {
+	if len(compact) == 0 {
+		return nil, false
+	}
+	nibbles := keybytesToHex(compact)
+	isLeaf := nibbles[0] >= 2
+	// the odd flag keeps the first nibble of the key
+	if nibbles[0]&1 == 1 {
+		return nibbles[1:], isLeaf
+	}
+	return nibbles[2:], isLeaf
+}
@@ -0,0 +1,228 @@
+package bsc
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+)
+
+// testTrie is a minimal Merkle-Patricia trie to generate the proofs, all the hashed nodes are kept in the ProofDB
+type testTrie struct {
+	root interface{}
+}
+
+type testShortNode struct {
+	key []byte // nibbles with the terminator 16 if it is a leaf
+	val interface{}
+}
+
+type testFullNode struct {
+	children [17]interface{}
+}
+
+func (t *testTrie) Update(key, value []byte) {
+	t.root = testTrieInsert(t.root, append(keybytesToHex(key), 16), value)
+}
+
+func testTrieInsert(n interface{}, key []byte, value []byte) interface{} {
+	if len(key) == 0 {
+		return value
+	}
+	switch n := n.(type) {
+	case *testShortNode:
+		match := 0
+		for match < len(key) && match < len(n.key) && key[match] == n.key[match] {
+			match++
+		}
+		if match == len(n.key) {
+			return &testShortNode{n.key, testTrieInsert(n.val, key[match:], value)}
+		}
+		branch := &testFullNode{}
+		if match+1 == len(n.key) {
+			branch.children[n.key[match]] = n.val
+		} else {
+			branch.children[n.key[match]] = &testShortNode{n.key[match+1:], n.val}
+		}
+		branch.children[key[match]] = testTrieInsert(nil, key[match+1:], value)
+		if match == 0 {
+			return branch
+		}
+		return &testShortNode{key[:match], branch}
+	case *testFullNode:
+		n.children[key[0]] = testTrieInsert(n.children[key[0]], key[1:], value)
+		return n
+	case nil:
+		return &testShortNode{key, value}
+	default:
+		panic("unexpected node")
+	}
+}
+
+func hexToCompact(hex []byte) []byte {
+	terminator := byte(0)
+	if len(hex) > 0 && hex[len(hex)-1] == 16 {
+		terminator = 1
+		hex = hex[:len(hex)-1]
+	}
+	buf := make([]byte, len(hex)/2+1)
+	buf[0] = terminator << 5
+	if len(hex)&1 == 1 {
+		buf[0] |= 1 << 4
+		buf[0] |= hex[0]
+		hex = hex[1:]
+	}
+	for i := 0; i < len(hex); i += 2 {
+		buf[i/2+1] = hex[i]<<4 | hex[i+1]
+	}
+	return buf
+}
+
+func (t *testTrie) encode(n interface{}, db ProofDB) []byte {
+	var val interface{}
+	switch n := n.(type) {
+	case *testShortNode:
+		val = []interface{}{hexToCompact(n.key), t.ref(n.val, db)}
+	case *testFullNode:
+		children := make([]interface{}, 17)
+		for i, child := range n.children {
+			children[i] = t.ref(child, db)
+		}
+		val = children
+	default:
+		panic("unexpected node")
+	}
+	bz, err := rlp.EncodeToBytes(val)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ref returns the reference of the child node in the parent, the node is embedded if its encoding is shorter than a hash
+func (t *testTrie) ref(n interface{}, db ProofDB) interface{} {
+	switch n := n.(type) {
+	case nil:
+		return []byte{}
+	case []byte:
+		return n
+	}
+	bz := t.encode(n, db)
+	if len(bz) < HashLength {
+		return rlp.RawValue(bz)
+	}
+	hash := BytesToHash(Keccak256(bz))
+	db[hash] = bz
+	return hash.Bytes()
+}
+
+// Commit returns the root hash and all the nodes
+func (t *testTrie) Commit() (Hash, ProofDB) {
+	db := make(ProofDB)
+	if t.root == nil {
+		return EmptyRootHash, db
+	}
+	bz := t.encode(t.root, db)
+	hash := BytesToHash(Keccak256(bz))
+	db[hash] = bz
+	return hash, db
+}
+
+func TestVerifyProof(t *testing.T) {
+	trie := &testTrie{}
+	root, _ := trie.Commit()
+	require.Equal(t, "56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421", hex.EncodeToString(root.Bytes()))
+
+	values := map[string]string{"doe": "reindeer", "dog": "puppy", "dogglesworth": "cat"}
+	for k, v := range values {
+		trie.Update([]byte(k), []byte(v))
+	}
+	root, db := trie.Commit()
+	// the root of the trie in the go-ethereum tests
+	require.Equal(t, "8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3", hex.EncodeToString(root.Bytes()))
+
+	for k, v := range values {
+		value, err := VerifyProof(root, []byte(k), db)
+		require.NoError(t, err)
+		require.Equal(t, v, string(value))
+	}
+	for _, k := range []string{"do", "dogs", "cat", ""} {
+		value, err := VerifyProof(root, []byte(k), db)
+		require.NoError(t, err)
+		require.Nil(t, value)
+	}
+
+	// missing nodes
+	delete(db, root)
+	_, err := VerifyProof(root, []byte("dog"), db)
+	require.Error(t, err)
+}
+
+func genTestReceipts(count int, contract Address) [][]byte {
+	receipts := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		r := receiptRLP{
+			PostStateOrStatus: []byte{1},
+			CumulativeGasUsed: uint64(21000 * (i + 1)),
+		}
+		if i%2 == 1 {
+			log := Log{Address: contract, Topics: []Hash{BytesToHash(Keccak256([]byte("event")))}, Data: []byte{byte(i)}}
+			r.Logs = []Log{log}
+			r.Bloom.Add(log.Address[:])
+			r.Bloom.Add(log.Topics[0][:])
+		}
+		bz, err := rlp.EncodeToBytes(r)
+		if err != nil {
+			panic(err)
+		}
+		receipts = append(receipts, bz)
+	}
+	return receipts
+}
+
+func TestVerifyReceiptsProof(t *testing.T) {
+	contract := Address{0x20, 0x00}
+	receipts := genTestReceipts(130, contract)
+	trie := &testTrie{}
+	for i, receipt := range receipts {
+		trie.Update(ReceiptKey(uint64(i)), receipt)
+	}
+	root, db := trie.Commit()
+
+	all, err := VerifyReceiptsProof(root, uint64(len(receipts)), db)
+	require.NoError(t, err)
+	require.Len(t, all, len(receipts))
+	require.True(t, all[0].Succeeded())
+	require.EqualValues(t, 21000, all[0].CumulativeGasUsed)
+
+	// the receipts are not complete
+	_, err = VerifyReceiptsProof(root, uint64(len(receipts)-1), db)
+	require.Error(t, err)
+	_, err = VerifyReceiptsProof(root, uint64(len(receipts)+1), db)
+	require.Error(t, err)
+
+	log, err := VerifyLogProof(root, 7, 0, db)
+	require.NoError(t, err)
+	require.Equal(t, contract, log.Address)
+	require.Equal(t, []byte{7}, log.Data)
+	require.True(t, all[7].Bloom.Test(contract[:]))
+	require.False(t, all[6].Bloom.Test(contract[:]))
+
+	_, err = VerifyLogProof(root, 6, 0, db)
+	require.Error(t, err)
+
+	// the receipt whose logs are not in its bloom is rejected
+	bad := receiptRLP{PostStateOrStatus: []byte{1}, Logs: []Log{{Address: contract}}}
+	bz, err := rlp.EncodeToBytes(bad)
+	require.NoError(t, err)
+	_, err = DecodeReceipt(bz)
+	require.Error(t, err)
+
+	// typed receipt
+	typed, err := DecodeReceipt(append([]byte{2}, receipts[1]...))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, typed.Type)
+	require.Len(t, typed.Logs, 1)
+}
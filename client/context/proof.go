@@ -0,0 +1,104 @@
+package context
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/store"
+)
+
+// StoreValueProof is a value of a store together with the IAVL existence proof and the multistore proof,
+// which proves the value against the AppHash of the block at Height+1.
+type StoreValueProof struct {
+	Height    int64         `json:"height"`
+	StoreName string        `json:"store_name"`
+	Key       cmn.HexBytes  `json:"key"`
+	Value     cmn.HexBytes  `json:"value"`
+	Proof     *merkle.Proof `json:"proof"`
+}
+
+// QueryStoreWithProof queries the value of a key in a store together with its proof at the height of the
+// context, or the latest height if it is not set. The proof is not verified, use VerifyStoreValueProof or
+// VerifyStoreValueProofWithAppHash to verify it.
+func (ctx CLIContext) QueryStoreWithProof(key cmn.HexBytes, storeName string) (StoreValueProof, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return StoreValueProof{}, err
+	}
+
+	opts := rpcclient.ABCIQueryOptions{
+		Height: ctx.Height,
+		Prove:  true,
+	}
+	result, err := node.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", storeName), key, opts)
+	if err != nil {
+		return StoreValueProof{}, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return StoreValueProof{}, errors.Errorf(resp.Log)
+	}
+	if len(resp.Value) == 0 {
+		return StoreValueProof{}, errors.Errorf("key %X not found in store %s at height %d", []byte(key), storeName, resp.Height)
+	}
+	if resp.Proof == nil {
+		return StoreValueProof{}, errors.Errorf("no proof returned for key %X in store %s", []byte(key), storeName)
+	}
+
+	return StoreValueProof{
+		Height:    resp.Height,
+		StoreName: storeName,
+		Key:       key,
+		Value:     resp.Value,
+		Proof:     resp.Proof,
+	}, nil
+}
+
+// VerifyStoreValueProof verifies the proof against the AppHash of the certified header at Height+1,
+// a valid certifier is required.
+func (ctx CLIContext) VerifyStoreValueProof(proof StoreValueProof) error {
+	if ctx.Verifier == nil {
+		return fmt.Errorf("missing valid certifier to verify data from distrusted node")
+	}
+
+	// the AppHash for height H is in header H+1
+	commit, err := ctx.Verify(proof.Height + 1)
+	if err != nil {
+		return err
+	}
+	return VerifyStoreValueProofWithAppHash(proof, commit.Header.AppHash)
+}
+
+// VerifyStoreValueProofWithAppHash verifies the proof against the given AppHash, it is up to the caller to
+// make sure the AppHash comes from a trusted header. A nil value is verified as an absence proof.
+func VerifyStoreValueProofWithAppHash(proof StoreValueProof, appHash []byte) error {
+	if proof.Proof == nil {
+		return errors.New("proof is empty")
+	}
+
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(proof.StoreName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(proof.Key, merkle.KeyEncodingURL)
+
+	// TODO: Instead of reconstructing, stash on CLIContext field?
+	prt := store.DefaultProofRuntime()
+
+	if proof.Value == nil {
+		err := prt.VerifyAbsence(proof.Proof, appHash, kp.String())
+		if err != nil {
+			return errors.Wrap(err, "failed to prove merkle absence proof")
+		}
+		return nil
+	}
+
+	err := prt.VerifyValue(proof.Proof, appHash, kp.String(), proof.Value)
+	if err != nil {
+		return errors.Wrap(err, "failed to prove merkle existence proof")
+	}
+	return nil
+}
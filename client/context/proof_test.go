@@ -0,0 +1,58 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVerifyStoreValueProofWithAppHash(t *testing.T) {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ibcKey := sdk.NewKVStoreKey("ibc")
+	ms.MountStoreWithDB(ibcKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key := []byte{0x00, 0x00, 0x01, 0x00, 0x02, 0x08, 0, 0, 0, 0, 0, 0, 0, 0x0a}
+	value := []byte("package")
+	ms.GetCommitKVStore(ibcKey).Set(key, value)
+	cid := ms.Commit()
+
+	res := ms.Query(abci.RequestQuery{
+		Path:  "/ibc/key",
+		Data:  key,
+		Prove: true,
+	})
+	require.True(t, res.IsOK())
+
+	proof := StoreValueProof{
+		Height:    res.Height,
+		StoreName: "ibc",
+		Key:       key,
+		Value:     res.Value,
+		Proof:     res.Proof,
+	}
+	require.NoError(t, VerifyStoreValueProofWithAppHash(proof, cid.Hash))
+
+	// tampered value
+	bad := proof
+	bad.Value = []byte("forged")
+	require.Error(t, VerifyStoreValueProofWithAppHash(bad, cid.Hash))
+
+	// wrong store
+	bad = proof
+	bad.StoreName = "acc"
+	require.Error(t, VerifyStoreValueProofWithAppHash(bad, cid.Hash))
+
+	// wrong app hash
+	require.Error(t, VerifyStoreValueProofWithAppHash(proof, []byte("apphash")))
+
+	// missing proof
+	bad = proof
+	bad.Proof = nil
+	require.Error(t, VerifyStoreValueProofWithAppHash(bad, cid.Hash))
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto/merkle"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	tmliteErr "github.com/tendermint/tendermint/lite/errors"
 	tmliteProxy "github.com/tendermint/tendermint/lite/proxy"
@@ -205,43 +204,19 @@ func (ctx CLIContext) Verify(height int64) (tmtypes.SignedHeader, error) {
 
 // verifyProof perform response proof verification.
 func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) error {
-	if ctx.Verifier == nil {
-		return fmt.Errorf("missing valid certifier to verify data from distrusted node")
-	}
-
-	// the AppHash for height H is in header H+1
-	commit, err := ctx.Verify(resp.Height + 1)
-	if err != nil {
-		return err
-	}
-
-	// TODO: Instead of reconstructing, stash on CLIContext field?
-	prt := store.DefaultProofRuntime()
-
 	// TODO: Better convention for path?
 	storeName, err := parseQueryStorePath(queryPath)
 	if err != nil {
 		return err
 	}
 
-	kp := merkle.KeyPath{}
-	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
-
-	if resp.Value == nil {
-		err = prt.VerifyAbsence(resp.Proof, commit.Header.AppHash, kp.String())
-		if err != nil {
-			return errors.Wrap(err, "failed to prove merkle absence proof")
-		}
-		return nil
-	}
-
-	err = prt.VerifyValue(resp.Proof, commit.Header.AppHash, kp.String(), resp.Value)
-	if err != nil {
-		return errors.Wrap(err, "failed to prove merkle existence proof")
-	}
-
-	return nil
+	return ctx.VerifyStoreValueProof(StoreValueProof{
+		Height:    resp.Height,
+		StoreName: storeName,
+		Key:       resp.Key,
+		Value:     resp.Value,
+		Proof:     resp.Proof,
+	})
 }
 
 // queryStore performs a query from a Tendermint node with the provided a store
@@ -27,6 +27,10 @@ const (
 	FlagOffline        = "offline"
 	FlagGenerateOnly   = "generate-only"
 	FlagIndentResponse = "indent"
+
+	FlagPageLimit   = "limit"
+	FlagPageKey     = "page-key"
+	FlagPageReverse = "reverse"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -81,3 +85,13 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	}
 	return cmds
 }
+
+// PaginationFlags adds the flags of the paginated queries to the query commands
+func PaginationFlags(cmds ...*cobra.Command) []*cobra.Command {
+	for _, c := range cmds {
+		c.Flags().Int(FlagPageLimit, 0, "query a page of at most limit entries instead of all the entries")
+		c.Flags().String(FlagPageKey, "", "hex encoded key to start the page from, which is the next_key of the previous page")
+		c.Flags().Bool(FlagPageReverse, false, "query the page in the reverse order")
+	}
+	return cmds
+}
@@ -201,7 +201,7 @@ func InitializeTestLCD(
 	privVal.Reset()
 
 	db := dbm.NewMemDB()
-	app := gapp.NewGaiaApp(logger, db, nil)
+	app := gapp.NewGaiaApp(logger, db, nil, 0)
 	cdc = gapp.MakeCodec()
 
 	genesisFile := config.GenesisFile()
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
@@ -19,6 +20,10 @@ import (
 const (
 	queryArgDryRun       = "simulate"
 	queryArgGenerateOnly = "generate_only"
+
+	queryArgPageLimit   = "limit"
+	queryArgPageKey     = "page_key"
+	queryArgPageReverse = "reverse"
 )
 
 //----------------------------------------
@@ -77,6 +82,30 @@ func ParseFloat64OrReturnBadRequest(w http.ResponseWriter, s string, defaultIfEm
 	return n, true
 }
 
+// ParsePageRequestOrReturnBadRequest reads the page of a paginated query from the URL's query parameters "limit",
+// "page_key", which is hex encoded, and "reverse". paginated is false if none of them are set.
+func ParsePageRequestOrReturnBadRequest(w http.ResponseWriter, r *http.Request) (page sdk.PageRequest, paginated bool, ok bool) {
+	query := r.URL.Query()
+	if limit := query.Get(queryArgPageLimit); len(limit) != 0 {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("'%s' is not a valid page limit", limit))
+			return page, false, false
+		}
+		page.Limit = n
+	}
+	if key := query.Get(queryArgPageKey); len(key) != 0 {
+		bz, err := hex.DecodeString(key)
+		if err != nil {
+			WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("'%s' is not a valid page key", key))
+			return page, false, false
+		}
+		page.Key = bz
+	}
+	page.Reverse = urlQueryHasArg(r.URL, queryArgPageReverse)
+	return page, page.Limit > 0 || len(page.Key) != 0 || page.Reverse, true
+}
+
 // WriteGenerateStdTxResponse writes response for the generate_only mode.
 func WriteGenerateStdTxResponse(w http.ResponseWriter, txBldr authtxb.TxBuilder, msgs []sdk.Msg) {
 	stdMsg, err := txBldr.Build(msgs)
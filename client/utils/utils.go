@@ -244,3 +244,16 @@ func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
 	}
 	return false
 }
+
+// ReadPageRequest reads the page of a paginated query from the pagination flags. paginated is false if none of the
+// flags are set, in which case the query is expected to return all the entries.
+func ReadPageRequest() (page sdk.PageRequest, paginated bool, err error) {
+	page.Limit = viper.GetInt(client.FlagPageLimit)
+	page.Reverse = viper.GetBool(client.FlagPageReverse)
+	if key := viper.GetString(client.FlagPageKey); len(key) != 0 {
+		if page.Key, err = hex.DecodeString(key); err != nil {
+			return page, false, fmt.Errorf("invalid page key %s: %v", key, err)
+		}
+	}
+	return page, page.Limit > 0 || len(page.Key) != 0 || page.Reverse, nil
+}
@@ -18,10 +18,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/sidechain"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -63,11 +65,13 @@ type GaiaApp struct {
 	tkeyParams       *sdk.TransientStoreKey
 	keyIbc           *sdk.KVStoreKey
 	keySide          *sdk.KVStoreKey
+	keySupply        *sdk.KVStoreKey
 
 	// Manage getting and setting accounts
 	accountKeeper       auth.AccountKeeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
 	bankKeeper          bank.Keeper
+	supplyKeeper        bank.SupplyKeeper
 	stakeKeeper         stake.Keeper
 	slashingKeeper      slashing.Keeper
 	mintKeeper          mint.Keeper
@@ -75,10 +79,13 @@ type GaiaApp struct {
 	govKeeper           gov.Keeper
 	paramsKeeper        params.Keeper
 	ibcKeeper           ibc.Keeper
+	crisisKeeper        crisis.Keeper
 }
 
 // NewGaiaApp returns a reference to an initialized GaiaApp.
-func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptions ...func(*bam.BaseApp)) *GaiaApp {
+// The registered invariants are asserted every invCheckPeriod blocks, 0 disables the periodic checks.
+func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, invCheckPeriod int64,
+	baseAppOptions ...func(*bam.BaseApp)) *GaiaApp {
 	cdc := MakeCodec()
 
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), sdk.CollectConfig{}, baseAppOptions...)
@@ -102,6 +109,7 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		tkeyParams:       sdk.NewTransientStoreKey("transient_params"),
 		keyIbc:           sdk.NewKVStoreKey("ibc"),
 		keySide:          sdk.NewKVStoreKey("sc"),
+		keySupply:        sdk.NewKVStoreKey("supply"),
 	}
 
 	// define the accountKeeper
@@ -112,7 +120,8 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	)
 
 	// add handlers
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper)
+	app.supplyKeeper = bank.NewSupplyKeeper(app.cdc, app.keySupply, app.accountKeeper)
+	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper).WithSupplyKeeper(app.supplyKeeper)
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(
 		app.cdc,
 		app.keyFeeCollection,
@@ -159,21 +168,36 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	app.stakeKeeper = app.stakeKeeper.WithHooks(
 		NewHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()))
 
+	// the total supply starts to be tracked at the upgrade, if its height is configured
+	if sdk.UpgradeMgr.GetUpgradeHeight(sdk.TotalSupply) != 0 {
+		bank.RegisterUpgradeBeginBlocker(app.supplyKeeper)
+	}
+
+	// register the invariants, they are asserted by MsgVerifyInvariant and every invCheckPeriod blocks
+	app.crisisKeeper = crisis.NewKeeper(invCheckPeriod, app.RegisterCodespace(crisis.DefaultCodespace))
+	bank.RegisterInvariants(&app.crisisKeeper, app.accountKeeper, app.supplyKeeper)
+	stake.RegisterInvariants(&app.crisisKeeper, app.stakeKeeper)
+	distr.RegisterInvariants(&app.crisisKeeper, app.distrKeeper)
+	oracle.RegisterInvariants(&app.crisisKeeper, app.bankKeeper, nil)
+
 	// register message routes
 	app.Router().
 		AddRoute("bank", bank.NewHandler(app.bankKeeper)).
 		AddRoute("stake", stake.NewStakeHandler(app.stakeKeeper)).
 		AddRoute("distr", distr.NewHandler(app.distrKeeper)).
 		AddRoute("slashing", slashing.NewSlashingHandler(app.slashingKeeper)).
-		AddRoute("gov", gov.NewHandler(app.govKeeper))
+		AddRoute("gov", gov.NewHandler(app.govKeeper)).
+		AddRoute(crisis.MsgRoute, crisis.NewHandler(app.crisisKeeper))
 
 	app.QueryRouter().
 		AddRoute("gov", gov.NewQuerier(app.govKeeper)).
-		AddRoute("stake", stake.NewQuerier(app.stakeKeeper, app.cdc))
+		AddRoute("stake", stake.NewQuerier(app.stakeKeeper, app.cdc)).
+		AddRoute("ibc", ibc.NewQuerier(app.ibcKeeper, app.cdc)).
+		AddRoute("bank", bank.NewQuerier(app.supplyKeeper, app.cdc))
 
 	// initialize BaseApp
 	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyStake, app.keyStakeReward, app.keyMint, app.keyDistr,
-		app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams, app.keyIbc)
+		app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams, app.keyIbc, app.keySupply)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper))
@@ -204,6 +228,7 @@ func MakeCodec() *codec.Codec {
 	distr.RegisterCodec(cdc)
 	slashing.RegisterCodec(cdc)
 	gov.RegisterCodec(cdc)
+	crisis.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -212,6 +237,9 @@ func MakeCodec() *codec.Codec {
 
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// run the begin blockers of the upgrades at this height
+	sdk.UpgradeMgr.BeginBlocker(ctx)
+
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
 
 	// distribute rewards from previous block
@@ -232,6 +260,7 @@ func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.R
 	gov.EndBlocker(ctx, app.govKeeper)
 	validatorUpdates, _ := stake.EndBlocker(ctx, app.stakeKeeper)
 	ibc.EndBlocker(ctx, app.ibcKeeper)
+	crisis.EndBlocker(ctx, app.crisisKeeper)
 
 	// Add these new validators to the addr -> pubkey map.
 	app.slashingKeeper.AddValidators(ctx, validatorUpdates)
@@ -143,7 +143,7 @@ func BenchmarkFullGaiaSimulation(b *testing.B) {
 		db.Close()
 		os.RemoveAll(dir)
 	}()
-	app := NewGaiaApp(logger, db, nil)
+	app := NewGaiaApp(logger, db, nil, 0)
 
 	// Run randomized simulation
 	// TODO parameterize numbers, save for a later PR
@@ -180,7 +180,7 @@ func TestFullGaiaSimulation(t *testing.T) {
 		logger = log.NewNopLogger()
 	}
 	db := dbm.NewMemDB()
-	app := NewGaiaApp(logger, db, nil)
+	app := NewGaiaApp(logger, db, nil, 0)
 	require.Equal(t, "GaiaApp", app.Name())
 
 	// Run randomized simulation
@@ -215,7 +215,7 @@ func TestAppStateDeterminism(t *testing.T) {
 		for j := 0; j < numTimesToRunPerSeed; j++ {
 			logger := log.NewNopLogger()
 			db := dbm.NewMemDB()
-			app := NewGaiaApp(logger, db, nil)
+			app := NewGaiaApp(logger, db, nil, 0)
 
 			// Run randomized simulation
 			simulation.SimulateFromSeed(
@@ -18,15 +18,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	crisiscmd "github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
 	distrcmd "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	govcmd "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	ibccmd "github.com/cosmos/cosmos-sdk/x/ibc/client/cli"
 	slashingcmd "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	stakecmd "github.com/cosmos/cosmos-sdk/x/stake/client/cli"
 )
 
 const (
 	storeAcc      = "acc"
+	storeBank     = "bank"
 	storeGov      = "gov"
+	storeIbc      = "ibc"
 	storeSlashing = "slashing"
 	storeStake    = "stake"
 )
@@ -82,6 +86,10 @@ func main() {
 		stakecmd.GetCmdQueryValidators(storeStake, cdc),
 		govcmd.GetCmdQueryVote(storeGov, cdc),
 		govcmd.GetCmdQueryVotes(storeGov, cdc),
+		ibccmd.GetCmdQueryPackageProof(storeIbc, cdc),
+		ibccmd.GetCmdQueryChannelStats(storeIbc, cdc),
+		ibccmd.GetCmdQueryPackage(storeIbc, cdc),
+		bankcmd.GetCmdQuerySupply(storeBank, cdc),
 	)...)
 
 	//Add query commands
@@ -105,6 +113,7 @@ func main() {
 			stakecmd.GetCmdDelegate(cdc),
 			stakecmd.GetCmdRedelegate(storeStake, cdc),
 			stakecmd.GetCmdUnbond(storeStake, cdc),
+			stakecmd.GetCmdCancelUnbond(cdc),
 			distrcmd.GetCmdWithdrawRewards(cdc),
 			distrcmd.GetCmdSetWithdrawAddr(cdc),
 			govcmd.GetCmdDeposit(cdc),
@@ -113,6 +122,7 @@ func main() {
 			govcmd.GetCmdSubmitListProposal(cdc),
 			slashingcmd.GetCmdUnjail(cdc),
 			govcmd.GetCmdVote(cdc),
+			crisiscmd.GetCmdInvariantBroken(cdc),
 		)...)
 	rootCmd.AddCommand(
 		queryCmd,
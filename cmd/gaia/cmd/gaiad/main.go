@@ -20,6 +20,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+const flagInvCheckPeriod = "inv-check-period"
+
+var invCheckPeriod int64
+
 func main() {
 	cdc := app.MakeCodec()
 	ctx := server.NewDefaultContext()
@@ -35,6 +39,8 @@ func main() {
 	rootCmd.AddCommand(gaiaInit.GenTxCmd(ctx, cdc))
 
 	server.AddCommands(ctx, cdc, rootCmd, exportAppStateAndTMValidators)
+	rootCmd.PersistentFlags().Int64Var(&invCheckPeriod, flagInvCheckPeriod, 0,
+		"Assert the registered invariants every N blocks, 0 disables the periodic checks")
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "GA", app.DefaultNodeHome)
@@ -46,7 +52,7 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewGaiaApp(logger, db, traceStore,
+	return app.NewGaiaApp(logger, db, traceStore, invCheckPeriod,
 		baseapp.SetPruning(viper.GetString("pruning")),
 	)
 }
@@ -54,6 +60,6 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer,
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
-	gApp := app.NewGaiaApp(logger, db, traceStore)
+	gApp := app.NewGaiaApp(logger, db, traceStore, 0)
 	return gApp.ExportAppStateAndValidators()
 }
@@ -12,6 +12,7 @@ type AccountCache interface {
 
 	Cache() AccountCache
 	Write()
+	DirtyAddrs() []AccAddress // the accounts set or deleted in the cache and not written to the store yet
 }
 
 type DummyAccountCache struct {
@@ -36,3 +37,7 @@ func (d *DummyAccountCache) Cache() AccountCache {
 
 func (d *DummyAccountCache) Write() {
 }
+
+func (d *DummyAccountCache) DirtyAddrs() []AccAddress {
+	return nil
+}
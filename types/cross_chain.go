@@ -78,6 +78,13 @@ type CrossChainApplication interface {
 	ExecuteFailAckPackage(ctx Context, payload []byte) ExecuteResult
 }
 
+// CrossChainPackageValuer is implemented by the cross chain applications whose syn packages carry value,
+// e.g. token transfers, so that the value going through their channels can be rate limited.
+type CrossChainPackageValuer interface {
+	// payload excludes the package header
+	GetSynPackageValue(payload []byte) (int64, error)
+}
+
 type ExecuteResult struct {
 	Err     Error
 	Tags    Tags
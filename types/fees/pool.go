@@ -12,6 +12,7 @@ var Pool pool = newPool()
 type pool struct {
 	fees          map[string]types.Fee // TxHash -> fee
 	committedFees types.Fee
+	settledFees   types.Coins // the committed fees paid out or burnt in the block
 }
 
 func newPool() pool {
@@ -42,9 +43,19 @@ func (p pool) BlockFees() types.Fee {
 	return p.committedFees
 }
 
+// SettleFees records the committed fees paid out or burnt, they are no longer in the pool
+func (p *pool) SettleFees(coins types.Coins) {
+	p.settledFees = p.settledFees.Plus(coins)
+}
+
+func (p pool) SettledFees() types.Coins {
+	return p.settledFees
+}
+
 func (p *pool) Clear() {
 	p.fees = map[string]types.Fee{}
 	p.committedFees = types.Fee{}
+	p.settledFees = nil
 }
 
 func (p *pool) GetFee(txHash string) *types.Fee {
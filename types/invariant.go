@@ -0,0 +1,11 @@
+package types
+
+// An Invariant is a function which tests a particular invariant of the state, it returns a descriptive error if the
+// invariant is broken.
+type Invariant func(ctx Context) error
+
+// InvariantRouter is where the modules register their invariants, an invariant is identified by the name of the module
+// and the route of the invariant in the module.
+type InvariantRouter interface {
+	RegisterRoute(moduleName, route string, invar Invariant)
+}
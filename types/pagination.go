@@ -0,0 +1,69 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// nolint
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+	MaxPageScan      = 10000 // the max number of entries scanned for a page, whether they are included or not
+)
+
+// PageRequest is the cursor of a paginated query. A page starts from the Key, or from the first entry in the order if
+// the Key is empty, and includes at most Limit entries. The entries are in the ascending order of the keys unless
+// Reverse is set.
+type PageRequest struct {
+	Key     cmn.HexBytes `json:"key"`
+	Limit   int          `json:"limit"`
+	Reverse bool         `json:"reverse"`
+}
+
+// PageLimit returns the Limit capped to the MaxPageLimit, or the DefaultPageLimit if it is not set
+func (p PageRequest) PageLimit() int {
+	if p.Limit <= 0 {
+		return DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return p.Limit
+}
+
+// Paginate iterates the entries under the prefix from the cursor of the page. fn is called with the full key and the
+// value of each entry, and returns whether the entry is included in the page, the iteration stops once the page is
+// full or MaxPageScan entries are scanned, so a page may have fewer entries than the limit even if there are more. The
+// returned key is relative to the prefix and is where the next page starts, it is nil if there are no more entries.
+func Paginate(store KVStore, prefix []byte, page PageRequest, fn func(key, value []byte) (included bool)) (nextKey []byte) {
+	start, end := prefix, PrefixEndBytes(prefix)
+	if len(page.Key) != 0 {
+		cursor := append(append([]byte{}, prefix...), page.Key...)
+		if page.Reverse {
+			// the end is exclusive, while the cursor belongs to the page
+			end = append(cursor, 0x00)
+		} else {
+			start = cursor
+		}
+	}
+
+	var iterator Iterator
+	if page.Reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	limit, count, scanned := page.PageLimit(), 0, 0
+	for ; iterator.Valid(); iterator.Next() {
+		if count == limit || scanned == MaxPageScan {
+			return append([]byte{}, iterator.Key()[len(prefix):]...)
+		}
+		scanned++
+		if fn(iterator.Key(), iterator.Value()) {
+			count++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPaginateMaxScan(t *testing.T) {
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	key := types.NewKVStoreKey("test")
+	cms.MountStoreWithDB(key, types.StoreTypeIAVL, db)
+	require.Nil(t, cms.LoadLatestVersion())
+	ctx := types.NewContext(cms, abci.Header{}, types.RunTxModeDeliver, log.NewNopLogger())
+	kvStore := ctx.KVStore(key)
+
+	// only the last entry matches the filter
+	prefix := []byte{0x01}
+	total := types.MaxPageScan + 10
+	for i := 0; i < total; i++ {
+		k := make([]byte, 8)
+		binary.BigEndian.PutUint64(k, uint64(i))
+		kvStore.Set(append(prefix, k...), []byte{byte(i / (total - 1))})
+	}
+	filter := func(included *int) func(_, value []byte) bool {
+		return func(_, value []byte) bool {
+			if value[0] == 1 {
+				*included++
+				return true
+			}
+			return false
+		}
+	}
+
+	// the first page stops at the scan cap without any entry
+	included := 0
+	nextKey := types.Paginate(kvStore, prefix, types.PageRequest{Limit: 1}, filter(&included))
+	require.Equal(t, 0, included)
+	require.EqualValues(t, types.MaxPageScan, binary.BigEndian.Uint64(nextKey))
+
+	// the next page continues from the cursor and finds the entry
+	nextKey = types.Paginate(kvStore, prefix, types.PageRequest{Key: nextKey, Limit: 1}, filter(&included))
+	require.Equal(t, 1, included)
+	require.Nil(t, nextKey)
+}
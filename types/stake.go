@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 )
@@ -29,6 +31,20 @@ func BondStatusToString(b BondStatus) string {
 	}
 }
 
+// BondStatusFromString returns the bond status of the name printed by BondStatusToString
+func BondStatusFromString(str string) (BondStatus, error) {
+	switch str {
+	case "Unbonded":
+		return Unbonded, nil
+	case "Unbonding":
+		return Unbonding, nil
+	case "Bonded":
+		return Bonded, nil
+	default:
+		return BondStatus(0xff), fmt.Errorf("'%s' is not a valid bond status", str)
+	}
+}
+
 // nolint
 func (b BondStatus) Equal(b2 BondStatus) bool {
 	return byte(b) == byte(b2)
@@ -87,7 +103,7 @@ type ValidatorSet interface {
 	// functions for side chain
 	ValidatorBySideChainConsAddr(Context, []byte) Validator
 	UnjailSideChain(Context, []byte)
-	SlashSideChain(ctx Context, sideChainId string, sideConsAddr []byte, slashAmount Dec) (validator Validator, slashedAmount Dec, err error)
+	SlashSideChain(ctx Context, sideChainId string, sideConsAddr []byte, slashAmount Dec) (validator Validator, slashedAmount Dec, losses []DelegatorLoss, err error)
 
 	// allocate remaining slashed amount to validators who are going to be distributed next time
 	AllocateSlashAmtToValidators(ctx Context, slashedConsAddr []byte, amount Dec) (bool, map[string]int64, error)
@@ -96,6 +112,14 @@ type ValidatorSet interface {
 	BondDenom(ctx Context) string
 }
 
+// DelegatorLoss is the stake a delegator lost in a side chain slash, split into the tokens unbonded from the
+// delegation and the tokens taken from the unbonding delegation
+type DelegatorLoss struct {
+	DelegatorAddr AccAddress
+	DelegationAmt int64
+	UnbondingAmt  int64
+}
+
 //_______________________________________________________________________________
 
 // delegation bond for a delegated proof of stake system
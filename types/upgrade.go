@@ -5,15 +5,34 @@ import "fmt"
 var UpgradeMgr = NewUpgradeManager(UpgradeConfig{})
 
 const (
-	FixSignBytesOverflow = "FixSignBytesOverflow" // fix json unmarshal overflow when build SignBytes
-	BEP9                 = "BEP9"                 // https://github.com/bnb-chain/BEPs/pull/9
-	BEP12                = "BEP12"                // https://github.com/bnb-chain/BEPs/pull/17
-	BEP3                 = "BEP3"                 // https://github.com/bnb-chain/BEPs/pull/30
-	BEP8                 = "BEP8"                 // https://github.com/bnb-chain/BEPs/pull/69
-	LaunchBscUpgrade     = "LaunchBscUpgrade"
-	BEP82                = "BEP82" // https://github.com/bnb-chain/BEPs/pull/82
-	FixFailAckPackage    = "FixFailAckPackage"
-	BEP128               = "BEP128" //https://github.com/bnb-chain/BEPs/pull/128
+	FixSignBytesOverflow        = "FixSignBytesOverflow" // fix json unmarshal overflow when build SignBytes
+	BEP9                        = "BEP9"                 // https://github.com/bnb-chain/BEPs/pull/9
+	BEP12                       = "BEP12"                // https://github.com/bnb-chain/BEPs/pull/17
+	BEP3                        = "BEP3"                 // https://github.com/bnb-chain/BEPs/pull/30
+	BEP8                        = "BEP8"                 // https://github.com/bnb-chain/BEPs/pull/69
+	LaunchBscUpgrade            = "LaunchBscUpgrade"
+	BEP82                       = "BEP82" // https://github.com/bnb-chain/BEPs/pull/82
+	FixFailAckPackage           = "FixFailAckPackage"
+	BEP128                      = "BEP128" //https://github.com/bnb-chain/BEPs/pull/128
+	ProphecyExpiry              = "ProphecyExpiry"
+	OracleLiveness              = "OracleLiveness"
+	RelayerReward               = "RelayerReward"
+	IBCPackageCleanup           = "IBCPackageCleanup"
+	CrossChainRegistry          = "CrossChainRegistry"
+	ChannelRateLimit            = "ChannelRateLimit"
+	SideChainEvidence           = "SideChainEvidence"
+	SideChainDowntime           = "SideChainDowntime"
+	BscLightClient              = "BscLightClient"
+	OracleProofClaim            = "OracleProofClaim"
+	SideChainAutoCompound       = "SideChainAutoCompound"
+	SideChainCommissionSchedule = "SideChainCommissionSchedule"
+	LiquidStaking               = "LiquidStaking"
+	CancelUnbonding             = "CancelUnbonding"
+	ValidatorSetHistory         = "ValidatorSetHistory"
+	DelegatorSlashRecord        = "DelegatorSlashRecord"
+	SelfBondEnforcement         = "SelfBondEnforcement"
+	Crisis                      = "Crisis"
+	TotalSupply                 = "TotalSupply"
 )
 
 var MainNetConfig = UpgradeConfig{
@@ -353,6 +353,32 @@ func (ac *accountCache) Write() {
 	ac.cache = sync.Map{}
 }
 
+// DirtyAddrs returns the sorted addresses of the accounts set or deleted in the cache and its parent caches
+func (ac *accountCache) DirtyAddrs() []sdk.AccAddress {
+	keys := make(map[string]struct{})
+	for cache := ac; cache != nil; {
+		cache.cache.Range(func(key, value interface{}) bool {
+			if value.(cValue).dirty {
+				keys[key.(string)] = struct{}{}
+			}
+			return true
+		})
+		cache, _ = cache.parent.(*accountCache)
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	addrs := make([]sdk.AccAddress, len(sorted))
+	for i, key := range sorted {
+		addrs[i] = sdk.AccAddress(key)
+	}
+	return addrs
+}
+
 func (ac *accountCache) getAccountFromCache(addr sdk.AccAddress) (acc sdk.Account) {
 	cacheVal, ok := ac.cache.Load(string(addr))
 	if !ok {
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// GetCmdQuerySupply implements the command to query the total and circulating supply.
+func GetCmdQuerySupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the total and circulating supply of a denom, or of all the denoms if no denom is given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := bank.QuerySupplyParams{}
+			if len(args) == 1 {
+				params.Denom = args[0]
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, bank.QuerySupply), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
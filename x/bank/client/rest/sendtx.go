@@ -18,6 +18,8 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keys.Keybase) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
 	r.HandleFunc("/tx/broadcast", BroadcastTxRequestHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/supply", supplyHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/supply/{denom}", supplyHandlerFn(cliCtx, cdc)).Methods("GET")
 }
 
 type sendReq struct {
@@ -0,0 +1,118 @@
+package bank
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// RegisterInvariants registers the bank invariants
+func RegisterInvariants(ir sdk.InvariantRouter, am auth.AccountKeeper, sk SupplyKeeper) {
+	ir.RegisterRoute("bank", "nonnegative-outstanding", NonnegativeBalanceInvariant(am))
+	ir.RegisterRoute("bank", "total-supply", TotalSupplyInvariant(sk))
+}
+
+// NonnegativeBalanceInvariant checks that all accounts have non-negative balances
+func NonnegativeBalanceInvariant(am auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var err error
+		iterateAccounts(ctx, am, func(acc sdk.Account) bool {
+			coins := acc.GetCoins()
+			if !coins.IsNotNegative() {
+				err = fmt.Errorf("%s has a negative denomination of %s", acc.GetAddress().String(), coins.String())
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
+
+// TotalSupplyInvariant checks that the coins held by all the accounts and the fees in flight equal the total supply.
+// The fees deducted in the block are paid out or burnt when the block ends, so the committed fees of the block not
+// settled yet and the fee of the running tx are still part of the total supply. The fees must be settled through
+// PayFees and BurnFees of the bank keeper.
+func TotalSupplyInvariant(sk SupplyKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		if !sdk.IsUpgrade(sdk.TotalSupply) {
+			return nil
+		}
+
+		held := make(map[string]int64)
+		iterateAccounts(ctx, sk.am, func(acc sdk.Account) bool {
+			for _, coin := range sk.heldCoins(acc) {
+				held[coin.Denom] += coin.Amount
+			}
+			return false
+		})
+		for _, coin := range inFlightFees(ctx) {
+			held[coin.Denom] += coin.Amount
+		}
+
+		supply := make(map[string]int64)
+		for _, coin := range sk.GetTotalSupply(ctx) {
+			supply[coin.Denom] = coin.Amount
+		}
+
+		denoms := make([]string, 0, len(held))
+		for denom := range held {
+			denoms = append(denoms, denom)
+		}
+		for denom := range supply {
+			if _, ok := held[denom]; !ok {
+				denoms = append(denoms, denom)
+			}
+		}
+		sort.Strings(denoms)
+		for _, denom := range denoms {
+			if held[denom] != supply[denom] {
+				return fmt.Errorf("the accounts and the fees hold %d%s, but the total supply is %d%s",
+					held[denom], denom, supply[denom], denom)
+			}
+		}
+		return nil
+	}
+}
+
+// inFlightFees returns the fees committed in the block and not settled, and the fee of the running tx, which is
+// committed when the tx is done
+func inFlightFees(ctx sdk.Context) sdk.Coins {
+	inFlight := fees.Pool.BlockFees().Tokens.Minus(fees.Pool.SettledFees())
+	if txHash, ok := ctx.Value(baseapp.TxHashKey).(string); ok {
+		if fee := fees.Pool.GetFee(txHash); fee != nil {
+			inFlight = inFlight.Plus(fee.Tokens)
+		}
+	}
+	return inFlight
+}
+
+// iterateAccounts iterates over the accounts in the store and the accounts created in the account cache of the block,
+// which are not in the store until the block is committed
+func iterateAccounts(ctx sdk.Context, am auth.AccountKeeper, process func(acc sdk.Account) (stop bool)) {
+	stored := make(map[string]struct{})
+	stop := false
+	am.IterateAccounts(ctx, func(acc sdk.Account) bool {
+		stored[string(acc.GetAddress())] = struct{}{}
+		if acc = am.GetAccount(ctx, acc.GetAddress()); acc == nil {
+			return false
+		}
+		stop = process(acc)
+		return stop
+	})
+	if stop {
+		return
+	}
+
+	for _, addr := range ctx.AccountCache().DirtyAddrs() {
+		if _, ok := stored[string(addr)]; ok {
+			continue
+		}
+		if acc := am.GetAccount(ctx, addr); acc != nil && process(acc) {
+			return
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
@@ -14,6 +15,10 @@ type Keeper interface {
 	SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+	MintCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+	BurnCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+	PayFees(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+	BurnFees(ctx sdk.Context, amt sdk.Coins) sdk.Error
 	GetAccountKeeper() auth.AccountKeeper
 }
 
@@ -23,6 +28,9 @@ var _ Keeper = (*BaseKeeper)(nil)
 // interface.
 type BaseKeeper struct {
 	am auth.AccountKeeper
+
+	// tracks the total supply, nil if the supply is not tracked
+	supply *SupplyKeeper
 }
 
 // NewBaseKeeper returns a new BaseKeeper
@@ -30,6 +38,12 @@ func NewBaseKeeper(am auth.AccountKeeper) BaseKeeper {
 	return BaseKeeper{am: am}
 }
 
+// WithSupplyKeeper returns a BaseKeeper which updates the total supply when minting and burning coins
+func (keeper BaseKeeper) WithSupplyKeeper(sk SupplyKeeper) BaseKeeper {
+	keeper.supply = &sk
+	return keeper
+}
+
 // GetCoins returns the coins at the addr.
 func (keeper BaseKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	return getCoins(ctx, keeper.am, addr)
@@ -65,6 +79,67 @@ func (keeper BaseKeeper) AddCoins(
 	return addCoins(ctx, keeper.am, addr, amt)
 }
 
+// MintCoins creates amt at the addr and adds it to the total supply.
+func (keeper BaseKeeper) MintCoins(
+	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Coins, sdk.Tags, sdk.Error) {
+
+	coins, tags, err := addCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return coins, tags, err
+	}
+	if keeper.tracksSupply() {
+		keeper.supply.Inflate(ctx, amt)
+	}
+	return coins, tags, nil
+}
+
+// BurnCoins destroys amt at the addr and removes it from the total supply.
+func (keeper BaseKeeper) BurnCoins(
+	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Coins, sdk.Tags, sdk.Error) {
+
+	coins, tags, err := subtractCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return coins, tags, err
+	}
+	if keeper.tracksSupply() {
+		if err := keeper.supply.Deflate(ctx, amt); err != nil {
+			return coins, tags, err
+		}
+	}
+	return coins, tags, nil
+}
+
+// PayFees pays amt of the committed fees of the block to the addr. The fees are still in the total supply, so they
+// must be paid out by PayFees or BurnFees for the total supply to add up.
+func (keeper BaseKeeper) PayFees(
+	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Coins, sdk.Tags, sdk.Error) {
+
+	coins, tags, err := addCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return coins, tags, err
+	}
+	fees.Pool.SettleFees(amt)
+	return coins, tags, nil
+}
+
+// BurnFees burns amt of the committed fees of the block and removes it from the total supply.
+func (keeper BaseKeeper) BurnFees(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	if keeper.tracksSupply() {
+		if err := keeper.supply.Deflate(ctx, amt); err != nil {
+			return err
+		}
+	}
+	fees.Pool.SettleFees(amt)
+	return nil
+}
+
+func (keeper BaseKeeper) tracksSupply() bool {
+	return keeper.supply != nil && sdk.IsUpgrade(sdk.TotalSupply)
+}
+
 // SendCoins moves coins from one account to another
 func (keeper BaseKeeper) SendCoins(
 	ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins,
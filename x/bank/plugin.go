@@ -0,0 +1,11 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func RegisterUpgradeBeginBlocker(sk SupplyKeeper) {
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.TotalSupply, func(ctx sdk.Context) {
+		sk.InitSupply(ctx)
+	})
+}
@@ -0,0 +1,79 @@
+package bank
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the bank Querier
+const (
+	QuerySupply = "supply"
+)
+
+// Params for query 'custom/bank/supply', the supply of all the denoms is queried if Denom is empty
+type QuerySupplyParams struct {
+	Denom string `json:"denom"`
+}
+
+// Supply is the total and circulating supply of a denom
+type Supply struct {
+	Denom       string `json:"denom"`
+	Total       int64  `json:"total"`
+	Circulating int64  `json:"circulating"`
+}
+
+func (s Supply) String() string {
+	return fmt.Sprintf("Supply of %s\nTotal: %d\nCirculating: %d\n", s.Denom, s.Total, s.Circulating)
+}
+
+func NewQuerier(sk SupplyKeeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QuerySupply:
+			var params QuerySupplyParams
+			if len(req.Data) != 0 {
+				err := cdc.UnmarshalJSON(req.Data, &params)
+				if err != nil {
+					return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+				}
+			}
+			return querySupply(ctx, cdc, sk, params)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
+		}
+	}
+}
+
+func querySupply(ctx sdk.Context, cdc *codec.Codec, sk SupplyKeeper, params QuerySupplyParams) ([]byte, sdk.Error) {
+	if !sdk.IsUpgrade(sdk.TotalSupply) {
+		return nil, sdk.ErrMsgNotSupported("the total supply is not tracked before the TotalSupply upgrade")
+	}
+
+	var denoms []string
+	if params.Denom == "" {
+		for _, coin := range sk.GetTotalSupply(ctx) {
+			denoms = append(denoms, coin.Denom)
+		}
+	} else {
+		denoms = []string{params.Denom}
+	}
+
+	supplies := make([]Supply, 0, len(denoms))
+	for _, denom := range denoms {
+		supplies = append(supplies, Supply{
+			Denom:       denom,
+			Total:       sk.GetSupply(ctx, denom),
+			Circulating: sk.GetCirculatingSupply(ctx, denom),
+		})
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, supplies)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
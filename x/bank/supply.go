@@ -0,0 +1,148 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// SupplyKey is the prefix of the total supply of each denom
+var SupplyKey = []byte{0x01}
+
+// GetSupplyKey returns the key of the total supply of the denom
+func GetSupplyKey(denom string) []byte {
+	return append(SupplyKey, []byte(denom)...)
+}
+
+// SupplyKeeper tracks the total supply of each denom since the TotalSupply upgrade, it is updated by the mint and burn
+// paths of the bank keeper, including the fees burnt when the block ends. The peg-in and peg-out through the peg account
+// and the bonding to the stake pools move coins between accounts, and the coins they slash or charge go to the fees of
+// the block, so they keep the total supply.
+//
+// Coins locked in the non-circulating accounts are still part of the total supply, e.g. the coins transferred out to a
+// side chain stay in the peg account until they are transferred in, so they are only excluded from the circulating
+// supply.
+type SupplyKeeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	am       auth.AccountKeeper
+
+	// returns all the coins held by the account, the node's account types keep the frozen and locked coins aside
+	heldCoins      func(acc sdk.Account) sdk.Coins
+	nonCirculating []sdk.AccAddress
+}
+
+// NewSupplyKeeper returns a new SupplyKeeper, the peg account is not circulating
+func NewSupplyKeeper(cdc *codec.Codec, key sdk.StoreKey, am auth.AccountKeeper) SupplyKeeper {
+	return SupplyKeeper{
+		storeKey:       key,
+		cdc:            cdc,
+		am:             am,
+		heldCoins:      func(acc sdk.Account) sdk.Coins { return acc.GetCoins() },
+		nonCirculating: []sdk.AccAddress{sdk.PegAccount},
+	}
+}
+
+// WithHeldCoins sets the function returning all the coins held by an account
+func (sk SupplyKeeper) WithHeldCoins(heldCoins func(acc sdk.Account) sdk.Coins) SupplyKeeper {
+	sk.heldCoins = heldCoins
+	return sk
+}
+
+// WithNonCirculatingAddrs adds accounts whose coins are excluded from the circulating supply
+func (sk SupplyKeeper) WithNonCirculatingAddrs(addrs ...sdk.AccAddress) SupplyKeeper {
+	nonCirculating := make([]sdk.AccAddress, 0, len(sk.nonCirculating)+len(addrs))
+	sk.nonCirculating = append(append(nonCirculating, sk.nonCirculating...), addrs...)
+	return sk
+}
+
+// GetSupply returns the total supply of the denom
+func (sk SupplyKeeper) GetSupply(ctx sdk.Context, denom string) (supply int64) {
+	store := ctx.KVStore(sk.storeKey)
+	bz := store.Get(GetSupplyKey(denom))
+	if bz == nil {
+		return 0
+	}
+	sk.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
+	return
+}
+
+// GetTotalSupply returns the total supply of all the denoms
+func (sk SupplyKeeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(sk.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SupplyKey)
+	defer iterator.Close()
+
+	supply := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount int64
+		sk.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		supply = append(supply, sdk.NewCoin(string(iterator.Key()[len(SupplyKey):]), amount))
+	}
+	return supply.Sort()
+}
+
+// GetCirculatingSupply returns the total supply of the denom minus the coins held by the non-circulating accounts
+func (sk SupplyKeeper) GetCirculatingSupply(ctx sdk.Context, denom string) int64 {
+	circulating := sk.GetSupply(ctx, denom)
+	for _, addr := range sk.nonCirculating {
+		if acc := sk.am.GetAccount(ctx, addr); acc != nil {
+			circulating -= sk.heldCoins(acc).AmountOf(denom)
+		}
+	}
+	return circulating
+}
+
+func (sk SupplyKeeper) setSupply(ctx sdk.Context, denom string, supply int64) {
+	store := ctx.KVStore(sk.storeKey)
+	if supply == 0 {
+		store.Delete(GetSupplyKey(denom))
+		return
+	}
+	store.Set(GetSupplyKey(denom), sk.cdc.MustMarshalBinaryLengthPrefixed(supply))
+}
+
+// Inflate adds the minted coins to the total supply
+func (sk SupplyKeeper) Inflate(ctx sdk.Context, amt sdk.Coins) {
+	for _, coin := range amt {
+		sk.setSupply(ctx, coin.Denom, sk.GetSupply(ctx, coin.Denom)+coin.Amount)
+	}
+}
+
+// Deflate removes the burned coins from the total supply
+func (sk SupplyKeeper) Deflate(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		supply := sk.GetSupply(ctx, coin.Denom)
+		if supply < coin.Amount {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("can not burn %s, the total supply is %d%s", coin, supply, coin.Denom))
+		}
+	}
+	for _, coin := range amt {
+		sk.setSupply(ctx, coin.Denom, sk.GetSupply(ctx, coin.Denom)-coin.Amount)
+	}
+	return nil
+}
+
+// InitSupply sets the total supply to the coins held by all the accounts and the fees in flight, it is called once at
+// the TotalSupply upgrade
+func (sk SupplyKeeper) InitSupply(ctx sdk.Context) {
+	total := make(map[string]int64)
+	iterateAccounts(ctx, sk.am, func(acc sdk.Account) bool {
+		for _, coin := range sk.heldCoins(acc) {
+			total[coin.Denom] += coin.Amount
+		}
+		return false
+	})
+	for _, coin := range inFlightFees(ctx) {
+		total[coin.Denom] += coin.Amount
+	}
+
+	for _, coin := range sk.GetTotalSupply(ctx) {
+		sk.setSupply(ctx, coin.Denom, 0)
+	}
+	for denom, amount := range total {
+		sk.setSupply(ctx, denom, amount)
+	}
+}
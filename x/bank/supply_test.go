@@ -0,0 +1,171 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestSupply(t *testing.T) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("authkey")
+	supplyKey := sdk.NewKVStoreKey("supply")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	accountCache := getAccountCache(cdc, ms, authKey)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, sdk.RunTxModeDeliver, log.NewNopLogger()).WithAccountCache(accountCache)
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+	supplyKeeper := NewSupplyKeeper(cdc, supplyKey, accountKeeper)
+	bankKeeper := NewBaseKeeper(accountKeeper).WithSupplyKeeper(supplyKeeper)
+	invariant := TotalSupplyInvariant(supplyKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	_, _, err := bankKeeper.AddCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 100)})
+	require.Nil(t, err)
+	_, _, err = bankKeeper.AddCoins(ctx, sdk.PegAccount, sdk.Coins{sdk.NewCoin("foocoin", 40)})
+	require.Nil(t, err)
+	accountCache.Write()
+
+	// the supply is not tracked before the upgrade
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TotalSupply, 10)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TotalSupply, 0)
+	sdk.UpgradeMgr.SetHeight(9)
+	_, _, err = bankKeeper.MintCoins(ctx, addr2, sdk.Coins{sdk.NewCoin("foocoin", 10)})
+	require.Nil(t, err)
+	require.EqualValues(t, 0, supplyKeeper.GetSupply(ctx, "foocoin"))
+	require.Nil(t, invariant(ctx))
+	accountCache.Write()
+
+	// the supply starts from the coins held by the accounts at the upgrade
+	sdk.UpgradeMgr.SetHeight(10)
+	supplyKeeper.InitSupply(ctx)
+	require.EqualValues(t, 150, supplyKeeper.GetSupply(ctx, "foocoin"))
+	require.EqualValues(t, 110, supplyKeeper.GetCirculatingSupply(ctx, "foocoin"))
+	require.Nil(t, invariant(ctx))
+
+	_, _, err = bankKeeper.MintCoins(ctx, addr, sdk.Coins{sdk.NewCoin("barcoin", 5), sdk.NewCoin("foocoin", 20)})
+	require.Nil(t, err)
+	require.True(t, supplyKeeper.GetTotalSupply(ctx).IsEqual(sdk.Coins{sdk.NewCoin("barcoin", 5), sdk.NewCoin("foocoin", 170)}))
+	require.Nil(t, invariant(ctx))
+
+	_, _, err = bankKeeper.BurnCoins(ctx, addr, sdk.Coins{sdk.NewCoin("barcoin", 5)})
+	require.Nil(t, err)
+	require.True(t, supplyKeeper.GetTotalSupply(ctx).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 170)}))
+
+	// the coins moved to the peg account are not circulating
+	_, err = bankKeeper.SendCoins(ctx, addr, sdk.PegAccount, sdk.Coins{sdk.NewCoin("foocoin", 30)})
+	require.Nil(t, err)
+	require.EqualValues(t, 170, supplyKeeper.GetSupply(ctx, "foocoin"))
+	require.EqualValues(t, 100, supplyKeeper.GetCirculatingSupply(ctx, "foocoin"))
+	require.Nil(t, invariant(ctx))
+
+	// the accounts created in the block are only in the account cache
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	_, _, err = bankKeeper.MintCoins(ctx, addr3, sdk.Coins{sdk.NewCoin("foocoin", 5)})
+	require.Nil(t, err)
+	require.EqualValues(t, 175, supplyKeeper.GetSupply(ctx, "foocoin"))
+	require.Nil(t, invariant(ctx))
+
+	// the fee of the running tx and the committed fees of the block are in flight
+	defer fees.Pool.Clear()
+	txCtx := ctx.WithValue(baseapp.TxHashKey, "tx1")
+	fee := sdk.NewFee(sdk.Coins{sdk.NewCoin("foocoin", 2)}, sdk.FeeForProposer)
+	_, _, err = bankKeeper.SubtractCoins(txCtx, addr, fee.Tokens)
+	require.Nil(t, err)
+	require.NotNil(t, invariant(txCtx))
+	fees.Pool.AddFee("tx1", fee)
+	require.Nil(t, invariant(txCtx))
+	fees.Pool.CommitFee("tx1")
+	require.Nil(t, invariant(ctx))
+
+	// coins created without minting or removed without burning break the invariant
+	_, _, err = bankKeeper.AddCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 1)})
+	require.Nil(t, err)
+	require.NotNil(t, invariant(ctx))
+	_, _, err = bankKeeper.SubtractCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 2)})
+	require.Nil(t, err)
+	require.NotNil(t, invariant(ctx))
+}
+
+func TestTotalSupplyAfterFees(t *testing.T) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("authkey")
+	supplyKey := sdk.NewKVStoreKey("supply")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	accountCache := getAccountCache(cdc, ms, authKey)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, sdk.RunTxModeDeliver, log.NewNopLogger()).WithAccountCache(accountCache)
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+	supplyKeeper := NewSupplyKeeper(cdc, supplyKey, accountKeeper)
+	bankKeeper := NewBaseKeeper(accountKeeper).WithSupplyKeeper(supplyKeeper)
+	invariant := TotalSupplyInvariant(supplyKeeper)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TotalSupply, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TotalSupply, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+	addr := sdk.AccAddress([]byte("addr1"))
+	proposer := sdk.AccAddress([]byte("proposer"))
+	_, _, err := bankKeeper.AddCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 100)})
+	require.Nil(t, err)
+	_, _, err = bankKeeper.AddCoins(ctx, sdk.PegAccount, sdk.Coins{sdk.NewCoin("foocoin", 40)})
+	require.Nil(t, err)
+	supplyKeeper.InitSupply(ctx)
+
+	// the block collects the fee of a tx and the relay fee paid by the peg account
+	defer fees.Pool.Clear()
+	txFee := sdk.NewFee(sdk.Coins{sdk.NewCoin("foocoin", 4)}, sdk.FeeForProposer)
+	_, _, err = bankKeeper.SubtractCoins(ctx, addr, txFee.Tokens)
+	require.Nil(t, err)
+	fees.Pool.AddFee("tx1", txFee)
+	fees.Pool.CommitFee("tx1")
+	_, err = bankKeeper.SendCoins(ctx, addr, sdk.PegAccount, sdk.Coins{sdk.NewCoin("foocoin", 10)})
+	require.Nil(t, err)
+	relayFee := sdk.NewFee(sdk.Coins{sdk.NewCoin("foocoin", 1)}, sdk.FeeForProposer)
+	_, _, err = bankKeeper.SubtractCoins(ctx, sdk.PegAccount, relayFee.Tokens)
+	require.Nil(t, err)
+	fees.Pool.AddAndCommitFee("relay1", relayFee)
+	require.Nil(t, invariant(ctx))
+
+	// the fees are paid out and burnt when the block ends
+	_, _, err = bankKeeper.PayFees(ctx, proposer, sdk.Coins{sdk.NewCoin("foocoin", 3)})
+	require.Nil(t, err)
+	require.Nil(t, invariant(ctx))
+	require.Nil(t, bankKeeper.BurnFees(ctx, sdk.Coins{sdk.NewCoin("foocoin", 2)}))
+	require.EqualValues(t, 138, supplyKeeper.GetSupply(ctx, "foocoin"))
+	require.Nil(t, invariant(ctx))
+
+	// the pool is cleared for the next block
+	fees.Pool.Clear()
+	require.Nil(t, invariant(ctx))
+
+	// the fees paid out without settling them break the invariant
+	fees.Pool.AddAndCommitFee("relay2", relayFee)
+	_, _, err = bankKeeper.SubtractCoins(ctx, sdk.PegAccount, relayFee.Tokens)
+	require.Nil(t, err)
+	_, _, err = bankKeeper.AddCoins(ctx, proposer, relayFee.Tokens)
+	require.Nil(t, err)
+	require.NotNil(t, invariant(ctx))
+}
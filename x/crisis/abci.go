@@ -0,0 +1,16 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker halts the chain if a MsgVerifyInvariant found an invariant broken in the block, and asserts all the
+// invariants every InvCheckPeriod blocks
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.haltIfBroken(ctx)
+
+	if k.invCheckPeriod == 0 || ctx.BlockHeight()%k.invCheckPeriod != 0 {
+		return
+	}
+	k.AssertInvariants(ctx)
+}
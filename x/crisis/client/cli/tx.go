@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+
+	"github.com/spf13/cobra"
+)
+
+// GetCmdInvariantBroken implements the verify invariant command.
+func GetCmdInvariantBroken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariant-broken [module-name] [invariant-route]",
+		Args:  cobra.ExactArgs(2),
+		Short: "submit proof that an invariant is broken to halt the chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			sender, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := crisis.NewMsgVerifyInvariant(sender, args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
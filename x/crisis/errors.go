@@ -0,0 +1,24 @@
+// nolint
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// Default crisis codespace
+	DefaultCodespace sdk.CodespaceType = 33
+
+	CodeInvalidInput     sdk.CodeType = 1
+	CodeUnknownInvariant sdk.CodeType = 2
+)
+
+func ErrNilSender(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "sender address is nil")
+}
+
+func ErrUnknownInvariant(codespace sdk.CodespaceType, moduleName, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownInvariant, fmt.Sprintf("unknown invariant %s/%s", moduleName, route))
+}
@@ -0,0 +1,46 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for "crisis" type messages, it must be created after all the invariants are registered
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgVerifyInvariant:
+			return handleMsgVerifyInvariant(ctx, msg, k)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized crisis msg type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgVerifyInvariant(ctx sdk.Context, msg MsgVerifyInvariant, k Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.Crisis) {
+		return sdk.ErrMsgNotSupported("MsgVerifyInvariant is not supported before the Crisis upgrade").Result()
+	}
+
+	for _, route := range k.routes {
+		if route.ModuleName != msg.InvariantModuleName || route.Route != msg.InvariantRoute {
+			continue
+		}
+
+		// the invariant only reads the state, any write of it is dropped
+		cacheCtx, _ := ctx.CacheContext()
+		err := route.Invar(cacheCtx)
+		tags := sdk.NewTags("sender", []byte(msg.Sender.String()), "invariant", []byte(route.FullRoute()))
+		if err != nil {
+			// the tx succeeds to charge the fee, and the chain halts at the end of the block
+			if ctx.IsDeliverTx() {
+				k.setBroken(route, err)
+			}
+			tags = tags.AppendTag("broken", []byte(err.Error()))
+		}
+		return sdk.Result{Tags: tags}
+	}
+	return ErrUnknownInvariant(k.codespace, msg.InvariantModuleName, msg.InvariantRoute).Result()
+}
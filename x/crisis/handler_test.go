@@ -0,0 +1,96 @@
+package crisis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var (
+	sender = sdk.AccAddress([]byte("sender______________"))
+
+	passing = func(ctx sdk.Context) error { return nil }
+	broken  = func(ctx sdk.Context) error { return errors.New("broken") }
+)
+
+func createTestInput(t *testing.T, mode sdk.RunTxMode, height int64) sdk.Context {
+	keyAcc := sdk.NewKVStoreKey("acc")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	accountCache := auth.NewAccountCache(auth.NewAccountStoreCache(cdc, ms.GetKVStore(keyAcc), 10))
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.Crisis, 10)
+	sdk.UpgradeMgr.SetHeight(height)
+	return sdk.NewContext(ms, abci.Header{Height: height}, mode, log.NewNopLogger()).WithAccountCache(accountCache)
+}
+
+func createTestKeeper(invCheckPeriod int64) Keeper {
+	k := NewKeeper(invCheckPeriod, DefaultCodespace)
+	k.RegisterRoute("bank", "passing", passing)
+	k.RegisterRoute("bank", "broken", broken)
+	return k
+}
+
+func TestHandleMsgVerifyInvariant(t *testing.T) {
+	k := createTestKeeper(0)
+	handler := NewHandler(k)
+
+	// not supported before the upgrade
+	ctx := createTestInput(t, sdk.RunTxModeDeliver, 9)
+	res := handler(ctx, NewMsgVerifyInvariant(sender, "bank", "passing"))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeMsgNotSupported), res.Code)
+
+	ctx = createTestInput(t, sdk.RunTxModeDeliver, 10)
+	res = handler(ctx, NewMsgVerifyInvariant(sender, "bank", "unknown"))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnknownInvariant), res.Code)
+
+	res = handler(ctx, NewMsgVerifyInvariant(sender, "bank", "passing"))
+	require.True(t, res.IsOK())
+	require.NotPanics(t, func() { EndBlocker(ctx, k) })
+
+	// a broken invariant found in CheckTx does not halt the chain
+	checkCtx := createTestInput(t, sdk.RunTxModeCheck, 10)
+	res = handler(checkCtx, NewMsgVerifyInvariant(sender, "bank", "broken"))
+	require.True(t, res.IsOK())
+	require.NotPanics(t, func() { EndBlocker(ctx, k) })
+
+	// the tx succeeds and the chain halts at the end of the block
+	res = handler(ctx, NewMsgVerifyInvariant(sender, "bank", "broken"))
+	require.True(t, res.IsOK())
+	require.Panics(t, func() { EndBlocker(ctx, k) })
+}
+
+func TestEndBlockerAssertInvariants(t *testing.T) {
+	k := createTestKeeper(5)
+
+	ctx := createTestInput(t, sdk.RunTxModeDeliver, 12)
+	require.NotPanics(t, func() { EndBlocker(ctx, k) })
+
+	ctx = createTestInput(t, sdk.RunTxModeDeliver, 15)
+	require.Panics(t, func() { EndBlocker(ctx, k) })
+
+	// the checks are disabled by a zero period
+	k = createTestKeeper(0)
+	require.NotPanics(t, func() { EndBlocker(ctx, k) })
+}
+
+func TestMsgVerifyInvariantValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgVerifyInvariant(sender, "bank", "passing").ValidateBasic())
+	require.NotNil(t, NewMsgVerifyInvariant(nil, "bank", "passing").ValidateBasic())
+	require.NotNil(t, NewMsgVerifyInvariant(sender, "", "passing").ValidateBasic())
+	require.NotNil(t, NewMsgVerifyInvariant(sender, "bank", "").ValidateBasic())
+}
@@ -0,0 +1,91 @@
+package crisis
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper holds the invariants registered by the modules, it asserts all of them every InvCheckPeriod blocks and halts
+// the chain at the end of the block in which an invariant is found broken.
+type Keeper struct {
+	routes         []InvarRoute
+	invCheckPeriod int64
+
+	// the invariant found broken by a MsgVerifyInvariant in the current block, it is shared by the copies of the keeper
+	broken *brokenInvariant
+
+	codespace sdk.CodespaceType
+}
+
+type brokenInvariant struct {
+	route InvarRoute
+	err   error
+}
+
+var _ sdk.InvariantRouter = (*Keeper)(nil)
+
+// NewKeeper creates a new Keeper, the invariants are asserted every invCheckPeriod blocks, 0 disables the checks
+func NewKeeper(invCheckPeriod int64, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		routes:         []InvarRoute{},
+		invCheckPeriod: invCheckPeriod,
+		broken:         &brokenInvariant{},
+		codespace:      codespace,
+	}
+}
+
+// RegisterRoute registers an invariant of the module, it must be called before the keeper is copied to the handler
+func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	k.routes = append(k.routes, NewInvarRoute(moduleName, route, invar))
+}
+
+// Routes returns the registered invariants
+func (k Keeper) Routes() []InvarRoute {
+	return k.routes
+}
+
+// InvCheckPeriod returns the number of blocks between the checks of all the invariants
+func (k Keeper) InvCheckPeriod() int64 {
+	return k.invCheckPeriod
+}
+
+// Codespace returns the codespace of the crisis errors
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// AssertInvariants asserts all the registered invariants and panics with the first broken one
+func (k Keeper) AssertInvariants(ctx sdk.Context) {
+	logger := ctx.Logger().With("module", "x/crisis")
+
+	start := time.Now()
+	for _, route := range k.routes {
+		if err := route.Invar(ctx); err != nil {
+			k.halt(ctx, route, err)
+		}
+	}
+	logger.Info("asserted all invariants", "duration", time.Since(start), "height", ctx.BlockHeight())
+}
+
+func (k Keeper) setBroken(route InvarRoute, err error) {
+	// the first broken invariant is kept
+	if k.broken.err == nil {
+		k.broken.route = route
+		k.broken.err = err
+	}
+}
+
+// haltIfBroken halts the chain if an invariant was found broken in the block
+func (k Keeper) haltIfBroken(ctx sdk.Context) {
+	if k.broken.err != nil {
+		k.halt(ctx, k.broken.route, k.broken.err)
+	}
+}
+
+func (k Keeper) halt(ctx sdk.Context, route InvarRoute, err error) {
+	msg := fmt.Sprintf("invariant %s is broken at height %d, halting the chain: %v", route.FullRoute(), ctx.BlockHeight(), err)
+	ctx.Logger().With("module", "x/crisis").Error(msg)
+	panic(msg)
+}
@@ -0,0 +1,68 @@
+package crisis
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var cdc = codec.New()
+
+// name to identify transaction types
+const (
+	MsgRoute               = "crisis"
+	TypeMsgVerifyInvariant = "verify_invariant"
+)
+
+// verify interface at compile time
+var _ sdk.Msg = MsgVerifyInvariant{}
+
+// MsgVerifyInvariant - asks the chain to verify an invariant, the chain halts at the end of the block if it is broken
+type MsgVerifyInvariant struct {
+	Sender              sdk.AccAddress `json:"sender"`
+	InvariantModuleName string         `json:"invariant_module_name"`
+	InvariantRoute      string         `json:"invariant_route"`
+}
+
+func NewMsgVerifyInvariant(sender sdk.AccAddress, invariantModuleName, invariantRoute string) MsgVerifyInvariant {
+	return MsgVerifyInvariant{
+		Sender:              sender,
+		InvariantModuleName: invariantModuleName,
+		InvariantRoute:      invariantRoute,
+	}
+}
+
+// nolint
+func (msg MsgVerifyInvariant) Route() string { return MsgRoute }
+func (msg MsgVerifyInvariant) Type() string  { return TypeMsgVerifyInvariant }
+func (msg MsgVerifyInvariant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgVerifyInvariant) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgVerifyInvariant) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrNilSender(DefaultCodespace)
+	}
+	if len(msg.InvariantModuleName) == 0 || len(msg.InvariantRoute) == 0 {
+		return ErrUnknownInvariant(DefaultCodespace, msg.InvariantModuleName, msg.InvariantRoute)
+	}
+	return nil
+}
+
+func (msg MsgVerifyInvariant) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+// FullInvariantRoute returns the route of the invariant prefixed by the name of its module
+func (msg MsgVerifyInvariant) FullInvariantRoute() string {
+	return msg.InvariantModuleName + "/" + msg.InvariantRoute
+}
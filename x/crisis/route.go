@@ -0,0 +1,28 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvarRoute is an invariant registered by a module
+type InvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// NewInvarRoute creates a new InvarRoute
+func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
+	return InvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	}
+}
+
+// FullRoute returns the route of the invariant prefixed by the name of its module
+func (i InvarRoute) FullRoute() string {
+	return fmt.Sprintf("%s/%s", i.ModuleName, i.Route)
+}
@@ -26,7 +26,8 @@ type (
 )
 
 var (
-	NewKeeper = keeper.NewKeeper
+	NewKeeper          = keeper.NewKeeper
+	RegisterInvariants = keeper.RegisterInvariants
 
 	GetValidatorDistInfoKey     = keeper.GetValidatorDistInfoKey
 	GetDelegationDistInfoKey    = keeper.GetDelegationDistInfoKey
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// RegisterInvariants registers the distribution invariants
+func RegisterInvariants(ir sdk.InvariantRouter, k Keeper) {
+	ir.RegisterRoute(types.MsgRoute, "nonnegative-fee-pool", NonnegativeFeePoolInvariant(k))
+}
+
+// NonnegativeFeePoolInvariant checks that the fee pool, the community pool and the pools of the validators hold
+// non-negative amounts
+func NonnegativeFeePoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		feePool := k.GetFeePool(ctx)
+		if hasNegative(feePool.Pool) {
+			return fmt.Errorf("negative fee pool: %v", feePool.Pool)
+		}
+		if hasNegative(feePool.CommunityPool) {
+			return fmt.Errorf("negative community pool: %v", feePool.CommunityPool)
+		}
+
+		var err error
+		k.IterateValidatorDistInfos(ctx, func(_ int64, distInfo types.ValidatorDistInfo) bool {
+			if hasNegative(distInfo.Pool) || hasNegative(distInfo.PoolCommission) {
+				err = fmt.Errorf("negative pool of validator %s: pool %v, commission %v",
+					distInfo.OperatorAddr, distInfo.Pool, distInfo.PoolCommission)
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
+
+func hasNegative(coins types.DecCoins) bool {
+	for _, coin := range coins {
+		if coin.Amount.LT(sdk.ZeroDec()) {
+			return true
+		}
+	}
+	return false
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -397,22 +398,49 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				params.ProposalStatus = proposalStatus
 			}
 
-			bz, err := cdc.MarshalJSON(params)
+			page, paginated, err := utils.ReadPageRequest()
 			if err != nil {
 				return err
 			}
 
-			cliCtx := context.NewCLIContext().WithCodec(cdc)
-
-			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/proposals", queryRoute), bz)
+			var bz []byte
+			if paginated {
+				bz, err = cdc.MarshalJSON(gov.QueryProposalsPageParams{
+					BaseParams:     params.BaseParams,
+					Voter:          params.Voter,
+					Depositer:      params.Depositer,
+					ProposalStatus: params.ProposalStatus,
+					Page:           page,
+				})
+			} else {
+				bz, err = cdc.MarshalJSON(params)
+			}
 			if err != nil {
 				return err
 			}
 
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
 			var matchingProposals []gov.Proposal
-			err = cdc.UnmarshalJSON(res, &matchingProposals)
-			if err != nil {
-				return err
+			var nextKey []byte
+			if paginated {
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryProposalsPage), bz)
+				if err != nil {
+					return err
+				}
+				var proposalsPage gov.ProposalsPage
+				if err = cdc.UnmarshalJSON(res, &proposalsPage); err != nil {
+					return err
+				}
+				matchingProposals, nextKey = proposalsPage.Proposals, proposalsPage.NextKey
+			} else {
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/proposals", queryRoute), bz)
+				if err != nil {
+					return err
+				}
+				if err = cdc.UnmarshalJSON(res, &matchingProposals); err != nil {
+					return err
+				}
 			}
 
 			if len(matchingProposals) == 0 {
@@ -423,6 +451,9 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			for _, proposal := range matchingProposals {
 				fmt.Printf("  %d - %s\n", proposal.GetProposalID(), proposal.GetTitle())
 			}
+			if len(nextKey) != 0 {
+				fmt.Printf("Next Key: %X\n", nextKey)
+			}
 
 			return nil
 		},
@@ -433,6 +464,7 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
 	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
 	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+	sdkclient.PaginationFlags(cmd)
 
 	return cmd
 }
@@ -490,6 +522,28 @@ func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			proposalID := viper.GetInt64(flagProposalID)
 			sideChainId := viper.GetString(flagSideChainId)
 
+			page, paginated, err := utils.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+			if paginated {
+				params := gov.QueryProposalPageParams{
+					BaseParams: gov.NewBaseParams(sideChainId),
+					ProposalID: proposalID,
+					Page:       page,
+				}
+				bz, err := cdc.MarshalJSON(params)
+				if err != nil {
+					return err
+				}
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryVotesPage), bz)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(res))
+				return nil
+			}
+
 			params := gov.QueryVotesParams{
 				BaseParams: gov.NewBaseParams(sideChainId),
 				ProposalID: proposalID,
@@ -511,6 +565,7 @@ func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's votes are being queried")
 	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+	sdkclient.PaginationFlags(cmd)
 
 	return cmd
 }
@@ -567,6 +622,28 @@ func GetCmdQueryDeposits(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			proposalID := viper.GetInt64(flagProposalID)
 			sideChainId := viper.GetString(flagSideChainId)
 
+			page, paginated, err := utils.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+			if paginated {
+				params := gov.QueryProposalPageParams{
+					BaseParams: gov.NewBaseParams(sideChainId),
+					ProposalID: proposalID,
+					Page:       page,
+				}
+				bz, err := cdc.MarshalJSON(params)
+				if err != nil {
+					return err
+				}
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryDepositsPage), bz)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(res))
+				return nil
+			}
+
 			params := gov.QueryDepositsParams{
 				BaseParams: gov.NewBaseParams(sideChainId),
 				ProposalID: proposalID,
@@ -588,6 +665,7 @@ func GetCmdQueryDeposits(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's deposits are being queried")
 	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+	sdkclient.PaginationFlags(cmd)
 
 	return cmd
 }
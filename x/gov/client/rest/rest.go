@@ -236,6 +236,15 @@ func queryDepositsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 			return
 		}
 
+		page, paginated, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		if paginated {
+			queryPage(w, cliCtx, cdc, "custom/gov/depositsPage", gov.QueryProposalPageParams{ProposalID: proposalID, Page: page})
+			return
+		}
+
 		params := gov.QueryDepositsParams{
 			ProposalID: proposalID,
 		}
@@ -404,6 +413,15 @@ func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 			return
 		}
 
+		page, paginated, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		if paginated {
+			queryPage(w, cliCtx, cdc, "custom/gov/votesPage", gov.QueryProposalPageParams{ProposalID: proposalID, Page: page})
+			return
+		}
+
 		params := gov.QueryVotesParams{
 			ProposalID: proposalID,
 		}
@@ -467,6 +485,20 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 			params.NumLatestProposals = numLatest
 		}
 
+		page, paginated, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		if paginated {
+			queryPage(w, cliCtx, cdc, "custom/gov/proposalsPage", gov.QueryProposalsPageParams{
+				Voter:          params.Voter,
+				Depositer:      params.Depositer,
+				ProposalStatus: params.ProposalStatus,
+				Page:           page,
+			})
+			return
+		}
+
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -522,3 +554,20 @@ func queryTallyOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// queryPage queries a page of the paginated endpoint
+func queryPage(w http.ResponseWriter, cliCtx context.CLIContext, cdc *codec.Codec, endpoint string, params interface{}) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := cliCtx.QueryWithData(endpoint, bz)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+}
@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -233,6 +234,55 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddr
 	return matchingProposals
 }
 
+// Get a page of the proposals matching the filters in the order of the proposal ids. The key of the page is the
+// big endian proposal id to start from.
+func (keeper Keeper) GetProposalsPage(ctx sdk.Context, voterAddr sdk.AccAddress, depositerAddr sdk.AccAddress,
+	status ProposalStatus, page sdk.PageRequest) (proposals []Proposal, nextKey []byte) {
+
+	proposals = []Proposal{}
+	maxProposalID, err := keeper.peekCurrentProposalID(ctx)
+	if err != nil {
+		return proposals, nil
+	}
+
+	proposalID, step := int64(0), int64(1)
+	if page.Reverse {
+		proposalID, step = maxProposalID-1, -1
+	}
+	if len(page.Key) != 0 {
+		if len(page.Key) != 8 {
+			return proposals, nil
+		}
+		proposalID = int64(binary.BigEndian.Uint64(page.Key))
+	}
+
+	limit := page.PageLimit()
+	for ; proposalID >= 0 && proposalID < maxProposalID; proposalID += step {
+		if len(proposals) == limit {
+			nextKey = make([]byte, 8)
+			binary.BigEndian.PutUint64(nextKey, uint64(proposalID))
+			return proposals, nextKey
+		}
+
+		if len(voterAddr) != 0 {
+			if _, found := keeper.GetVote(ctx, proposalID, voterAddr); !found {
+				continue
+			}
+		}
+		if len(depositerAddr) != 0 {
+			if _, found := keeper.GetDeposit(ctx, proposalID, depositerAddr); !found {
+				continue
+			}
+		}
+		proposal := keeper.GetProposal(ctx, proposalID)
+		if proposal == nil || (validProposalStatus(status) && proposal.GetStatus() != status) {
+			continue
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, nil
+}
+
 func (keeper Keeper) SetInitialProposalID(ctx sdk.Context, proposalID int64) sdk.Error {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := store.Get(KeyNextProposalID)
@@ -365,6 +415,19 @@ func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID int64) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, KeyVotesSubspace(proposalID))
 }
 
+// Gets a page of the votes on a specific proposal
+func (keeper Keeper) GetVotesPage(ctx sdk.Context, proposalID int64, page sdk.PageRequest) (votes []Vote, nextKey []byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	votes = []Vote{}
+	nextKey = sdk.Paginate(store, KeyVotesSubspace(proposalID), page, func(_, value []byte) bool {
+		var vote Vote
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &vote)
+		votes = append(votes, vote)
+		return true
+	})
+	return votes, nextKey
+}
+
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(KeyVote(proposalID, voterAddr))
@@ -446,6 +509,19 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID int64) sdk.Iterator
 	return sdk.KVStorePrefixIterator(store, KeyDepositsSubspace(proposalID))
 }
 
+// Gets a page of the deposits on a specific proposal
+func (keeper Keeper) GetDepositsPage(ctx sdk.Context, proposalID int64, page sdk.PageRequest) (deposits []Deposit, nextKey []byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	deposits = []Deposit{}
+	nextKey = sdk.Paginate(store, KeyDepositsSubspace(proposalID), page, func(_, value []byte) bool {
+		var deposit Deposit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &deposit)
+		deposits = append(deposits, deposit)
+		return true
+	})
+	return deposits, nextKey
+}
+
 // Returns and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
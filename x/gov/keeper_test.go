@@ -318,3 +318,54 @@ func TestProposalQueues(t *testing.T) {
 	require.Equal(t, keeper.ActiveProposalQueuePeek(ctx).GetProposalID(), proposal4.GetProposalID())
 	require.Equal(t, keeper.ActiveProposalQueuePop(ctx).GetProposalID(), proposal4.GetProposalID())
 }
+
+func TestProposalsAndVotesPage(t *testing.T) {
+	mapp, _, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+
+	var proposalIDs []int64
+	for i := 0; i < 5; i++ {
+		proposal := keeper.NewTextProposal(ctx, "Test", "description", gov.ProposalTypeText, 1000*time.Second)
+		proposalIDs = append(proposalIDs, proposal.GetProposalID())
+	}
+	proposal := keeper.GetProposal(ctx, proposalIDs[3])
+	proposal.SetStatus(gov.StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+	for _, addr := range addrs {
+		keeper.AddVote(ctx, proposalIDs[3], addr, gov.OptionYes)
+	}
+
+	proposals, nextKey := keeper.GetProposalsPage(ctx, nil, nil, gov.StatusNil, sdk.PageRequest{Limit: 3})
+	require.Len(t, proposals, 3)
+	require.Equal(t, proposalIDs[0], proposals[0].GetProposalID())
+	proposals, nextKey = keeper.GetProposalsPage(ctx, nil, nil, gov.StatusNil, sdk.PageRequest{Key: nextKey, Limit: 3})
+	require.Len(t, proposals, 2)
+	require.Equal(t, proposalIDs[3], proposals[0].GetProposalID())
+	require.Nil(t, nextKey)
+
+	proposals, _ = keeper.GetProposalsPage(ctx, nil, nil, gov.StatusNil, sdk.PageRequest{Limit: 1, Reverse: true})
+	require.Equal(t, proposalIDs[4], proposals[0].GetProposalID())
+	proposals, _ = keeper.GetProposalsPage(ctx, addrs[0], nil, gov.StatusVotingPeriod, sdk.PageRequest{})
+	require.Len(t, proposals, 1)
+	require.Equal(t, proposalIDs[3], proposals[0].GetProposalID())
+
+	// query the votes through the querier
+	querier := gov.NewQuerier(keeper)
+	bz := mapp.Cdc.MustMarshalJSON(gov.QueryProposalPageParams{ProposalID: proposalIDs[3], Page: sdk.PageRequest{Limit: 2}})
+	res, err := querier(ctx, []string{gov.QueryVotesPage}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var votesPage gov.VotesPage
+	mapp.Cdc.MustUnmarshalJSON(res, &votesPage)
+	require.Len(t, votesPage.Votes, 2)
+	voters := []sdk.AccAddress{votesPage.Votes[0].Voter, votesPage.Votes[1].Voter}
+
+	bz = mapp.Cdc.MustMarshalJSON(gov.QueryProposalPageParams{ProposalID: proposalIDs[3], Page: sdk.PageRequest{Key: votesPage.NextKey}})
+	res, err = querier(ctx, []string{gov.QueryVotesPage}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	mapp.Cdc.MustUnmarshalJSON(res, &votesPage)
+	require.Len(t, votesPage.Votes, 1)
+	require.Empty(t, votesPage.NextKey)
+	require.ElementsMatch(t, addrs, append(voters, votesPage.Votes[0].Voter))
+}
@@ -131,6 +131,10 @@ const (
 	ProposalTypeRemoveValidator      ProposalKind = 0x07
 	ProposalTypeDelistTradingPair    ProposalKind = 0x08
 	ProposalTypeManageChanPermission ProposalKind = 0x09
+	ProposalTypeManageChannel        ProposalKind = 0x0A
+	ProposalTypeManageDestChain      ProposalKind = 0x0B
+	ProposalTypeManageChanRateLimit  ProposalKind = 0x0C
+	ProposalTypeRegisterLightClient  ProposalKind = 0x0D
 )
 
 // String to proposalType byte.  Returns ff if invalid.
@@ -158,6 +162,14 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		return ProposalTypeCSCParamsChange, nil
 	case "ManageChanPermission":
 		return ProposalTypeManageChanPermission, nil
+	case "ManageChannel":
+		return ProposalTypeManageChannel, nil
+	case "ManageDestChain":
+		return ProposalTypeManageDestChain, nil
+	case "ManageChanRateLimit":
+		return ProposalTypeManageChanRateLimit, nil
+	case "RegisterLightClient":
+		return ProposalTypeRegisterLightClient, nil
 	default:
 		return ProposalKind(0xff), errors.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -173,7 +185,11 @@ func validProposalType(pt ProposalKind) bool {
 		pt == ProposalTypeCreateValidator ||
 		pt == ProposalTypeRemoveValidator ||
 		pt == ProposalTypeDelistTradingPair ||
-		pt == ProposalTypeManageChanPermission {
+		pt == ProposalTypeManageChanPermission ||
+		pt == ProposalTypeManageChannel ||
+		pt == ProposalTypeManageDestChain ||
+		pt == ProposalTypeManageChanRateLimit ||
+		pt == ProposalTypeRegisterLightClient {
 		return true
 	}
 	return false
@@ -236,6 +252,14 @@ func (pt ProposalKind) String() string {
 		return "CSCParamsChange"
 	case ProposalTypeManageChanPermission:
 		return "ManageChanPermission"
+	case ProposalTypeManageChannel:
+		return "ManageChannel"
+	case ProposalTypeManageDestChain:
+		return "ManageDestChain"
+	case ProposalTypeManageChanRateLimit:
+		return "ManageChanRateLimit"
+	case ProposalTypeRegisterLightClient:
+		return "RegisterLightClient"
 	default:
 		return ""
 	}
@@ -2,6 +2,7 @@ package gov
 
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,10 @@ const (
 	QueryVotes     = "votes"
 	QueryVote      = "vote"
 	QueryTally     = "tally"
+
+	QueryProposalsPage = "proposalsPage"
+	QueryDepositsPage  = "depositsPage"
+	QueryVotesPage     = "votesPage"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -71,6 +76,27 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 				return res, err
 			}
 			return queryTally(ctx, path[1:], req, p, keeper)
+		case QueryProposalsPage:
+			p := new(QueryProposalsPageParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryProposalsPage(ctx, p, keeper)
+		case QueryDepositsPage:
+			p := new(QueryProposalPageParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryDepositsPage(ctx, p, keeper)
+		case QueryVotesPage:
+			p := new(QueryProposalPageParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryVotesPage(ctx, p, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -232,6 +258,73 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, params *Q
 	return bz, nil
 }
 
+// Params for query 'custom/gov/proposalsPage'
+type QueryProposalsPageParams struct {
+	BaseParams
+	Voter          sdk.AccAddress
+	Depositer      sdk.AccAddress
+	ProposalStatus ProposalStatus
+	Page           sdk.PageRequest
+}
+
+// Params for the following queries:
+// - 'custom/gov/depositsPage'
+// - 'custom/gov/votesPage'
+type QueryProposalPageParams struct {
+	BaseParams
+	ProposalID int64
+	Page       sdk.PageRequest
+}
+
+// Result of query 'custom/gov/proposalsPage', the next page starts from the NextKey, which is empty if there are no
+// more proposals
+type ProposalsPage struct {
+	Proposals []Proposal   `json:"proposals"`
+	NextKey   cmn.HexBytes `json:"next_key"`
+}
+
+// Result of query 'custom/gov/depositsPage'
+type DepositsPage struct {
+	Deposits []Deposit    `json:"deposits"`
+	NextKey  cmn.HexBytes `json:"next_key"`
+}
+
+// Result of query 'custom/gov/votesPage'
+type VotesPage struct {
+	Votes   []Vote       `json:"votes"`
+	NextKey cmn.HexBytes `json:"next_key"`
+}
+
+func queryProposalsPage(ctx sdk.Context, params *QueryProposalsPageParams, keeper Keeper) (res []byte, err sdk.Error) {
+	proposals, nextKey := keeper.GetProposalsPage(ctx, params.Voter, params.Depositer, params.ProposalStatus, params.Page)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, ProposalsPage{Proposals: proposals, NextKey: nextKey})
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+func queryDepositsPage(ctx sdk.Context, params *QueryProposalPageParams, keeper Keeper) (res []byte, err sdk.Error) {
+	deposits, nextKey := keeper.GetDepositsPage(ctx, params.ProposalID, params.Page)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, DepositsPage{Deposits: deposits, NextKey: nextKey})
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+func queryVotesPage(ctx sdk.Context, params *QueryProposalPageParams, keeper Keeper) (res []byte, err sdk.Error) {
+	votes, nextKey := keeper.GetVotesPage(ctx, params.ProposalID, params.Page)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, VotesPage{Votes: votes, NextKey: nextKey})
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
 func RequestPrepare(ctx sdk.Context, k Keeper, req abci.RequestQuery, p SideChainIder) (newCtx sdk.Context, err sdk.Error) {
 	if req.Data == nil || len(req.Data) == 0 {
 		return ctx, nil
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+const (
+	flagSrcChainId  = "src-chain-id"
+	flagDestChainId = "dest-chain-id"
+	flagChannelId   = "channel-id"
+	flagSequence    = "sequence"
+	flagDestChain   = "dest-chain"
+	flagChannel     = "channel"
+)
+
+// GetCmdQueryPackageProof implements the command to query an outgoing package together with its proof.
+// The proof is verified against the AppHash of a certified header unless --trust-node is set.
+func GetCmdQueryPackageProof(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package-proof",
+		Short: "Query an outgoing cross chain package together with its merkle proof",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			key := ibc.GetIBCPackageKey(sdk.ChainID(viper.GetUint(flagSrcChainId)), sdk.ChainID(viper.GetUint(flagDestChainId)),
+				sdk.ChannelID(viper.GetUint(flagChannelId)), viper.GetUint64(flagSequence))
+			proof, err := cliCtx.QueryStoreWithProof(key, storeName)
+			if err != nil {
+				return err
+			}
+
+			if !cliCtx.TrustNode {
+				if err := cliCtx.VerifyStoreValueProof(proof); err != nil {
+					return err
+				}
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, proof)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint(flagSrcChainId, 0, "the cross chain id of this chain")
+	cmd.Flags().Uint(flagDestChainId, 0, "the cross chain id of the destination chain")
+	cmd.Flags().Uint(flagChannelId, 0, "the channel id of the package")
+	cmd.Flags().Uint64(flagSequence, 0, "the sequence of the package")
+	return cmd
+}
+
+// GetCmdQueryChannelStats implements the command to query the sequence and backlog statistics of the channels.
+func GetCmdQueryChannelStats(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-stats",
+		Short: "Query the send sequence, lowest stored sequence, backlog and stored bytes of the cross chain channels",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := ibc.QueryChannelStatsParams{
+				DestChainName: viper.GetString(flagDestChain),
+				ChannelName:   viper.GetString(flagChannel),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, ibc.QueryChannelStats), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDestChain, "", "name of the destination chain, all the destination chains if empty")
+	cmd.Flags().String(flagChannel, "", "name of the channel, all the channels if empty")
+	return cmd
+}
+
+// GetCmdQueryPackage implements the command to query an outgoing package with its payload decoded as JSON.
+func GetCmdQueryPackage(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package",
+		Short: "Query an outgoing cross chain package with its payload decoded by the payload schema of the channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := ibc.QueryPackageParams{
+				DestChainId: sdk.ChainID(viper.GetUint(flagDestChainId)),
+				ChannelId:   sdk.ChannelID(viper.GetUint(flagChannelId)),
+				Sequence:    viper.GetUint64(flagSequence),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, ibc.QueryPackage), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint(flagDestChainId, 0, "the cross chain id of the destination chain")
+	cmd.Flags().Uint(flagChannelId, 0, "the channel id of the package")
+	cmd.Flags().Uint64(flagSequence, 0, "the sequence of the package")
+	return cmd
+}
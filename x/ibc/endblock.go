@@ -5,6 +5,9 @@ import (
 )
 
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	if sdk.IsUpgrade(sdk.IBCPackageCleanup) {
+		keeper.cleanupExpiredPackages(ctx)
+	}
 	if len(keeper.packageCollector.collectedPackages) == 0 {
 		return
 	}
@@ -48,11 +48,11 @@ func (k *Keeper) CreateIBCSyncPackage(ctx sdk.Context, destChainName string, cha
 func (k *Keeper) CreateRawIBCPackage(ctx sdk.Context, destChainName string, channelName string,
 	packageType sdk.CrossChainPackageType, packageLoad []byte, relayerFee big.Int) (uint64, sdk.Error) {
 
-	destChainID, err := k.sideKeeper.GetDestChainID(destChainName)
+	destChainID, err := k.sideKeeper.GetDestChainID(ctx, destChainName)
 	if err != nil {
 		return 0, sdk.ErrInternal(err.Error())
 	}
-	channelID, err := k.sideKeeper.GetChannelID(channelName)
+	channelID, err := k.sideKeeper.GetChannelID(ctx, channelName)
 	if err != nil {
 		return 0, sdk.ErrInternal(err.Error())
 	}
@@ -63,7 +63,7 @@ func (k *Keeper) CreateRawIBCPackage(ctx sdk.Context, destChainName string, chan
 func (k *Keeper) CreateRawIBCPackageById(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
 	packageType sdk.CrossChainPackageType, packageLoad []byte) (uint64, sdk.Error) {
 
-	destChainName, err := k.sideKeeper.GetDestChainName(destChainID)
+	destChainName, err := k.sideKeeper.GetDestChainName(ctx, destChainID)
 	if err != nil {
 		return 0, ErrInvalidChainId(DefaultCodespace, "can not find dest chain id")
 	}
@@ -81,6 +81,15 @@ func (k *Keeper) CreateRawIBCPackageByIdWithFee(ctx sdk.Context, destChainID sdk
 	if packageType == sdk.SynCrossChainPackageType && k.sideKeeper.GetChannelSendPermission(ctx, destChainID, channelID) != sdk.ChannelAllow {
 		return 0, ErrWritePackageForbidden(DefaultCodespace, fmt.Sprintf("channel %d is not allowed to write syn package", channelID))
 	}
+	if packageType == sdk.SynCrossChainPackageType && !k.sideKeeper.IsChannelActive(ctx, destChainID, channelID) {
+		return 0, ErrWritePackageForbidden(DefaultCodespace, fmt.Sprintf("channel %d to chain %d is not active", channelID, destChainID))
+	}
+	if packageType == sdk.SynCrossChainPackageType {
+		sdkErr := k.sideKeeper.RecordChannelTraffic(ctx, sTypes.TrafficOutbound, destChainID, channelID, packageLoad)
+		if sdkErr != nil {
+			return 0, sdkErr
+		}
+	}
 
 	sequence := k.sideKeeper.GetSendSequence(ctx, destChainID, channelID)
 	key := buildIBCPackageKey(k.sideKeeper.GetSrcChainID(), destChainID, channelID, sequence)
@@ -107,11 +116,11 @@ func (k *Keeper) CreateRawIBCPackageByIdWithFee(ctx sdk.Context, destChainID sdk
 }
 
 func (k *Keeper) GetIBCPackage(ctx sdk.Context, destChainName string, channelName string, sequence uint64) ([]byte, error) {
-	destChainID, err := k.sideKeeper.GetDestChainID(destChainName)
+	destChainID, err := k.sideKeeper.GetDestChainID(ctx, destChainName)
 	if err != nil {
 		return nil, err
 	}
-	channelID, err := k.sideKeeper.GetChannelID(channelName)
+	channelID, err := k.sideKeeper.GetChannelID(ctx, channelName)
 	if err != nil {
 		return nil, err
 	}
@@ -125,11 +134,11 @@ func (k *Keeper) GetIBCPackageById(ctx sdk.Context, destChainID sdk.ChainID, cha
 }
 
 func (k *Keeper) CleanupIBCPackage(ctx sdk.Context, destChainName string, channelName string, confirmedSequence uint64) {
-	destChainID, err := k.sideKeeper.GetDestChainID(destChainName)
+	destChainID, err := k.sideKeeper.GetDestChainID(ctx, destChainName)
 	if err != nil {
 		return
 	}
-	channelID, err := k.sideKeeper.GetChannelID(channelName)
+	channelID, err := k.sideKeeper.GetChannelID(ctx, channelName)
 	if err != nil {
 		return
 	}
@@ -151,6 +160,123 @@ func (k *Keeper) CleanupIBCPackage(ctx sdk.Context, destChainName string, channe
 	}
 }
 
+// GetConfirmedSequence returns the sequence of the first package not confirmed to be received by the destination
+// chain, all the packages below it are received
+func (k *Keeper) GetConfirmedSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(buildConfirmedSequenceKey(destChainID, channelID))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k *Keeper) setConfirmedSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) {
+	bz := make([]byte, sequenceLength)
+	binary.BigEndian.PutUint64(bz, sequence)
+	ctx.KVStore(k.storeKey).Set(buildConfirmedSequenceKey(destChainID, channelID), bz)
+}
+
+// ConfirmSynPackage is called when an ack or fail ack package is received from the destination chain. The
+// destination chain handles the packages of a channel in order and answers the syn packages in order, so the
+// answered syn package is the first unconfirmed one and all the packages up to it are received. Syn packages
+// answered without an ack package are confirmed by the next answer, the confirmed sequence is never ahead of
+// the destination chain.
+func (k *Keeper) ConfirmSynPackage(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) {
+	confirmed := k.GetConfirmedSequence(ctx, destChainID, channelID)
+	prefixKey := buildIBCPackageKeyPrefix(k.sideKeeper.GetSrcChainID(), destChainID, channelID)
+	startKey := buildIBCPackageKey(k.sideKeeper.GetSrcChainID(), destChainID, channelID, confirmed)
+	iterator := ctx.KVStore(k.storeKey).Iterator(startKey, sdk.PrefixEndBytes(prefixKey))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		packageKey := iterator.Key()
+		if len(packageKey) != totalPackageKeyLength {
+			continue
+		}
+		packageType, _, err := sTypes.DecodePackageHeader(iterator.Value())
+		if err != nil || packageType != sdk.SynCrossChainPackageType {
+			continue
+		}
+		sequence := binary.BigEndian.Uint64(packageKey[totalPackageKeyLength-sequenceLength:])
+		k.setConfirmedSequence(ctx, destChainID, channelID, sequence+1)
+		return
+	}
+}
+
+// GetChannelStats returns the sequence and backlog statistics of a channel to the destination chain
+func (k *Keeper) GetChannelStats(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) ChannelStats {
+	stats := ChannelStats{
+		DestChainID:       destChainID,
+		ChannelID:         channelID,
+		SendSequence:      k.sideKeeper.GetSendSequence(ctx, destChainID, channelID),
+		ConfirmedSequence: k.GetConfirmedSequence(ctx, destChainID, channelID),
+	}
+	stats.DestChainName, _ = k.sideKeeper.GetDestChainName(ctx, destChainID)
+	stats.ChannelName, _ = k.sideKeeper.GetChannelName(ctx, channelID)
+
+	prefixKey := buildIBCPackageKeyPrefix(k.sideKeeper.GetSrcChainID(), destChainID, channelID)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		packageKey := iterator.Key()
+		if len(packageKey) != totalPackageKeyLength {
+			continue
+		}
+		if stats.Backlog == 0 {
+			stats.LowestSequence = binary.BigEndian.Uint64(packageKey[totalPackageKeyLength-sequenceLength:])
+		}
+		stats.Backlog++
+		stats.StoredBytes += int64(len(iterator.Value()))
+	}
+	return stats
+}
+
+// GetAllChannelStats returns the statistics of all the registered channels to all the registered destination chains
+func (k *Keeper) GetAllChannelStats(ctx sdk.Context) []ChannelStats {
+	allStats := make([]ChannelStats, 0)
+	for _, destChainID := range k.sideKeeper.GetDestChainIDs(ctx) {
+		for _, channelID := range k.sideKeeper.GetChannelIDs(ctx) {
+			allStats = append(allStats, k.GetChannelStats(ctx, destChainID, channelID))
+		}
+	}
+	return allStats
+}
+
+// cleanupExpiredPackages removes the packages confirmed by the destination chain, the latest PackageRetention
+// packages of every channel are always kept
+func (k *Keeper) cleanupExpiredPackages(ctx sdk.Context) {
+	for _, destChainID := range k.sideKeeper.GetDestChainIDs(ctx) {
+		destChainName, err := k.sideKeeper.GetDestChainName(ctx, destChainID)
+		if err != nil {
+			continue
+		}
+		retention := k.GetPackageRetention(ctx, destChainName)
+		if retention <= 0 {
+			continue
+		}
+		for _, channelID := range k.sideKeeper.GetChannelIDs(ctx) {
+			sendSequence := k.sideKeeper.GetSendSequence(ctx, destChainID, channelID)
+			if sendSequence <= uint64(retention) {
+				continue
+			}
+			// keep the sequences in [sendSequence-retention, sendSequence) and the unconfirmed ones
+			bound := sendSequence - uint64(retention)
+			if confirmed := k.GetConfirmedSequence(ctx, destChainID, channelID); confirmed < bound {
+				bound = confirmed
+			}
+			if bound == 0 {
+				continue
+			}
+			channelName, err := k.sideKeeper.GetChannelName(ctx, channelID)
+			if err != nil {
+				continue
+			}
+			k.CleanupIBCPackage(ctx, destChainName, channelName, bound-1)
+		}
+	}
+}
+
 func (k Keeper) GetRelayerFeeParam(ctx sdk.Context, destChainName string) (relaterFee *big.Int, err error) {
 	storePrefix := k.sideKeeper.GetSideChainStorePrefix(ctx, destChainName)
 	if storePrefix == nil {
@@ -163,8 +289,24 @@ func (k Keeper) GetRelayerFeeParam(ctx sdk.Context, destChainName string) (relat
 	return
 }
 
+// GetPackageRetention returns the number of the latest packages kept for each channel to the destination chain,
+// 0 means the automatic cleanup is disabled
+func (k Keeper) GetPackageRetention(ctx sdk.Context, destChainName string) (retention int64) {
+	storePrefix := k.sideKeeper.GetSideChainStorePrefix(ctx, destChainName)
+	if storePrefix == nil {
+		return 0
+	}
+	k.paramSpace.GetIfExists(ctx.WithSideChainKeyPrefix(storePrefix), ParamPackageRetention, &retention)
+	return
+}
+
 func (k Keeper) SetParams(ctx sdk.Context, params Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	sdk.Upgrade(sdk.IBCPackageCleanup, func() {
+		pb := paramsBeforeIBCPackageCleanup{RelayerFee: params.RelayerFee}
+		k.paramSpace.SetParamSet(ctx, &pb)
+	}, nil, func() {
+		k.paramSpace.SetParamSet(ctx, &params)
+	})
 }
 
 func (k *Keeper) SubscribeParamChange(hub types.ParamChangePublisher) {
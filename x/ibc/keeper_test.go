@@ -1,6 +1,8 @@
 package ibc
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -14,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/sidechain"
+	sTypes "github.com/cosmos/cosmos-sdk/x/sidechain/types"
 )
 
 func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper) {
@@ -23,6 +26,10 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper) {
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(keyIBC, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keySideChain, sdk.StoreTypeIAVL, db)
+	keyParams := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -30,8 +37,6 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper) {
 	if isCheckTx {
 		mode = sdk.RunTxModeCheck
 	}
-	keyParams := sdk.NewKVStoreKey("params")
-	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
 	cdc := createTestCodec()
 	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
@@ -117,9 +122,165 @@ func TestKeeper(t *testing.T) {
 
 }
 
+func TestChannelStatsAndCleanup(t *testing.T) {
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.IBCPackageCleanup, 1)
+	sdk.UpgradeMgr.SetHeight(1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.IBCPackageCleanup, 0)
+
+	destChainName := "bsc"
+	destChainID := sdk.ChainID(0x000f)
+	channelName := "transfer"
+	channelID := sdk.ChannelID(0x01)
+
+	ctx, keeper := createTestInput(t, false)
+	keeper.sideKeeper.SetSrcChainID(sdk.ChainID(0x0001))
+	require.NoError(t, keeper.sideKeeper.RegisterDestChain(destChainName, destChainID))
+	require.NoError(t, keeper.sideKeeper.RegisterChannel(channelName, channelID, nil))
+	require.NoError(t, keeper.sideKeeper.RegisterChannel("mockChannel", sdk.ChannelID(2), nil))
+	keeper.sideKeeper.SetChannelSendPermission(ctx, destChainID, channelID, sdk.ChannelAllow)
+	storePrefix := []byte{0x99}
+	keeper.sideKeeper.SetSideChainIdAndStorePrefix(ctx, destChainName, storePrefix)
+
+	for i := 0; i < 5; i++ {
+		_, err := keeper.CreateRawIBCPackage(ctx, destChainName, channelName, sdk.SynCrossChainPackageType, []byte{0x00, 0x01}, *big.NewInt(100))
+		require.NoError(t, err)
+	}
+
+	stats := keeper.GetAllChannelStats(ctx)
+	require.Len(t, stats, 2)
+	require.Equal(t, ChannelStats{
+		DestChainID:    destChainID,
+		DestChainName:  destChainName,
+		ChannelID:      channelID,
+		ChannelName:    channelName,
+		SendSequence:   5,
+		LowestSequence: 0,
+		Backlog:        5,
+		StoredBytes:    5 * (sTypes.PackageHeaderLength + 2),
+	}, stats[0])
+	require.Equal(t, int64(0), stats[1].Backlog)
+
+	// cleanup is disabled by default
+	EndBlocker(ctx, keeper)
+	require.Equal(t, int64(5), keeper.GetChannelStats(ctx, destChainID, channelID).Backlog)
+
+	// the unconfirmed packages are kept
+	keeper.SetParams(ctx.WithSideChainKeyPrefix(storePrefix), Params{RelayerFee: DefaultRelayerFeeParam, PackageRetention: 3})
+	require.Equal(t, int64(3), keeper.GetPackageRetention(ctx, destChainName))
+	EndBlocker(ctx, keeper)
+	require.Equal(t, int64(5), keeper.GetChannelStats(ctx, destChainID, channelID).Backlog)
+
+	// the ack packages are skipped by the confirmations
+	_, sdkErr := keeper.CreateRawIBCPackage(ctx, destChainName, channelName, sdk.AckCrossChainPackageType, []byte{0x00, 0x01}, *big.NewInt(0))
+	require.NoError(t, sdkErr)
+	_, sdkErr = keeper.CreateRawIBCPackage(ctx, destChainName, channelName, sdk.SynCrossChainPackageType, []byte{0x00, 0x01}, *big.NewInt(100))
+	require.NoError(t, sdkErr)
+	keeper.ConfirmSynPackage(ctx, destChainID, channelID)
+	require.Equal(t, uint64(1), keeper.GetConfirmedSequence(ctx, destChainID, channelID))
+	EndBlocker(ctx, keeper)
+	channelStats := keeper.GetChannelStats(ctx, destChainID, channelID)
+	require.Equal(t, uint64(1), channelStats.LowestSequence)
+	require.Equal(t, int64(6), channelStats.Backlog)
+
+	// the latest PackageRetention packages are kept even if they are confirmed
+	for i := 0; i < 5; i++ {
+		keeper.ConfirmSynPackage(ctx, destChainID, channelID)
+	}
+	require.Equal(t, uint64(7), keeper.GetConfirmedSequence(ctx, destChainID, channelID))
+	keeper.ConfirmSynPackage(ctx, destChainID, channelID)
+	require.Equal(t, uint64(7), keeper.GetConfirmedSequence(ctx, destChainID, channelID))
+	EndBlocker(ctx, keeper)
+	channelStats = keeper.GetChannelStats(ctx, destChainID, channelID)
+	require.Equal(t, uint64(7), channelStats.SendSequence)
+	require.Equal(t, uint64(7), channelStats.ConfirmedSequence)
+	require.Equal(t, uint64(4), channelStats.LowestSequence)
+	require.Equal(t, int64(3), channelStats.Backlog)
+
+	// query through the querier
+	cdc := createTestCodec()
+	querier := NewQuerier(keeper, cdc)
+	bz, err := cdc.MarshalJSON(QueryChannelStatsParams{DestChainName: destChainName, ChannelName: channelName})
+	require.NoError(t, err)
+	res, sdkErr := querier(ctx, []string{QueryChannelStats}, abci.RequestQuery{Data: bz})
+	require.NoError(t, sdkErr)
+	var queried []ChannelStats
+	require.NoError(t, cdc.UnmarshalJSON(res, &queried))
+	require.Equal(t, []ChannelStats{channelStats}, queried)
+
+	bz, err = cdc.MarshalJSON(QueryChannelStatsParams{DestChainName: "btc"})
+	require.NoError(t, err)
+	_, sdkErr = querier(ctx, []string{QueryChannelStats}, abci.RequestQuery{Data: bz})
+	require.Error(t, sdkErr)
+}
+
 func createTestCodec() *codec.Codec {
 	cdc := codec.New()
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
 }
+
+func TestQueryPackage(t *testing.T) {
+	destChainID := sdk.ChainID(0x000f)
+	channelID := sdk.ChannelID(0x01)
+	rawChannelID := sdk.ChannelID(0x02)
+
+	ctx, keeper := createTestInput(t, true)
+	keeper.sideKeeper.SetSrcChainID(sdk.ChainID(0x0001))
+	require.NoError(t, keeper.sideKeeper.RegisterDestChain("bsc", destChainID))
+	require.NoError(t, keeper.sideKeeper.RegisterChannel("transfer", channelID, nil))
+	require.NoError(t, keeper.sideKeeper.RegisterChannel("raw", rawChannelID, nil))
+	require.NoError(t, keeper.sideKeeper.RegisterPayloadSchema(channelID, sTypes.PayloadSchema{
+		Syn: sTypes.RLPPayloadDecoder(sTypes.CommonAckPackage{}),
+	}))
+	require.Error(t, keeper.sideKeeper.RegisterPayloadSchema(channelID, sTypes.PayloadSchema{}))
+	keeper.sideKeeper.SetChannelSendPermission(ctx, destChainID, channelID, sdk.ChannelAllow)
+	keeper.sideKeeper.SetChannelSendPermission(ctx, destChainID, rawChannelID, sdk.ChannelAllow)
+
+	payload, err := sTypes.GenCommonAckPackage(7)
+	require.NoError(t, err)
+	for _, id := range []sdk.ChannelID{channelID, rawChannelID} {
+		_, sdkErr := keeper.CreateRawIBCPackageByIdWithFee(ctx, destChainID, id, sdk.SynCrossChainPackageType, payload, *big.NewInt(100))
+		require.Nil(t, sdkErr)
+	}
+	_, sdkErr := keeper.CreateRawIBCPackageByIdWithFee(ctx, destChainID, channelID, sdk.AckCrossChainPackageType, payload, *big.NewInt(0))
+	require.Nil(t, sdkErr)
+
+	cdc := createTestCodec()
+	querier := NewQuerier(keeper, cdc)
+	query := func(channelID sdk.ChannelID, sequence uint64) (map[string]interface{}, sdk.Error) {
+		bz, err := cdc.MarshalJSON(QueryPackageParams{DestChainId: destChainID, ChannelId: channelID, Sequence: sequence})
+		require.NoError(t, err)
+		res, sdkErr := querier(ctx, []string{QueryPackage}, abci.RequestQuery{Data: bz})
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		var decoded map[string]interface{}
+		require.NoError(t, json.Unmarshal(res, &decoded))
+		return decoded, nil
+	}
+
+	decoded, sdkErr := query(channelID, 0)
+	require.Nil(t, sdkErr)
+	require.Equal(t, "transfer", decoded["channel_name"])
+	require.Equal(t, "syn", decoded["package_type"])
+	require.Equal(t, "100", decoded["relayer_fee"])
+	require.Equal(t, map[string]interface{}{"Code": float64(7)}, decoded["payload"])
+	require.Nil(t, decoded["decode_error"])
+
+	// no schema for the ack packages of the channel
+	decoded, sdkErr = query(channelID, 1)
+	require.Nil(t, sdkErr)
+	require.Equal(t, "ack", decoded["package_type"])
+	require.Equal(t, hex.EncodeToString(payload), decoded["raw_payload"])
+	require.NotEmpty(t, decoded["decode_error"])
+
+	// no schema for the channel
+	decoded, sdkErr = query(rawChannelID, 0)
+	require.Nil(t, sdkErr)
+	require.Nil(t, decoded["payload"])
+	require.Equal(t, hex.EncodeToString(payload), decoded["raw_payload"])
+
+	_, sdkErr = query(channelID, 2)
+	require.Equal(t, CodePackageNotFound, sdkErr.Code())
+}
@@ -18,6 +18,8 @@ const (
 var (
 	PrefixForIbcPackageKey = []byte{0x00}
 	PrefixForSequenceKey   = []byte{0x01}
+
+	PrefixForConfirmedSequenceKey = []byte{0x02}
 )
 
 func buildIBCPackageKey(srcChainID, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) []byte {
@@ -41,4 +43,19 @@ func buildIBCPackageKeyPrefix(srcChainID, destChainID sdk.ChainID, channelID sdk
 	copy(key[prefixLength+srcChainIdLength+destChainIDLength:], []byte{byte(channelID)})
 
 	return key
-}
\ No newline at end of file
+}
+
+func buildConfirmedSequenceKey(destChainID sdk.ChainID, channelID sdk.ChannelID) []byte {
+	key := make([]byte, prefixLength+destChainIDLength+channelIDLength)
+
+	copy(key[:prefixLength], PrefixForConfirmedSequenceKey)
+	binary.BigEndian.PutUint16(key[prefixLength:prefixLength+destChainIDLength], uint16(destChainID))
+	copy(key[prefixLength+destChainIDLength:], []byte{byte(channelID)})
+
+	return key
+}
+
+// GetIBCPackageKey returns the store key of an outgoing package, relayers use it to query the package with proof.
+func GetIBCPackageKey(srcChainID, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) []byte {
+	return buildIBCPackageKey(srcChainID, destChainID, channelID, sequence)
+}
@@ -3,6 +3,7 @@ package ibc
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -10,19 +11,26 @@ const (
 	DefaultRelayerFeeParam int64 = 1e6 // decimal is 8
 	// Default parameter namespace
 	DefaultParamspace = "ibc"
+
+	// MinPackageRetention is the minimum number of the latest packages kept for each channel
+	// if the automatic cleanup is enabled.
+	MinPackageRetention int64 = 1000
 )
 
 var (
-	ParamRelayerFee = []byte("relayerFee")
+	ParamRelayerFee       = []byte("relayerFee")
+	ParamPackageRetention = []byte("packageRetention")
 )
 
 type Params struct {
-	RelayerFee int64 `json:"relayer_fee"`
+	RelayerFee       int64 `json:"relayer_fee"`
+	PackageRetention int64 `json:"package_retention"` // number of the latest packages kept for each channel, 0 disables the cleanup
 }
 
 func (p *Params) KeyValuePairs() params.KeyValuePairs {
 	return params.KeyValuePairs{
 		{ParamRelayerFee, &p.RelayerFee},
+		{ParamPackageRetention, &p.PackageRetention},
 	}
 }
 
@@ -30,9 +38,24 @@ func (p *Params) UpdateCheck() error {
 	if p.RelayerFee <= 0 {
 		return fmt.Errorf("the syn_package_fee should be greater than 0")
 	}
+	if sdk.IsUpgrade(sdk.IBCPackageCleanup) && p.PackageRetention != 0 && p.PackageRetention < MinPackageRetention {
+		return fmt.Errorf("the package_retention should be 0 or not less than %d", MinPackageRetention)
+	}
 	return nil
 }
 
 func (p *Params) GetParamAttribute() (string, bool) {
 	return "ibc", false
 }
+
+// in order to be compatible with before
+type paramsBeforeIBCPackageCleanup struct {
+	RelayerFee int64 `json:"relayer_fee"`
+}
+
+// Implements params.ParamSet
+func (p *paramsBeforeIBCPackageCleanup) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{ParamRelayerFee, &p.RelayerFee},
+	}
+}
@@ -0,0 +1,104 @@
+package ibc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the ibc Querier
+const (
+	QueryChannelStats = "channelStats"
+	QueryPackage      = "package"
+)
+
+// Params for query 'custom/ibc/channelStats', all the registered destination chains and channels
+// are queried if DestChainName is empty
+type QueryChannelStatsParams struct {
+	DestChainName string `json:"dest_chain_name"`
+	ChannelName   string `json:"channel_name"`
+}
+
+// Params for query 'custom/ibc/package', the stored package is returned with its payload decoded
+type QueryPackageParams struct {
+	DestChainId sdk.ChainID   `json:"dest_chain_id"`
+	ChannelId   sdk.ChannelID `json:"channel_id"`
+	Sequence    uint64        `json:"sequence"`
+}
+
+func NewQuerier(keeper Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryChannelStats:
+			var params QueryChannelStatsParams
+			if len(req.Data) != 0 {
+				err := cdc.UnmarshalJSON(req.Data, &params)
+				if err != nil {
+					return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+				}
+			}
+			return queryChannelStats(ctx, cdc, keeper, params)
+		case QueryPackage:
+			var params QueryPackageParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			return queryPackage(ctx, keeper, params)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown ibc query endpoint")
+		}
+	}
+}
+
+func queryChannelStats(ctx sdk.Context, cdc *codec.Codec, keeper Keeper, params QueryChannelStatsParams) ([]byte, sdk.Error) {
+	var stats []ChannelStats
+	if params.DestChainName == "" {
+		stats = keeper.GetAllChannelStats(ctx)
+	} else {
+		destChainID, err := keeper.sideKeeper.GetDestChainID(ctx, params.DestChainName)
+		if err != nil {
+			return nil, ErrInvalidChainId(DefaultCodespace, err.Error())
+		}
+		channelIDs := keeper.sideKeeper.GetChannelIDs(ctx)
+		if params.ChannelName != "" {
+			channelID, err := keeper.sideKeeper.GetChannelID(ctx, params.ChannelName)
+			if err != nil {
+				return nil, ErrInvalidChannel(DefaultCodespace, err.Error())
+			}
+			channelIDs = []sdk.ChannelID{channelID}
+		}
+		stats = make([]ChannelStats, 0, len(channelIDs))
+		for _, channelID := range channelIDs {
+			stats = append(stats, keeper.GetChannelStats(ctx, destChainID, channelID))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, stats)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryPackage(ctx sdk.Context, keeper Keeper, params QueryPackageParams) ([]byte, sdk.Error) {
+	bz, err := keeper.GetIBCPackageById(ctx, params.DestChainId, params.ChannelId, params.Sequence)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	if bz == nil {
+		return nil, ErrPackageNotFound(DefaultCodespace, fmt.Sprintf("package %d of channel %d to chain %d is not found",
+			params.Sequence, params.ChannelId, params.DestChainId))
+	}
+
+	// the decoded payloads are arbitrary types which are not registered in the codec
+	res, err := json.MarshalIndent(keeper.sideKeeper.DecodePackage(ctx, params.ChannelId, params.Sequence, bz), "", "  ")
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
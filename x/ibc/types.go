@@ -19,3 +19,16 @@ func newPackageCollector() *packageCollector {
 		collectedPackages: nil,
 	}
 }
+
+// ChannelStats is the sequence and backlog statistics of the packages sent to a destination chain through a channel
+type ChannelStats struct {
+	DestChainID       sdk.ChainID   `json:"dest_chain_id"`
+	DestChainName     string        `json:"dest_chain_name"`
+	ChannelID         sdk.ChannelID `json:"channel_id"`
+	ChannelName       string        `json:"channel_name"`
+	SendSequence      uint64        `json:"send_sequence"`      // sequence of the next package to send, which is also the number of packages sent
+	ConfirmedSequence uint64        `json:"confirmed_sequence"` // sequence of the first package not confirmed by an ack of the destination chain
+	LowestSequence    uint64        `json:"lowest_sequence"`    // lowest sequence not cleaned up yet, only meaningful if Backlog is not 0
+	Backlog           int64         `json:"backlog"`            // number of the packages still stored
+	StoredBytes       int64         `json:"stored_bytes"`       // total size of the packages still stored
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func AddCommands(root *cobra.Command, cdc *codec.Codec) {
+	lightClientCmd := &cobra.Command{
+		Use:   "lightclient",
+		Short: "light clients of the side chains",
+	}
+
+	lightClientCmd.AddCommand(
+		client.PostCommands(
+			GetCmdSyncHeaders(cdc),
+			GetCmdCreateClient(cdc),
+			GetCmdSubmitRegistrationProposal(cdc),
+		)...)
+
+	lightClientCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQuerySnapshot(cdc),
+			GetCmdQueryTrustedHeader(cdc),
+		)...)
+
+	root.AddCommand(lightClientCmd)
+}
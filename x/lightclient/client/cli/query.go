@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/lightclient"
+)
+
+const (
+	flagSideChainId  = "side-chain-id"
+	flagHeight       = "height"
+	flagHeaders      = "headers"
+	flagHeadersFile  = "headers-file"
+	flagCheckpoint   = "checkpoint"
+	flagEpoch        = "epoch"
+	flagTitle        = "title"
+	flagDeposit      = "deposit"
+	flagVotingPeriod = "voting-period"
+	lightClientRoute = "lightclient"
+)
+
+// GetCmdQuerySnapshot implements the command to query the snapshot at the latest trusted header of a side chain.
+func GetCmdQuerySnapshot(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Query the validators and the latest trusted header of the light client of a side chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(lightclient.QuerySnapshotParams{SideChainId: viper.GetString(flagSideChainId)})
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", lightClientRoute, lightclient.QuerySnapshot), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagSideChainId, "", "chain-id of the side chain")
+	return cmd
+}
+
+// GetCmdQueryTrustedHeader implements the command to query a trusted header of a side chain.
+func GetCmdQueryTrustedHeader(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "header",
+		Short: "Query the header of a side chain trusted by the light client at the height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := lightclient.QueryTrustedHeaderParams{
+				SideChainId: viper.GetString(flagSideChainId),
+				Height:      viper.GetUint64(flagHeight),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", lightClientRoute, lightclient.QueryTrustedHeader), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagSideChainId, "", "chain-id of the side chain")
+	cmd.Flags().Uint64(flagHeight, 0, "height of the header")
+	return cmd
+}
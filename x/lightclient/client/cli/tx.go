@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/lightclient"
+)
+
+// GetCmdSyncHeaders implements the command to sync the headers of a side chain to its light client.
+func GetCmdSyncHeaders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-headers",
+		Short: "sync the consecutive headers following the latest trusted header of a side chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			sideChainId := viper.GetString(flagSideChainId)
+			if len(sideChainId) == 0 {
+				return fmt.Errorf("%s is required", flagSideChainId)
+			}
+
+			var headersBytes []byte
+			if filePath := viper.GetString(flagHeadersFile); filePath != "" {
+				headersBytes, err = os.ReadFile(filePath)
+				if err != nil {
+					return err
+				}
+			} else {
+				headersBytes = []byte(viper.GetString(flagHeaders))
+				if len(headersBytes) == 0 {
+					return fmt.Errorf("either %s or %s is required", flagHeadersFile, flagHeaders)
+				}
+			}
+			headers := make([]bsc.Header, 0)
+			if err := json.Unmarshal(headersBytes, &headers); err != nil {
+				return err
+			}
+
+			msg := lightclient.NewMsgSyncHeaders(from, sideChainId, headers)
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagSideChainId, "", "chain-id of the side chain")
+	cmd.Flags().String(flagHeaders, "", "consecutive headers with json format of the side chain")
+	cmd.Flags().String(flagHeadersFile, "", "File of the headers, if headers-file is not empty, --headers will be ignored")
+	return cmd
+}
+
+// GetCmdCreateClient implements the command to create the light client of a registered side chain.
+func GetCmdCreateClient(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-client",
+		Short: "create the light client of a registered side chain from the registered checkpoint header",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			sideChainId := viper.GetString(flagSideChainId)
+			if len(sideChainId) == 0 {
+				return fmt.Errorf("%s is required", flagSideChainId)
+			}
+			var checkpoint bsc.Header
+			if err := json.Unmarshal([]byte(viper.GetString(flagCheckpoint)), &checkpoint); err != nil {
+				return err
+			}
+
+			msg := lightclient.NewMsgCreateClient(from, sideChainId, checkpoint)
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagSideChainId, "", "chain-id of the side chain")
+	cmd.Flags().String(flagCheckpoint, "", "checkpoint header with json format of the side chain")
+	return cmd
+}
+
+// GetCmdSubmitRegistrationProposal implements the command to submit a proposal to register a side chain to the light client.
+func GetCmdSubmitRegistrationProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-registration-proposal",
+		Short: "Submit a proposal to register a side chain to be tracked by the light client from a checkpoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+
+			var checkpointHash bsc.Hash
+			if err := checkpointHash.UnmarshalText([]byte(viper.GetString(flagCheckpoint))); err != nil {
+				return err
+			}
+			registration := lightclient.ClientRegistration{
+				SideChainId:    viper.GetString(flagSideChainId),
+				CheckpointHash: checkpointHash,
+				Epoch:          viper.GetUint64(flagEpoch),
+			}
+			registrationBz, err := json.Marshal(registration)
+			if err != nil {
+				return err
+			}
+
+			votingPeriodInSeconds := viper.GetInt64(flagVotingPeriod)
+			if votingPeriodInSeconds <= 0 {
+				return errors.New("voting period should be positive")
+			}
+			votingPeriod := time.Duration(votingPeriodInSeconds) * time.Second
+			if votingPeriod > gov.MaxVotingPeriod {
+				return fmt.Errorf("voting period should less than %d seconds", gov.MaxVotingPeriod/time.Second)
+			}
+
+			msg := gov.NewMsgSubmitProposal(viper.GetString(flagTitle), string(registrationBz), gov.ProposalTypeRegisterLightClient, from, amount, votingPeriod)
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagSideChainId, "", "chain-id of the side chain")
+	cmd.Flags().String(flagCheckpoint, "", "hash of the checkpoint header the light client is created from")
+	cmd.Flags().Uint64(flagEpoch, bsc.DefaultEpoch, "epoch length of the side chain")
+	cmd.Flags().String(flagTitle, "", "title of proposal")
+	cmd.Flags().Int64(flagVotingPeriod, 7*24*60*60, "voting period in seconds")
+	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+	return cmd
+}
@@ -0,0 +1,50 @@
+package lightclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = 32
+
+	CodeInvalidHeaders      sdk.CodeType = 101
+	CodeClientNotFound      sdk.CodeType = 102
+	CodeClientExists        sdk.CodeType = 103
+	CodeInvalidCheckpoint   sdk.CodeType = 104
+	CodeTooManyHeaders      sdk.CodeType = 105
+	CodeHeaderNotFound      sdk.CodeType = 106
+	CodeInvalidSideChainId  sdk.CodeType = 107
+	CodeClientNotRegistered sdk.CodeType = 108
+)
+
+func ErrInvalidHeaders(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHeaders, msg)
+}
+
+func ErrClientNotFound(codespace sdk.CodespaceType, sideChainId string) sdk.Error {
+	return sdk.NewError(codespace, CodeClientNotFound, "light client of side chain %s is not found", sideChainId)
+}
+
+func ErrClientExists(codespace sdk.CodespaceType, sideChainId string) sdk.Error {
+	return sdk.NewError(codespace, CodeClientExists, "light client of side chain %s already exists", sideChainId)
+}
+
+func ErrInvalidCheckpoint(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCheckpoint, msg)
+}
+
+func ErrTooManyHeaders(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyHeaders, "at most %d headers can be synced at a time", MaxSyncHeaders)
+}
+
+func ErrHeaderNotFound(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeHeaderNotFound, msg)
+}
+
+func ErrInvalidSideChainId(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSideChainId, msg)
+}
+
+func ErrClientNotRegistered(codespace sdk.CodespaceType, sideChainId string) sdk.Error {
+	return sdk.NewError(codespace, CodeClientNotRegistered, "side chain %s is not registered to the light client", sideChainId)
+}
@@ -0,0 +1,93 @@
+package lightclient
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClientState is the state of the light client of a side chain
+type ClientState struct {
+	SideChainId string          `json:"side_chain_id"`
+	Snapshot    bsc.Snapshot    `json:"snapshot"`
+	Headers     []TrustedHeader `json:"headers"`
+	// ValidatorSets are the validator sets recorded, the one of the snapshot is recorded if it is empty
+	ValidatorSets []ValidatorSet `json:"validator_sets"`
+	// BranchSnapshots are the snapshots at the unconfirmed headers, the one of the snapshot is kept if it is empty
+	BranchSnapshots []bsc.Snapshot `json:"branch_snapshots"`
+}
+
+// GenesisState - all the light clients
+type GenesisState struct {
+	Clients []ClientState `json:"clients"`
+	// Registrations are the side chains registered to be tracked, the side chains of the clients are always registered
+	Registrations []ClientRegistration `json:"registrations"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, registration := range data.Registrations {
+		keeper.setRegistration(ctx, registration)
+	}
+	for _, client := range data.Clients {
+		if keeper.IsTracking(ctx, client.SideChainId) {
+			panic(fmt.Sprintf("duplicated light client of side chain %s", client.SideChainId))
+		}
+		snapshot := client.Snapshot
+		if !keeper.IsRegistered(ctx, client.SideChainId) {
+			keeper.setRegistration(ctx, ClientRegistration{SideChainId: client.SideChainId, CheckpointHash: snapshot.Hash, Epoch: snapshot.Epoch})
+		}
+		keeper.setSnapshot(ctx, client.SideChainId, &snapshot)
+		for _, header := range client.Headers {
+			keeper.setTrustedHeader(ctx, client.SideChainId, header)
+		}
+		validatorSets := client.ValidatorSets
+		if len(validatorSets) == 0 {
+			validatorSets = []ValidatorSet{{Height: snapshot.Number, Validators: snapshot.Validators}}
+		}
+		for _, validatorSet := range validatorSets {
+			keeper.setValidatorSet(ctx, client.SideChainId, validatorSet)
+		}
+		branchSnapshots := client.BranchSnapshots
+		if len(branchSnapshots) == 0 {
+			branchSnapshots = []bsc.Snapshot{snapshot}
+		}
+		for i := range branchSnapshots {
+			keeper.setBranchSnapshot(ctx, client.SideChainId, &branchSnapshots[i])
+		}
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	var clients []ClientState
+	keeper.IterateSnapshots(ctx, func(sideChainId string, snapshot bsc.Snapshot) bool {
+		client := ClientState{
+			SideChainId: sideChainId,
+			Snapshot:    snapshot,
+		}
+		keeper.IterateTrustedHeaders(ctx, sideChainId, func(header TrustedHeader) bool {
+			client.Headers = append(client.Headers, header)
+			return false
+		})
+		keeper.IterateValidatorSets(ctx, sideChainId, func(validatorSet ValidatorSet) bool {
+			client.ValidatorSets = append(client.ValidatorSets, validatorSet)
+			return false
+		})
+		keeper.IterateBranchSnapshots(ctx, sideChainId, func(snapshot bsc.Snapshot) bool {
+			client.BranchSnapshots = append(client.BranchSnapshots, snapshot)
+			return false
+		})
+		clients = append(clients, client)
+		return false
+	})
+	var registrations []ClientRegistration
+	keeper.IterateRegistrations(ctx, func(registration ClientRegistration) bool {
+		registrations = append(registrations, registration)
+		return false
+	})
+	return GenesisState{Clients: clients, Registrations: registrations}
+}
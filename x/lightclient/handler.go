@@ -0,0 +1,47 @@
+package lightclient
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSyncHeaders:
+			return handleMsgSyncHeaders(ctx, msg, k)
+		case MsgCreateClient:
+			return handleMsgCreateClient(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in lightclient module").Result()
+		}
+	}
+}
+
+func handleMsgSyncHeaders(ctx sdk.Context, msg MsgSyncHeaders, k Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.BscLightClient) {
+		return sdk.ErrMsgNotSupported("MsgSyncHeaders is not supported before the BscLightClient upgrade").Result()
+	}
+	snapshot, err := k.SyncHeaders(ctx, msg.SideChainId, msg.Headers)
+	if err != nil {
+		return err.Result()
+	}
+	tags := sdk.NewTags("sideChainId", []byte(msg.SideChainId), "height", []byte(strconv.FormatUint(snapshot.Number, 10)))
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgCreateClient(ctx sdk.Context, msg MsgCreateClient, k Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.BscLightClient) {
+		return sdk.ErrMsgNotSupported("MsgCreateClient is not supported before the BscLightClient upgrade").Result()
+	}
+	if err := k.CreateRegisteredClient(ctx, msg.SideChainId, &msg.Checkpoint); err != nil {
+		return err.Result()
+	}
+	tags := sdk.NewTags("sideChainId", []byte(msg.SideChainId), "height", []byte(strconv.FormatInt(msg.Checkpoint.Number, 10)))
+	return sdk.Result{
+		Tags: tags,
+	}
+}
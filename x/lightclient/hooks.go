@@ -0,0 +1,35 @@
+package lightclient
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+type RegistrationHooks struct {
+	k Keeper
+}
+
+func NewRegistrationHook(keeper Keeper) RegistrationHooks {
+	return RegistrationHooks{keeper}
+}
+
+var _ gov.GovHooks = RegistrationHooks{}
+
+func (hooks RegistrationHooks) OnProposalSubmitted(ctx sdk.Context, proposal gov.Proposal) error {
+	if proposal.GetProposalType() != gov.ProposalTypeRegisterLightClient {
+		panic(fmt.Sprintf("received wrong type of proposal %x", proposal.GetProposalType()))
+	}
+	if !sdk.IsUpgrade(sdk.BscLightClient) {
+		return fmt.Errorf("%s proposal is not supported before the %s upgrade", proposal.GetProposalType(), sdk.BscLightClient)
+	}
+
+	var registration ClientRegistration
+	err := json.Unmarshal([]byte(proposal.GetDescription()), &registration)
+	if err != nil {
+		return fmt.Errorf("get broken data when unmarshal ClientRegistration msg, err %v", err)
+	}
+	return hooks.k.ValidateClientRegistration(ctx, registration)
+}
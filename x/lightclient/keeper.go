@@ -0,0 +1,399 @@
+package lightclient
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// Keeper of the light clients of the BSC-like side chains, which follow the Parlia consensus.
+// The headers synced are verified against the validator set of the snapshot and trusted once they are confirmed.
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       *codec.Codec
+	codespace sdk.CodespaceType
+
+	govKeeper *gov.Keeper
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		codespace: codespace,
+	}
+}
+
+func (k *Keeper) SetGovKeeper(govKeeper *gov.Keeper) {
+	k.govKeeper = govKeeper
+}
+
+// CreateRegisteredClient creates the light client of the registered side chain from the checkpoint header
+// of the registered hash
+func (k Keeper) CreateRegisteredClient(ctx sdk.Context, sideChainId string, checkpoint *bsc.Header) sdk.Error {
+	registration, found := k.GetRegistration(ctx, sideChainId)
+	if !found {
+		return ErrClientNotRegistered(k.codespace, sideChainId)
+	}
+	if checkpoint.Hash() != registration.CheckpointHash {
+		return ErrInvalidCheckpoint(k.codespace, fmt.Sprintf("the hash of the checkpoint mismatches the registered %s", registration.CheckpointHash.Hex()))
+	}
+	return k.CreateClient(ctx, sideChainId, checkpoint, registration.Epoch)
+}
+
+// CreateClient creates the light client of the side chain trusting the checkpoint header,
+// the side chain is registered to be tracked since then
+func (k Keeper) CreateClient(ctx sdk.Context, sideChainId string, checkpoint *bsc.Header, epoch uint64) sdk.Error {
+	if k.IsTracking(ctx, sideChainId) {
+		return ErrClientExists(k.codespace, sideChainId)
+	}
+	snapshot, err := bsc.NewSnapshot(checkpoint, epoch)
+	if err != nil {
+		return ErrInvalidCheckpoint(k.codespace, err.Error())
+	}
+	k.setRegistration(ctx, ClientRegistration{SideChainId: sideChainId, CheckpointHash: checkpoint.Hash(), Epoch: epoch})
+	k.setSnapshot(ctx, sideChainId, snapshot)
+	k.setBranchSnapshot(ctx, sideChainId, snapshot)
+	k.setTrustedHeader(ctx, sideChainId, NewTrustedHeader(checkpoint))
+	k.setValidatorSet(ctx, sideChainId, ValidatorSet{Height: snapshot.Number, Validators: snapshot.Validators})
+	return nil
+}
+
+// SyncHeaders verifies the headers following the latest snapshot of the side chain and keeps them, they are trusted
+// once confirmed by ConfirmationDepth blocks. The headers can also fork from an unconfirmed header, they replace the
+// synced branch above it if their total difficulty is higher, so that the blocks signed out of turn by a few
+// validators can not lock the light client on a fork.
+func (k Keeper) SyncHeaders(ctx sdk.Context, sideChainId string, headers []bsc.Header) (*bsc.Snapshot, sdk.Error) {
+	if len(headers) == 0 {
+		return nil, ErrInvalidHeaders(k.codespace, "no headers to sync")
+	}
+	if len(headers) > MaxSyncHeaders {
+		return nil, ErrTooManyHeaders(k.codespace)
+	}
+	snapshot, found := k.GetSnapshot(ctx, sideChainId)
+	if !found {
+		return nil, ErrClientNotFound(k.codespace, sideChainId)
+	}
+	parent := snapshot
+	if first := headers[0].Number; first > 0 && uint64(first) <= snapshot.Number {
+		if parent, found = k.getBranchSnapshot(ctx, sideChainId, uint64(first)-1); !found {
+			return nil, ErrInvalidHeaders(k.codespace, fmt.Sprintf("the parent of block %d is confirmed, the branch can not be replaced", first))
+		}
+	}
+
+	// the headers are applied one by one to record the validator set changes
+	toApply := make([]*bsc.Header, 0, len(headers))
+	snapshots := make([]*bsc.Snapshot, 0, len(headers))
+	var validatorSets []ValidatorSet
+	var difficulty int64
+	newSnapshot := parent
+	for i := range headers {
+		header := &headers[i]
+		applied, err := newSnapshot.Apply([]*bsc.Header{header})
+		if err != nil {
+			return nil, ErrInvalidHeaders(k.codespace, err.Error())
+		}
+		if !equalValidators(newSnapshot.Validators, applied.Validators) {
+			validatorSets = append(validatorSets, ValidatorSet{Height: applied.Number, Validators: applied.Validators})
+		}
+		toApply = append(toApply, header)
+		snapshots = append(snapshots, applied)
+		difficulty += header.Difficulty
+		newSnapshot = applied
+	}
+
+	if parent.Number < snapshot.Number {
+		if difficulty <= k.branchDifficulty(ctx, sideChainId, parent.Number, snapshot.Number) {
+			return nil, ErrInvalidHeaders(k.codespace, fmt.Sprintf("the branch from block %d is not heavier than the synced one", parent.Number+1))
+		}
+		k.removeBranch(ctx, sideChainId, parent.Number, snapshot.Number)
+	}
+
+	for _, validatorSet := range validatorSets {
+		k.setValidatorSet(ctx, sideChainId, validatorSet)
+	}
+	for i, header := range toApply {
+		k.setTrustedHeader(ctx, sideChainId, NewTrustedHeader(header))
+		k.setBranchSnapshot(ctx, sideChainId, snapshots[i])
+		if header.Number >= 0 && uint64(header.Number) >= TrustedHeaderWindow {
+			k.deleteTrustedHeader(ctx, sideChainId, uint64(header.Number)-TrustedHeaderWindow)
+		}
+	}
+	k.pruneBranchSnapshots(ctx, sideChainId, newSnapshot.Number)
+	k.setSnapshot(ctx, sideChainId, newSnapshot)
+	ctx.Logger().Debug(fmt.Sprintf("light client of %s synced to block %d", sideChainId, newSnapshot.Number), "module", "lightclient")
+	return newSnapshot, nil
+}
+
+// branchDifficulty returns the total difficulty of the synced headers in the range (from, to]
+func (k Keeper) branchDifficulty(ctx sdk.Context, sideChainId string, from, to uint64) (difficulty int64) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(GetTrustedHeaderKey(sideChainId, from+1), GetTrustedHeaderKey(sideChainId, to+1))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var header TrustedHeader
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &header)
+		difficulty += header.Difficulty
+	}
+	return difficulty
+}
+
+// removeBranch removes the synced headers in the range (from, to] with their snapshots and validator sets
+func (k Keeper) removeBranch(ctx sdk.Context, sideChainId string, from, to uint64) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	for _, keyOf := range []func(string, uint64) []byte{GetTrustedHeaderKey, GetBranchSnapshotKey, GetValidatorSetKey} {
+		iterator := store.Iterator(keyOf(sideChainId, from+1), keyOf(sideChainId, to+1))
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IsConfirmed returns whether the synced header of the side chain at the height is confirmed by ConfirmationDepth blocks
+func (k Keeper) IsConfirmed(ctx sdk.Context, sideChainId string, height uint64) bool {
+	snapshot, found := k.GetSnapshot(ctx, sideChainId)
+	return found && height+ConfirmationDepth <= snapshot.Number
+}
+
+// IsTracking returns whether the light client of the side chain is created
+func (k Keeper) IsTracking(ctx sdk.Context, sideChainId string) bool {
+	return ctx.KVStore(k.storeKey).Has(GetSnapshotKey(sideChainId))
+}
+
+// IsRegistered returns whether the side chain is registered to be tracked by the light client,
+// the evidence of a registered side chain is rejected while its light client is missing
+func (k Keeper) IsRegistered(ctx sdk.Context, sideChainId string) bool {
+	return ctx.KVStore(k.storeKey).Has(GetRegistrationKey(sideChainId))
+}
+
+// GetRegistration returns the registration of the side chain, which decides the checkpoint its light client is created from
+func (k Keeper) GetRegistration(ctx sdk.Context, sideChainId string) (ClientRegistration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetRegistrationKey(sideChainId))
+	if bz == nil {
+		return ClientRegistration{}, false
+	}
+	var registration ClientRegistration
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &registration)
+	return registration, true
+}
+
+func (k Keeper) setRegistration(ctx sdk.Context, registration ClientRegistration) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(registration)
+	ctx.KVStore(k.storeKey).Set(GetRegistrationKey(registration.SideChainId), bz)
+}
+
+// IterateRegistrations iterates the registrations of the side chains to be tracked by the light client
+func (k Keeper) IterateRegistrations(ctx sdk.Context, fn func(registration ClientRegistration) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), RegistrationKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var registration ClientRegistration
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &registration)
+		if fn(registration) {
+			return
+		}
+	}
+}
+
+// GetSnapshot returns the snapshot at the latest trusted header of the side chain
+func (k Keeper) GetSnapshot(ctx sdk.Context, sideChainId string) (*bsc.Snapshot, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetSnapshotKey(sideChainId))
+	if bz == nil {
+		return nil, false
+	}
+	var snapshot bsc.Snapshot
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &snapshot)
+	return &snapshot, true
+}
+
+func (k Keeper) setSnapshot(ctx sdk.Context, sideChainId string, snapshot *bsc.Snapshot) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(*snapshot)
+	ctx.KVStore(k.storeKey).Set(GetSnapshotKey(sideChainId), bz)
+}
+
+// getBranchSnapshot returns the snapshot at the unconfirmed header of the side chain at the height, from which the
+// synced branch can be replaced
+func (k Keeper) getBranchSnapshot(ctx sdk.Context, sideChainId string, height uint64) (*bsc.Snapshot, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetBranchSnapshotKey(sideChainId, height))
+	if bz == nil {
+		return nil, false
+	}
+	var snapshot bsc.Snapshot
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &snapshot)
+	return &snapshot, true
+}
+
+func (k Keeper) setBranchSnapshot(ctx sdk.Context, sideChainId string, snapshot *bsc.Snapshot) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(*snapshot)
+	ctx.KVStore(k.storeKey).Set(GetBranchSnapshotKey(sideChainId, snapshot.Number), bz)
+}
+
+// pruneBranchSnapshots removes the snapshots below the lowest header which can still be replaced, the parent of the
+// lowest unconfirmed header is kept
+func (k Keeper) pruneBranchSnapshots(ctx sdk.Context, sideChainId string, latest uint64) {
+	if latest <= ConfirmationDepth {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(sideChainIdKey(BranchSnapshotKey, sideChainId), GetBranchSnapshotKey(sideChainId, latest-ConfirmationDepth))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateBranchSnapshots iterates the snapshots at the unconfirmed headers of the side chain by height
+func (k Keeper) IterateBranchSnapshots(ctx sdk.Context, sideChainId string, fn func(snapshot bsc.Snapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), sideChainIdKey(BranchSnapshotKey, sideChainId))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot bsc.Snapshot
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &snapshot)
+		if fn(snapshot) {
+			return
+		}
+	}
+}
+
+// GetTrustedHeader returns the synced header of the side chain at the height, which may not be confirmed yet,
+// only the latest TrustedHeaderWindow headers are kept
+func (k Keeper) GetTrustedHeader(ctx sdk.Context, sideChainId string, height uint64) (TrustedHeader, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetTrustedHeaderKey(sideChainId, height))
+	if bz == nil {
+		return TrustedHeader{}, false
+	}
+	var header TrustedHeader
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &header)
+	return header, true
+}
+
+// TrustedHeaderHash returns the hash of the trusted header of the side chain at the height, it must be confirmed
+func (k Keeper) TrustedHeaderHash(ctx sdk.Context, sideChainId string, height uint64) ([]byte, bool) {
+	if !k.IsConfirmed(ctx, sideChainId, height) {
+		return nil, false
+	}
+	header, found := k.GetTrustedHeader(ctx, sideChainId, height)
+	if !found {
+		return nil, false
+	}
+	return header.Hash.Bytes(), true
+}
+
+// TrustedReceiptHash returns the receipt root of the trusted header of the side chain at the height, it must be confirmed
+func (k Keeper) TrustedReceiptHash(ctx sdk.Context, sideChainId string, height uint64) (bsc.Hash, bool) {
+	if !k.IsConfirmed(ctx, sideChainId, height) {
+		return bsc.Hash{}, false
+	}
+	header, found := k.GetTrustedHeader(ctx, sideChainId, height)
+	if !found {
+		return bsc.Hash{}, false
+	}
+	return header.ReceiptHash, true
+}
+
+// LatestHeight returns the height of the latest trusted header of the side chain
+func (k Keeper) LatestHeight(ctx sdk.Context, sideChainId string) (uint64, bool) {
+	snapshot, found := k.GetSnapshot(ctx, sideChainId)
+	if !found {
+		return 0, false
+	}
+	return snapshot.Number, true
+}
+
+func (k Keeper) setTrustedHeader(ctx sdk.Context, sideChainId string, header TrustedHeader) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(header)
+	ctx.KVStore(k.storeKey).Set(GetTrustedHeaderKey(sideChainId, header.Number), bz)
+}
+
+func (k Keeper) deleteTrustedHeader(ctx sdk.Context, sideChainId string, height uint64) {
+	ctx.KVStore(k.storeKey).Delete(GetTrustedHeaderKey(sideChainId, height))
+}
+
+// ValidatorSetAt returns the sorted consensus addresses of the validator set of the side chain in effect at the
+// height and the height it takes effect from, the height must be confirmed
+func (k Keeper) ValidatorSetAt(ctx sdk.Context, sideChainId string, height uint64) (from uint64, validators [][]byte, found bool) {
+	if !k.IsConfirmed(ctx, sideChainId, height) {
+		return 0, nil, false
+	}
+	prefix := sideChainIdKey(ValidatorSetKey, sideChainId)
+	iterator := ctx.KVStore(k.storeKey).ReverseIterator(prefix, GetValidatorSetKey(sideChainId, height+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, nil, false
+	}
+	var validatorSet ValidatorSet
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &validatorSet)
+	validators = make([][]byte, 0, len(validatorSet.Validators))
+	for _, val := range validatorSet.Validators {
+		validators = append(validators, val.Bytes())
+	}
+	return validatorSet.Height, validators, true
+}
+
+func (k Keeper) setValidatorSet(ctx sdk.Context, sideChainId string, validatorSet ValidatorSet) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(validatorSet)
+	ctx.KVStore(k.storeKey).Set(GetValidatorSetKey(sideChainId, validatorSet.Height), bz)
+}
+
+// IterateValidatorSets iterates the validator sets of the side chain by the height they take effect
+func (k Keeper) IterateValidatorSets(ctx sdk.Context, sideChainId string, fn func(validatorSet ValidatorSet) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), sideChainIdKey(ValidatorSetKey, sideChainId))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var validatorSet ValidatorSet
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &validatorSet)
+		if fn(validatorSet) {
+			return
+		}
+	}
+}
+
+func equalValidators(a, b []bsc.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IterateTrustedHeaders iterates the trusted headers of the side chain by height
+func (k Keeper) IterateTrustedHeaders(ctx sdk.Context, sideChainId string, fn func(header TrustedHeader) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), sideChainIdKey(TrustedHeaderKey, sideChainId))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var header TrustedHeader
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &header)
+		if fn(header) {
+			return
+		}
+	}
+}
+
+// IterateSnapshots iterates the snapshots of all the side chains tracked
+func (k Keeper) IterateSnapshots(ctx sdk.Context, fn func(sideChainId string, snapshot bsc.Snapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), SnapshotKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		sideChainId := string(iterator.Key()[len(SnapshotKey)+1:])
+		var snapshot bsc.Snapshot
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &snapshot)
+		if fn(sideChainId, snapshot) {
+			return
+		}
+	}
+}
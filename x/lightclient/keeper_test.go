@@ -0,0 +1,375 @@
+package lightclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyLightClient := sdk.NewKVStoreKey("lightclient")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyLightClient, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, sdk.RunTxModeDeliver, log.NewNopLogger())
+	return ctx, NewKeeper(cdc, keyLightClient, DefaultCodespace)
+}
+
+// genTestChain generates the headers from the genesis, which are signed in turn by the validators
+func genTestChain(t *testing.T, keys []*btcec.PrivateKey, count int) []bsc.Header {
+	addr := func(key *btcec.PrivateKey) []byte {
+		return bsc.Keccak256(key.PubKey().SerializeUncompressed()[1:])[12:]
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(addr(keys[i]), addr(keys[j])) < 0
+	})
+
+	headers := make([]bsc.Header, 0, count)
+	for i := 0; i < count; i++ {
+		header := bsc.Header{
+			Number:     int64(i),
+			Difficulty: bsc.DiffInTurn,
+			Time:       uint64(1600000000 + 3*i),
+			Extra:      make([]byte, 32),
+		}
+		if i == 0 {
+			for _, key := range keys {
+				header.Extra = append(header.Extra, addr(key)...)
+			}
+		} else {
+			header.ParentHash = headers[i-1].Hash()
+		}
+		header.Extra = append(header.Extra, make([]byte, 65)...)
+		sig, err := btcec.SignCompact(btcec.S256(), keys[i%len(keys)], bsc.SealHash(&header).Bytes(), false)
+		require.Nil(t, err)
+		// convert the [v || r || s] of btcec to the [r || s || v] of bsc
+		copy(header.Extra[len(header.Extra)-65:], append(sig[1:], sig[0]-27))
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+func genTestKeys(t *testing.T, n int) []*btcec.PrivateKey {
+	keys := make([]*btcec.PrivateKey, 0, n)
+	for i := 0; i < n; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.Nil(t, err)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestSyncHeaders(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	headers := genTestChain(t, genTestKeys(t, 3), 30)
+
+	_, err := keeper.SyncHeaders(ctx, "bsc", headers[1:3])
+	require.Equal(t, CodeClientNotFound, err.Code())
+
+	require.Nil(t, keeper.CreateClient(ctx, "bsc", &headers[0], bsc.DefaultEpoch))
+	require.Equal(t, CodeClientExists, keeper.CreateClient(ctx, "bsc", &headers[0], bsc.DefaultEpoch).Code())
+	require.Equal(t, CodeInvalidCheckpoint, keeper.CreateClient(ctx, "tmc", &headers[1], bsc.DefaultEpoch).Code())
+	require.True(t, keeper.IsTracking(ctx, "bsc"))
+	require.False(t, keeper.IsTracking(ctx, "tmc"))
+
+	// not following the latest trusted header
+	_, err = keeper.SyncHeaders(ctx, "bsc", headers[2:4])
+	require.Equal(t, CodeInvalidHeaders, err.Code())
+
+	snapshot, err := keeper.SyncHeaders(ctx, "bsc", headers[1:6])
+	require.Nil(t, err)
+	require.EqualValues(t, 5, snapshot.Number)
+
+	// a forked block signed by the validator in turn is rejected for the parent mismatch
+	forked := headers[7]
+	forked.ParentHash = headers[5].ParentHash
+	_, err = keeper.SyncHeaders(ctx, "bsc", []bsc.Header{headers[6], forked})
+	require.Equal(t, CodeInvalidHeaders, err.Code())
+	snapshot, found := keeper.GetSnapshot(ctx, "bsc")
+	require.True(t, found)
+	require.EqualValues(t, 5, snapshot.Number)
+
+	_, err = keeper.SyncHeaders(ctx, "bsc", headers[6:])
+	require.Nil(t, err)
+
+	// only the headers confirmed by ConfirmationDepth blocks are trusted
+	for i := range headers {
+		hash, found := keeper.TrustedHeaderHash(ctx, "bsc", uint64(i))
+		if uint64(i)+ConfirmationDepth > 29 {
+			require.False(t, found)
+			continue
+		}
+		require.True(t, found)
+		require.Equal(t, headers[i].Hash().Bytes(), hash)
+	}
+	_, found = keeper.TrustedHeaderHash(ctx, "bsc", 30)
+	require.False(t, found)
+}
+
+func TestSyncHeadersReorg(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	keys := genTestKeys(t, 3)
+	// the keys are sorted, the block i is signed in turn by keys[i%3]
+	headers := genTestChain(t, keys, 30)
+	require.Nil(t, keeper.CreateClient(ctx, "bsc", &headers[0], bsc.DefaultEpoch))
+	_, err := keeper.SyncHeaders(ctx, "bsc", headers[1:6])
+	require.Nil(t, err)
+
+	// block 6 signed out of turn by a validator not signed recently
+	forked := headers[6]
+	forked.Difficulty = bsc.DiffNoTurn
+	forked.Extra = append([]byte{}, headers[6].Extra...)
+	sig, signErr := btcec.SignCompact(btcec.S256(), keys[1], bsc.SealHash(&forked).Bytes(), false)
+	require.Nil(t, signErr)
+	copy(forked.Extra[len(forked.Extra)-65:], append(sig[1:], sig[0]-27))
+	_, err = keeper.SyncHeaders(ctx, "bsc", []bsc.Header{forked})
+	require.Nil(t, err)
+	header, _ := keeper.GetTrustedHeader(ctx, "bsc", 6)
+	require.Equal(t, forked.Hash(), header.Hash)
+
+	// the branch signed in turn is heavier and replaces the forked block
+	snapshot, err := keeper.SyncHeaders(ctx, "bsc", headers[6:8])
+	require.Nil(t, err)
+	require.EqualValues(t, 7, snapshot.Number)
+	header, _ = keeper.GetTrustedHeader(ctx, "bsc", 6)
+	require.Equal(t, headers[6].Hash(), header.Hash)
+
+	// the forked block is not heavier than the synced branch
+	_, err = keeper.SyncHeaders(ctx, "bsc", []bsc.Header{forked})
+	require.Equal(t, CodeInvalidHeaders, err.Code())
+
+	// the confirmed headers can not be replaced
+	_, err = keeper.SyncHeaders(ctx, "bsc", headers[8:])
+	require.Nil(t, err)
+	_, err = keeper.SyncHeaders(ctx, "bsc", headers[6:])
+	require.Equal(t, CodeInvalidHeaders, err.Code())
+	_, found := keeper.getBranchSnapshot(ctx, "bsc", 29-ConfirmationDepth-1)
+	require.False(t, found)
+	_, found = keeper.getBranchSnapshot(ctx, "bsc", 29-ConfirmationDepth)
+	require.True(t, found)
+	hash, found := keeper.TrustedHeaderHash(ctx, "bsc", 6)
+	require.True(t, found)
+	require.Equal(t, headers[6].Hash().Bytes(), hash)
+}
+
+// genTestEpochChain generates the headers from the genesis with the validator sets changed at the checkpoints,
+// each block is signed by the first validator from the one in turn allowed by the snapshot
+func genTestEpochChain(t *testing.T, epoch uint64, sets [][]*btcec.PrivateKey, count int) []bsc.Header {
+	keys := make(map[bsc.Address]*btcec.PrivateKey)
+	validatorsExtra := func(set []*btcec.PrivateKey) []byte {
+		var res []byte
+		for _, key := range set {
+			var addr bsc.Address
+			copy(addr[:], bsc.Keccak256(key.PubKey().SerializeUncompressed()[1:])[12:])
+			keys[addr] = key
+			res = append(res, addr[:]...)
+		}
+		return res
+	}
+	seal := func(header *bsc.Header, key *btcec.PrivateKey) {
+		sig, err := btcec.SignCompact(btcec.S256(), key, bsc.SealHash(header).Bytes(), false)
+		require.Nil(t, err)
+		copy(header.Extra[len(header.Extra)-65:], append(sig[1:], sig[0]-27))
+	}
+
+	genesis := bsc.Header{Difficulty: bsc.DiffInTurn, Time: 1600000000}
+	genesis.Extra = append(append(make([]byte, 32), validatorsExtra(sets[0])...), make([]byte, 65)...)
+	seal(&genesis, sets[0][0])
+	snapshot, err := bsc.NewSnapshot(&genesis, epoch)
+	require.Nil(t, err)
+
+	headers := []bsc.Header{genesis}
+	for i := 1; i < count; i++ {
+		header := bsc.Header{
+			Number:     int64(i),
+			ParentHash: headers[i-1].Hash(),
+			Time:       uint64(1600000000 + 3*i),
+			Extra:      make([]byte, 32),
+		}
+		if uint64(i)%epoch == 0 {
+			set := sets[len(sets)-1]
+			if i/int(epoch) < len(sets) {
+				set = sets[i/int(epoch)]
+			}
+			header.Extra = append(header.Extra, validatorsExtra(set)...)
+		}
+		header.Extra = append(header.Extra, make([]byte, 65)...)
+
+		var applied *bsc.Snapshot
+		for j := range snapshot.Validators {
+			signer := snapshot.Validators[(i+j)%len(snapshot.Validators)]
+			header.Difficulty = bsc.DiffNoTurn
+			if snapshot.InTurn(uint64(i), signer) {
+				header.Difficulty = bsc.DiffInTurn
+			}
+			seal(&header, keys[signer])
+			if applied, err = snapshot.Apply([]*bsc.Header{&header}); err == nil {
+				break
+			}
+		}
+		require.NotNil(t, applied, "no validator can sign block %d", i)
+		headers = append(headers, header)
+		snapshot = applied
+	}
+	return headers
+}
+
+func TestValidatorSets(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	keys := genTestKeys(t, 4)
+	headers := genTestEpochChain(t, 4, [][]*btcec.PrivateKey{keys[:3], keys}, 116)
+
+	_, _, found := keeper.ValidatorSetAt(ctx, "bsc", 0)
+	require.False(t, found)
+	require.Nil(t, keeper.CreateClient(ctx, "bsc", &headers[0], 4))
+	_, err := keeper.SyncHeaders(ctx, "bsc", headers[1:])
+	require.Nil(t, err)
+
+	// the validator set of the checkpoint at 4 takes effect after half of the previous validators have signed
+	for height, expected := range map[uint64]struct {
+		from  uint64
+		count int
+	}{0: {0, 3}, 4: {0, 3}, 5: {5, 4}, 11: {5, 4}, 100: {5, 4}} {
+		from, validators, found := keeper.ValidatorSetAt(ctx, "bsc", height)
+		require.True(t, found)
+		require.Equal(t, expected.from, from, "validator set at %d", height)
+		require.Len(t, validators, expected.count, "validator set at %d", height)
+		require.True(t, sort.SliceIsSorted(validators, func(i, j int) bool {
+			return bytes.Compare(validators[i], validators[j]) < 0
+		}))
+	}
+	_, _, found = keeper.ValidatorSetAt(ctx, "tmc", 5)
+	require.False(t, found)
+	// the validator set at an unconfirmed height is not trusted
+	_, _, found = keeper.ValidatorSetAt(ctx, "bsc", 116-ConfirmationDepth)
+	require.False(t, found)
+
+	genesis := ExportGenesis(ctx, keeper)
+	require.Len(t, genesis.Clients[0].ValidatorSets, 2)
+	newCtx, newKeeper := createTestInput(t)
+	InitGenesis(newCtx, newKeeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(newCtx, newKeeper))
+}
+
+func TestClientRegistration(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	headers := genTestChain(t, genTestKeys(t, 3), 4)
+
+	registration := ClientRegistration{SideChainId: "bsc", CheckpointHash: headers[1].Hash(), Epoch: bsc.DefaultEpoch}
+	require.NotNil(t, keeper.ValidateClientRegistration(ctx, ClientRegistration{SideChainId: "", CheckpointHash: registration.CheckpointHash, Epoch: bsc.DefaultEpoch}))
+	require.NotNil(t, keeper.ValidateClientRegistration(ctx, ClientRegistration{SideChainId: "bsc", Epoch: bsc.DefaultEpoch}))
+	require.NotNil(t, keeper.ValidateClientRegistration(ctx, ClientRegistration{SideChainId: "bsc", CheckpointHash: registration.CheckpointHash}))
+
+	// the registration is carried by the proposal description in json
+	bz, err := json.Marshal(registration)
+	require.Nil(t, err)
+	var decoded ClientRegistration
+	require.Nil(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, registration, decoded)
+
+	require.Equal(t, CodeClientNotRegistered, keeper.CreateRegisteredClient(ctx, "bsc", &headers[0]).Code())
+	require.False(t, keeper.IsRegistered(ctx, "bsc"))
+	require.Nil(t, keeper.applyClientRegistration(ctx, decoded))
+	require.True(t, keeper.IsRegistered(ctx, "bsc"))
+	require.False(t, keeper.IsTracking(ctx, "bsc"))
+
+	// the registration of a side chain not tracked yet can be replaced
+	require.Equal(t, CodeInvalidCheckpoint, keeper.CreateRegisteredClient(ctx, "bsc", &headers[0]).Code())
+	registration.CheckpointHash = headers[0].Hash()
+	require.Nil(t, keeper.applyClientRegistration(ctx, registration))
+	require.Equal(t, CodeInvalidCheckpoint, keeper.CreateRegisteredClient(ctx, "bsc", &headers[1]).Code())
+	require.Nil(t, keeper.CreateRegisteredClient(ctx, "bsc", &headers[0]))
+	require.True(t, keeper.IsTracking(ctx, "bsc"))
+	require.NotNil(t, keeper.applyClientRegistration(ctx, registration))
+	_, err = keeper.SyncHeaders(ctx, "bsc", headers[1:])
+	require.Nil(t, err)
+
+	// the side chains registered without a client are kept in the genesis
+	require.Nil(t, keeper.applyClientRegistration(ctx, ClientRegistration{SideChainId: "tmc", CheckpointHash: headers[2].Hash(), Epoch: 1}))
+	genesis := ExportGenesis(ctx, keeper)
+	require.Len(t, genesis.Registrations, 2)
+	newCtx, newKeeper := createTestInput(t)
+	InitGenesis(newCtx, newKeeper, genesis)
+	require.True(t, newKeeper.IsRegistered(newCtx, "tmc"))
+	require.False(t, newKeeper.IsTracking(newCtx, "tmc"))
+	require.Equal(t, genesis, ExportGenesis(newCtx, newKeeper))
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	headers := genTestChain(t, genTestKeys(t, 3), 4)
+	require.Nil(t, keeper.CreateClient(ctx, "bsc", &headers[0], bsc.DefaultEpoch))
+	_, err := keeper.SyncHeaders(ctx, "bsc", headers[1:])
+	require.Nil(t, err)
+
+	genesis := ExportGenesis(ctx, keeper)
+	require.Len(t, genesis.Clients, 1)
+	require.Len(t, genesis.Clients[0].Headers, 4)
+
+	newCtx, newKeeper := createTestInput(t)
+	InitGenesis(newCtx, newKeeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(newCtx, newKeeper))
+}
+
+func TestHandleMsgSyncHeaders(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	headers := genTestChain(t, genTestKeys(t, 3), 4)
+	require.Nil(t, keeper.CreateClient(ctx, "bsc", &headers[0], bsc.DefaultEpoch))
+
+	msg := NewMsgSyncHeaders(sdk.AccAddress(make([]byte, sdk.AddrLen)), "bsc", headers[1:])
+	require.Nil(t, msg.ValidateBasic())
+	require.NotNil(t, NewMsgSyncHeaders(msg.Submitter, "bsc", []bsc.Header{headers[1], headers[3]}).ValidateBasic())
+
+	res := NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeMsgNotSupported), res.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	res = NewHandler(keeper)(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	snapshot, _ := keeper.GetSnapshot(ctx, "bsc")
+	require.EqualValues(t, 3, snapshot.Number)
+}
+
+func TestHandleMsgCreateClient(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	headers := genTestChain(t, genTestKeys(t, 3), 4)
+
+	msg := NewMsgCreateClient(sdk.AccAddress(make([]byte, sdk.AddrLen)), "bsc", headers[0])
+	require.Nil(t, msg.ValidateBasic())
+	require.NotNil(t, NewMsgCreateClient(msg.Submitter, "", headers[0]).ValidateBasic())
+
+	res := NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeMsgNotSupported), res.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	res = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeClientNotRegistered), res.Code)
+
+	require.Nil(t, keeper.applyClientRegistration(ctx, ClientRegistration{SideChainId: "bsc", CheckpointHash: headers[0].Hash(), Epoch: bsc.DefaultEpoch}))
+	res = NewHandler(keeper)(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, keeper.IsTracking(ctx, "bsc"))
+}
@@ -0,0 +1,47 @@
+package lightclient
+
+import (
+	"encoding/binary"
+)
+
+var (
+	SnapshotKey      = []byte{0x01} // prefix for the latest snapshot of each side chain
+	TrustedHeaderKey = []byte{0x02} // prefix for the trusted headers of each side chain, by height
+	ValidatorSetKey  = []byte{0x03} // prefix for the validator sets of each side chain, by the height they take effect
+	RegistrationKey  = []byte{0x04} // prefix for the registrations of the side chains to be tracked
+
+	BranchSnapshotKey = []byte{0x05} // prefix for the snapshots at the unconfirmed headers of each side chain, by height
+)
+
+func sideChainIdKey(prefix []byte, sideChainId string) []byte {
+	key := make([]byte, 0, len(prefix)+1+len(sideChainId))
+	key = append(key, prefix...)
+	key = append(key, byte(len(sideChainId)))
+	return append(key, []byte(sideChainId)...)
+}
+
+func GetSnapshotKey(sideChainId string) []byte {
+	return sideChainIdKey(SnapshotKey, sideChainId)
+}
+
+func GetRegistrationKey(sideChainId string) []byte {
+	return sideChainIdKey(RegistrationKey, sideChainId)
+}
+
+func GetTrustedHeaderKey(sideChainId string, height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	return append(sideChainIdKey(TrustedHeaderKey, sideChainId), bz...)
+}
+
+func GetValidatorSetKey(sideChainId string, height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	return append(sideChainIdKey(ValidatorSetKey, sideChainId), bz...)
+}
+
+func GetBranchSnapshotKey(sideChainId string, height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	return append(sideChainIdKey(BranchSnapshotKey, sideChainId), bz...)
+}
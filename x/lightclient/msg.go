@@ -0,0 +1,122 @@
+package lightclient
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+const (
+	MsgRoute            = "lightclient"
+	TypeMsgSyncHeaders  = "sync_headers"
+	TypeMsgCreateClient = "create_client"
+)
+
+// MsgSyncHeaders - struct for syncing the headers following the latest trusted header of the side chain to its light client
+var _ sdk.Msg = &MsgSyncHeaders{}
+
+type MsgSyncHeaders struct {
+	Submitter   sdk.AccAddress `json:"submitter"`
+	SideChainId string         `json:"side_chain_id"`
+	Headers     []bsc.Header   `json:"headers"`
+}
+
+func NewMsgSyncHeaders(submitter sdk.AccAddress, sideChainId string, headers []bsc.Header) MsgSyncHeaders {
+	return MsgSyncHeaders{
+		Submitter:   submitter,
+		SideChainId: sideChainId,
+		Headers:     headers,
+	}
+}
+
+func (MsgSyncHeaders) Route() string {
+	return MsgRoute
+}
+
+func (MsgSyncHeaders) Type() string {
+	return TypeMsgSyncHeaders
+}
+
+func (msg MsgSyncHeaders) ValidateBasic() sdk.Error {
+	if len(msg.Submitter) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected submitter address length is %d, actual length is %d", sdk.AddrLen, len(msg.Submitter)))
+	}
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return ErrInvalidSideChainId(DefaultCodespace, fmt.Sprintf("side chain id must be included and max length is %d bytes", types.MaxSideChainIdLength))
+	}
+	if len(msg.Headers) == 0 {
+		return ErrInvalidHeaders(DefaultCodespace, "no headers to sync")
+	}
+	if len(msg.Headers) > MaxSyncHeaders {
+		return ErrTooManyHeaders(DefaultCodespace)
+	}
+	for i := 1; i < len(msg.Headers); i++ {
+		if msg.Headers[i].Number != msg.Headers[i-1].Number+1 {
+			return ErrInvalidHeaders(DefaultCodespace, "the headers should be consecutive")
+		}
+	}
+	return nil
+}
+
+func (msg MsgSyncHeaders) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSyncHeaders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func (msg MsgSyncHeaders) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+// MsgCreateClient - struct for creating the light client of a registered side chain from the registered checkpoint
+var _ sdk.Msg = &MsgCreateClient{}
+
+type MsgCreateClient struct {
+	Submitter   sdk.AccAddress `json:"submitter"`
+	SideChainId string         `json:"side_chain_id"`
+	Checkpoint  bsc.Header     `json:"checkpoint"`
+}
+
+func NewMsgCreateClient(submitter sdk.AccAddress, sideChainId string, checkpoint bsc.Header) MsgCreateClient {
+	return MsgCreateClient{
+		Submitter:   submitter,
+		SideChainId: sideChainId,
+		Checkpoint:  checkpoint,
+	}
+}
+
+func (MsgCreateClient) Route() string {
+	return MsgRoute
+}
+
+func (MsgCreateClient) Type() string {
+	return TypeMsgCreateClient
+}
+
+func (msg MsgCreateClient) ValidateBasic() sdk.Error {
+	if len(msg.Submitter) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected submitter address length is %d, actual length is %d", sdk.AddrLen, len(msg.Submitter)))
+	}
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return ErrInvalidSideChainId(DefaultCodespace, fmt.Sprintf("side chain id must be included and max length is %d bytes", types.MaxSideChainIdLength))
+	}
+	return nil
+}
+
+func (msg MsgCreateClient) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func (msg MsgCreateClient) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
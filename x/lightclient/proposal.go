@@ -0,0 +1,87 @@
+package lightclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+const (
+	SafeToleratePeriod = 2 * 7 * 24 * 60 * 60 * time.Second // 2 weeks
+)
+
+// ValidateClientRegistration checks the side chain can be registered to be tracked by the light client,
+// the registration of a side chain not tracked yet can be replaced
+func (k Keeper) ValidateClientRegistration(ctx sdk.Context, registration ClientRegistration) error {
+	if len(registration.SideChainId) == 0 || len(registration.SideChainId) > types.MaxSideChainIdLength {
+		return fmt.Errorf("side chain id must be included and max length is %d bytes", types.MaxSideChainIdLength)
+	}
+	if k.IsTracking(ctx, registration.SideChainId) {
+		return fmt.Errorf("light client of side chain %s already exists", registration.SideChainId)
+	}
+	if registration.CheckpointHash == (bsc.Hash{}) {
+		return errors.New("checkpoint hash must be included")
+	}
+	if registration.Epoch == 0 {
+		return errors.New("epoch length should be positive")
+	}
+	return nil
+}
+
+func (k Keeper) applyClientRegistration(ctx sdk.Context, registration ClientRegistration) error {
+	if err := k.ValidateClientRegistration(ctx, registration); err != nil {
+		return err
+	}
+	k.setRegistration(ctx, registration)
+	return nil
+}
+
+// executeRegistrationProposals applies the passed RegisterLightClient proposals in the order they were submitted
+func (k Keeper) executeRegistrationProposals(ctx sdk.Context) {
+	proposals := make([]gov.Proposal, 0)
+	// It can still find the valid proposal if the block chain stop for SafeToleratePeriod time
+	backPeriod := SafeToleratePeriod + gov.MaxVotingPeriod
+	k.govKeeper.Iterate(ctx, nil, nil, gov.StatusNil, 0, true, func(proposal gov.Proposal) bool {
+		if proposal.GetProposalType() == gov.ProposalTypeRegisterLightClient {
+			if ctx.BlockHeader().Time.Sub(proposal.GetVotingStartTime()) > backPeriod {
+				return true
+			}
+			if proposal.GetStatus() != gov.StatusPassed {
+				return false
+			}
+
+			proposal.SetStatus(gov.StatusExecuted)
+			k.govKeeper.SetProposal(ctx, proposal)
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+
+	logger := ctx.Logger().With("module", "lightclient")
+	for j := len(proposals) - 1; j >= 0; j-- {
+		proposal := proposals[j]
+		var registration ClientRegistration
+		err := json.Unmarshal([]byte(proposal.GetDescription()), &registration)
+		if err == nil {
+			err = k.applyClientRegistration(ctx, registration)
+		}
+		if err != nil {
+			logger.Error("The light client registration proposal is invalid, will skip.",
+				"proposalId", proposal.GetProposalID(), "err", err)
+			continue
+		}
+		logger.Info("The light client registration proposal is executed.", "proposalId", proposal.GetProposalID(), "sideChainId", registration.SideChainId)
+	}
+}
+
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	if sdk.IsUpgrade(sdk.BscLightClient) && k.govKeeper != nil {
+		k.executeRegistrationProposals(ctx)
+	}
+}
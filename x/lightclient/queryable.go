@@ -0,0 +1,64 @@
+package lightclient
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the lightclient Querier
+const (
+	QuerySnapshot      = "snapshot"
+	QueryTrustedHeader = "header"
+)
+
+// Params for query 'custom/lightclient/snapshot'
+type QuerySnapshotParams struct {
+	SideChainId string `json:"side_chain_id"`
+}
+
+// Params for query 'custom/lightclient/header'
+type QueryTrustedHeaderParams struct {
+	SideChainId string `json:"side_chain_id"`
+	Height      uint64 `json:"height"`
+}
+
+func NewQuerier(keeper Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QuerySnapshot:
+			var params QuerySnapshotParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			snapshot, found := keeper.GetSnapshot(ctx, params.SideChainId)
+			if !found {
+				return nil, ErrClientNotFound(DefaultCodespace, params.SideChainId)
+			}
+			return marshalJSON(cdc, snapshot)
+		case QueryTrustedHeader:
+			var params QueryTrustedHeaderParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			header, found := keeper.GetTrustedHeader(ctx, params.SideChainId, params.Height)
+			if !found {
+				return nil, ErrHeaderNotFound(DefaultCodespace, "no trusted header at the height")
+			}
+			return marshalJSON(cdc, header)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown lightclient query endpoint")
+		}
+	}
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,53 @@
+package lightclient
+
+import (
+	"github.com/cosmos/cosmos-sdk/bsc"
+)
+
+const (
+	// MaxSyncHeaders is the max number of headers synced by one MsgSyncHeaders
+	MaxSyncHeaders = 256
+	// TrustedHeaderWindow is the number of the latest trusted headers kept for each side chain
+	TrustedHeaderWindow uint64 = 100000
+	// ConfirmationDepth is the number of blocks synced on top of a header before it is trusted, more than two thirds
+	// of the 21 validators of BSC sign them. The synced headers not confirmed yet can be replaced by a heavier branch.
+	ConfirmationDepth uint64 = 15
+)
+
+// TrustedHeader is the part of a side chain header verified by the light client,
+// which is kept for the evidence and the cross chain proofs to consult
+type TrustedHeader struct {
+	Number      uint64   `json:"number"`
+	Hash        bsc.Hash `json:"hash"`
+	ParentHash  bsc.Hash `json:"parent_hash"`
+	Root        bsc.Hash `json:"state_root"`
+	ReceiptHash bsc.Hash `json:"receipts_root"`
+	Time        uint64   `json:"timestamp"`
+	Difficulty  int64    `json:"difficulty"`
+}
+
+func NewTrustedHeader(header *bsc.Header) TrustedHeader {
+	return TrustedHeader{
+		Number:      uint64(header.Number),
+		Hash:        header.Hash(),
+		ParentHash:  header.ParentHash,
+		Root:        header.Root,
+		ReceiptHash: header.ReceiptHash,
+		Time:        header.Time,
+		Difficulty:  header.Difficulty,
+	}
+}
+
+// ValidatorSet is the validator set of a side chain taking effect from the height until the next one
+type ValidatorSet struct {
+	Height     uint64        `json:"height"`
+	Validators []bsc.Address `json:"validators"`
+}
+
+// ClientRegistration is the description of the RegisterLightClient proposal, the light client of the side chain
+// can only be created from the checkpoint header of the registered hash afterwards
+type ClientRegistration struct {
+	SideChainId    string   `json:"side_chain_id"`
+	CheckpointHash bsc.Hash `json:"checkpoint_hash"`
+	Epoch          uint64   `json:"epoch"`
+}
@@ -0,0 +1,288 @@
+/*
+Package crosschain runs the cross chain modules of the Binance Chain on x/mock.App together with an in-process
+mock side chain and a set of mock validators relaying the packages between them, to test x/oracle, x/ibc and
+the side chain applications end to end.
+*/
+package crosschain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	oTypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/sidechain"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+	stakeTypes "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+const (
+	SrcChainID       = sdk.ChainID(1)
+	SideChainID      = sdk.ChainID(97)
+	SideChainName    = "bsc"
+	BlockTimeSeconds = 5
+)
+
+var (
+	sideChainStorePrefix = []byte{0x99}
+
+	validatorCoins = sdk.NewCoin(sdk.NativeTokenSymbol, 5000e8)
+	validatorBond  = sdk.NewCoin(sdk.NativeTokenSymbol, 100e8)
+	pegCoins       = sdk.NewCoin(sdk.NativeTokenSymbol, 1000e8)
+)
+
+// Validator is a mock validator which relays the packages of the side chain by ClaimMsgs
+type Validator struct {
+	Addr    sdk.AccAddress
+	PrivKey crypto.PrivKey
+}
+
+// Harness wires the cross chain keepers into a x/mock.App and drives the blocks of it
+type Harness struct {
+	t *testing.T
+
+	App             *mock.App
+	BankKeeper      bank.BaseKeeper
+	StakeKeeper     stake.Keeper
+	SideChainKeeper sidechain.Keeper
+	IbcKeeper       ibc.Keeper
+	OracleKeeper    oracle.Keeper
+
+	SideChain  *SideChain
+	Validators []Validator
+
+	height   int64
+	time     time.Time
+	breathe  bool
+	inBlocks []func(ctx sdk.Context)
+}
+
+// NewHarness creates the mock app and the side chain, more channels can be registered by RegisterChannel before Start
+func NewHarness(t *testing.T, numValidators int) *Harness {
+	mapp := mock.NewApp()
+	stake.RegisterCodec(mapp.Cdc)
+	oracle.RegisterWire(mapp.Cdc)
+
+	keyParams := sdk.NewKVStoreKey("params")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	keyStake := sdk.NewKVStoreKey("stake")
+	keyStakeReward := sdk.NewKVStoreKey("stake_reward")
+	tkeyStake := sdk.NewTransientStoreKey("transient_stake")
+	keyOracle := sdk.NewKVStoreKey("oracle")
+	keyIbc := sdk.NewKVStoreKey("ibc")
+	keySideChain := sdk.NewKVStoreKey("sc")
+
+	pk := params.NewKeeper(mapp.Cdc, keyParams, tkeyParams)
+	bankKeeper := bank.NewBaseKeeper(mapp.AccountKeeper)
+	scKeeper := sidechain.NewKeeper(keySideChain, pk.Subspace(sidechain.DefaultParamspace), mapp.Cdc)
+	ibcKeeper := ibc.NewKeeper(keyIbc, pk.Subspace(ibc.DefaultParamspace), ibc.DefaultCodespace, scKeeper)
+	scKeeper.SetIbcKeeper(&ibcKeeper)
+	stakeKeeper := stake.NewKeeper(mapp.Cdc, keyStake, keyStakeReward, tkeyStake, bankKeeper, nil,
+		pk.Subspace(stake.DefaultParamspace), mapp.RegisterCodespace(stake.DefaultCodespace))
+	stakeKeeper.SetupForSideChain(&scKeeper, &ibcKeeper)
+	oracleKeeper := oracle.NewKeeper(mapp.Cdc, keyOracle, pk.Subspace(oracle.DefaultParamSpace), stakeKeeper,
+		scKeeper, ibcKeeper, bankKeeper, &sdk.Pool{})
+
+	scKeeper.SetSrcChainID(SrcChainID)
+	require.NoError(t, scKeeper.RegisterDestChain(SideChainName, SideChainID))
+	require.NoError(t, scKeeper.RegisterChannel(oTypes.RelayPackagesChannelName, oTypes.RelayPackagesChannelId, nil))
+
+	h := &Harness{
+		t:               t,
+		App:             mapp,
+		BankKeeper:      bankKeeper,
+		StakeKeeper:     stakeKeeper,
+		SideChainKeeper: scKeeper,
+		IbcKeeper:       ibcKeeper,
+		OracleKeeper:    oracleKeeper,
+		SideChain:       NewSideChain(SideChainName, SideChainID),
+		time:            time.Unix(0, 0).UTC(),
+	}
+
+	mapp.Router().
+		AddRoute("stake", newStakeHandler(stakeKeeper)).
+		AddRoute(oTypes.RouteOracle, oracle.NewHandler(oracleKeeper))
+	mapp.SetInitChainer(h.initChainer)
+	mapp.SetEndBlocker(h.endBlocker)
+	require.NoError(t, mapp.CompleteSetup(keyParams, tkeyParams, keyStake, keyStakeReward, tkeyStake, keyOracle, keyIbc, keySideChain))
+
+	for i := 0; i < numValidators; i++ {
+		privKey := ed25519.GenPrivKey()
+		h.Validators = append(h.Validators, Validator{
+			Addr:    sdk.AccAddress(privKey.PubKey().Address()),
+			PrivKey: privKey,
+		})
+	}
+	return h
+}
+
+// RegisterChannel binds the Binance Chain application and the side chain application to the channel
+func (h *Harness) RegisterChannel(name string, channelID sdk.ChannelID, app sdk.CrossChainApplication, sideApp SideChainApp) {
+	require.NoError(h.t, h.SideChainKeeper.RegisterChannel(name, channelID, app))
+	if sideApp != nil {
+		h.SideChain.SetApp(channelID, sideApp)
+	}
+}
+
+// Start runs the genesis and the first block, in which the mock validators are created and bonded
+func (h *Harness) Start() {
+	genAccs := make([]sdk.Account, 0, len(h.Validators)+1)
+	for _, val := range h.Validators {
+		genAccs = append(genAccs, &auth.BaseAccount{Address: val.Addr, Coins: sdk.Coins{validatorCoins}})
+	}
+	genAccs = append(genAccs, &auth.BaseAccount{Address: sdk.PegAccount, Coins: sdk.Coins{pegCoins}})
+	mock.SetGenesis(h.App, genAccs)
+
+	txs := make([]auth.StdTx, 0, len(h.Validators))
+	for _, val := range h.Validators {
+		msg := stake.NewMsgCreateValidator(sdk.ValAddress(val.Addr), ed25519.GenPrivKey().PubKey(), validatorBond,
+			stake.NewDescription(val.Addr.String(), "", "", ""), stake.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()))
+		txs = append(txs, h.GenTx(val, msg))
+	}
+	for _, res := range h.NextBlock(txs...) {
+		require.True(h.t, res.IsOK(), res.Log)
+	}
+}
+
+func (h *Harness) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+	h.App.InitChainer(ctx, req)
+
+	stakeGenesis := stake.DefaultGenesisState()
+	stakeGenesis.Pool.LooseTokens = sdk.NewDecWithoutFra(100000)
+	stakeGenesis.Params.BondDenom = sdk.NativeTokenSymbol
+	validators, err := stake.InitGenesis(ctx, h.StakeKeeper, stakeGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	h.SideChainKeeper.SetParams(ctx, sidechain.Params{BscSideChainId: SideChainName})
+	h.SideChainKeeper.SetSideChainIdAndStorePrefix(ctx, SideChainName, sideChainStorePrefix)
+	sideChainCtx := ctx.WithSideChainKeyPrefix(sideChainStorePrefix)
+	sideChainStakeParams := stake.DefaultParams()
+	sideChainStakeParams.BondDenom = sdk.NativeTokenSymbol
+	sideChainStakeParams.MinSelfDelegation = validatorBond.Amount
+	h.StakeKeeper.SetParams(sideChainCtx, sideChainStakeParams)
+	h.StakeKeeper.SetPool(sideChainCtx, stakeGenesis.Pool)
+	h.IbcKeeper.SetParams(ctx.WithSideChainKeyPrefix(sideChainStorePrefix), ibc.Params{RelayerFee: ibc.DefaultRelayerFeeParam})
+	h.OracleKeeper.SetParams(ctx, oTypes.Params{ConsensusNeeded: oTypes.DefaultConsensusNeeded})
+	for _, channelID := range h.SideChainKeeper.GetChannelIDs(ctx) {
+		h.SideChainKeeper.SetChannelSendPermission(ctx, SideChainID, channelID, sdk.ChannelAllow)
+	}
+
+	return abci.ResponseInitChain{
+		Validators: validators,
+	}
+}
+
+func (h *Harness) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	for _, fn := range h.inBlocks {
+		fn(ctx)
+	}
+	h.inBlocks = nil
+
+	oracle.EndBlocker(ctx, h.OracleKeeper)
+	sidechain.EndBlock(ctx, h.SideChainKeeper)
+	var validatorUpdates []abci.ValidatorUpdate
+	if h.breathe {
+		validatorUpdates, _ = stake.EndBreatheBlock(ctx, h.StakeKeeper)
+	} else {
+		validatorUpdates, _ = stake.EndBlocker(ctx, h.StakeKeeper)
+	}
+	ibc.EndBlocker(ctx, h.IbcKeeper)
+	return abci.ResponseEndBlock{
+		ValidatorUpdates: validatorUpdates,
+	}
+}
+
+// Context returns a context on the latest committed state
+func (h *Harness) Context() sdk.Context {
+	return h.App.BaseApp.NewContext(sdk.RunTxModeCheck, abci.Header{Height: h.height, Time: h.time})
+}
+
+// GenTx signs the msgs by the validator with its latest committed account number and sequence
+func (h *Harness) GenTx(val Validator, msgs ...sdk.Msg) auth.StdTx {
+	acc := h.App.AccountKeeper.GetAccount(h.Context(), val.Addr)
+	require.NotNil(h.t, acc)
+	return mock.GenTx(msgs, []int64{acc.GetAccountNumber()}, []int64{acc.GetSequence()}, val.PrivKey)
+}
+
+// NextBlock delivers the txs in a new block and commits it
+func (h *Harness) NextBlock(txs ...auth.StdTx) []sdk.Result {
+	h.height++
+	h.time = h.time.Add(BlockTimeSeconds * time.Second)
+	h.App.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: h.height, Time: h.time}})
+	results := make([]sdk.Result, 0, len(txs))
+	for _, tx := range txs {
+		results = append(results, h.App.Deliver(tx))
+	}
+	h.App.EndBlock(abci.RequestEndBlock{Height: h.height})
+	h.App.Commit()
+	return results
+}
+
+// NextBreatheBlock delivers the txs in a new breathe block, in which the validator set of the side chain is updated
+// and sent to the side chain
+func (h *Harness) NextBreatheBlock(txs ...auth.StdTx) []sdk.Result {
+	h.breathe = true
+	defer func() { h.breathe = false }()
+	return h.NextBlock(txs...)
+}
+
+// ExecuteInBlock runs fn with the deliver context of a new block before the end blockers of the modules,
+// e.g. to let a Binance Chain application send syn packages to the side chain
+func (h *Harness) ExecuteInBlock(fn func(ctx sdk.Context)) {
+	h.inBlocks = append(h.inBlocks, fn)
+	h.NextBlock()
+}
+
+// Relay lets the side chain consume the packages committed on the Binance Chain, and then relays the packages
+// queued on the side chain back by a ClaimMsg from every validator in a new block.
+// It returns the results of the ClaimMsgs, nil if there is nothing to relay.
+func (h *Harness) Relay() []sdk.Result {
+	ctx := h.Context()
+	require.NoError(h.t, h.SideChain.Consume(ctx, h.SideChainKeeper, h.IbcKeeper))
+
+	packages := h.SideChain.PendingPackages()
+	if len(packages) == 0 {
+		return nil
+	}
+	payload, err := rlp.EncodeToBytes(packages)
+	require.NoError(h.t, err)
+	sequence := h.SideChainKeeper.GetReceiveSequence(ctx, SideChainID, oTypes.RelayPackagesChannelId)
+
+	txs := make([]auth.StdTx, 0, len(h.Validators))
+	for _, val := range h.Validators {
+		txs = append(txs, h.GenTx(val, oTypes.NewClaimMsg(SideChainID, sequence, payload, val.Addr)))
+	}
+	results := h.NextBlock(txs...)
+
+	if h.SideChainKeeper.GetReceiveSequence(h.Context(), SideChainID, oTypes.RelayPackagesChannelId) > sequence {
+		h.SideChain.pending = nil
+	}
+	return results
+}
+
+// newStakeHandler routes the msgs of the side chains to the stake handler of the side chains
+func newStakeHandler(k stake.Keeper) sdk.Handler {
+	handler := stake.NewStakeHandler(k)
+	sideChainHandler := stake.NewHandler(k, gov.Keeper{})
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if _, ok := msg.(stakeTypes.SideChainIder); ok {
+			return sideChainHandler(ctx, msg)
+		}
+		return handler(ctx, msg)
+	}
+}
@@ -0,0 +1,231 @@
+package crosschain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	oTypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	sTypes "github.com/cosmos/cosmos-sdk/x/sidechain/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+	stakeKeeper "github.com/cosmos/cosmos-sdk/x/stake/keeper"
+	stakeTypes "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+const testChannelID = sdk.ChannelID(0x30)
+
+type testBCApp struct {
+	syn     [][]byte
+	ack     [][]byte
+	failAck [][]byte
+}
+
+func (app *testBCApp) ExecuteSynPackage(ctx sdk.Context, payload []byte, relayerFee int64) sdk.ExecuteResult {
+	if string(payload) == "panic" {
+		panic("unexpected payload")
+	}
+	app.syn = append(app.syn, payload)
+	return sdk.ExecuteResult{Payload: append([]byte("ack:"), payload...)}
+}
+
+func (app *testBCApp) ExecuteAckPackage(ctx sdk.Context, payload []byte) sdk.ExecuteResult {
+	app.ack = append(app.ack, payload)
+	return sdk.ExecuteResult{}
+}
+
+func (app *testBCApp) ExecuteFailAckPackage(ctx sdk.Context, payload []byte) sdk.ExecuteResult {
+	app.failAck = append(app.failAck, payload)
+	return sdk.ExecuteResult{}
+}
+
+type testSideApp struct {
+	ack     [][]byte
+	failAck [][]byte
+}
+
+func (app *testSideApp) HandleSynPackage(payload []byte) ([]byte, error) {
+	if string(payload) == "refuse" {
+		return nil, errors.New("refused")
+	}
+	return append([]byte("ack:"), payload...), nil
+}
+
+func (app *testSideApp) HandleAckPackage(payload []byte) {
+	app.ack = append(app.ack, payload)
+}
+
+func (app *testSideApp) HandleFailAckPackage(payload []byte) {
+	app.failAck = append(app.failAck, payload)
+}
+
+func setupHarness(t *testing.T) (*Harness, *testBCApp, *testSideApp) {
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.FixFailAckPackage, 1)
+	h := NewHarness(t, 4)
+	bcApp, sideApp := &testBCApp{}, &testSideApp{}
+	h.RegisterChannel("test", testChannelID, bcApp, sideApp)
+	h.Start()
+	return h, bcApp, sideApp
+}
+
+func TestSynPackageFromBinanceChain(t *testing.T) {
+	h, bcApp, _ := setupHarness(t)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.FixFailAckPackage, 0)
+
+	h.ExecuteInBlock(func(ctx sdk.Context) {
+		_, err := h.IbcKeeper.CreateRawIBCPackageById(ctx, SideChainID, testChannelID, sdk.SynCrossChainPackageType, []byte("hello"))
+		require.Nil(t, err)
+		_, err = h.IbcKeeper.CreateRawIBCPackageById(ctx, SideChainID, testChannelID, sdk.SynCrossChainPackageType, []byte("refuse"))
+		require.Nil(t, err)
+	})
+
+	results := h.Relay()
+	require.Len(t, results, len(h.Validators))
+	require.True(t, results[0].IsOK(), results[0].Log)
+
+	received := h.SideChain.ReceivedPackages(testChannelID)
+	require.Len(t, received, 2)
+	require.Equal(t, []byte("hello"), received[0].Payload)
+	require.Equal(t, ibcRelayerFee(t, h), received[0].RelayerFee)
+
+	require.Equal(t, [][]byte{[]byte("ack:hello")}, bcApp.ack)
+	require.Equal(t, [][]byte{[]byte("refuse")}, bcApp.failAck)
+	require.Equal(t, uint64(2), h.SideChainKeeper.GetReceiveSequence(h.Context(), SideChainID, testChannelID))
+	require.Empty(t, h.SideChain.PendingPackages())
+
+	// nothing left to relay
+	require.Nil(t, h.Relay())
+}
+
+func TestSynPackageFromSideChain(t *testing.T) {
+	h, bcApp, sideApp := setupHarness(t)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.FixFailAckPackage, 0)
+
+	h.SideChain.SendPackage(testChannelID, sdk.SynCrossChainPackageType, []byte("hello"), 0)
+	h.SideChain.SendPackage(testChannelID, sdk.SynCrossChainPackageType, []byte("panic"), 0)
+	results := h.Relay()
+	require.True(t, results[0].IsOK(), results[0].Log)
+	require.Equal(t, [][]byte{[]byte("hello")}, bcApp.syn)
+
+	// the ack and fail ack packages are written by the oracle and consumed by the side chain
+	require.Nil(t, h.Relay())
+	require.Equal(t, [][]byte{[]byte("ack:hello")}, sideApp.ack)
+	require.Equal(t, [][]byte{[]byte("panic")}, sideApp.failAck)
+
+	received := h.SideChain.ReceivedPackages(testChannelID)
+	require.Len(t, received, 2)
+	require.Equal(t, sdk.AckCrossChainPackageType, received[0].PackageType)
+	require.Equal(t, sdk.FailAckCrossChainPackageType, received[1].PackageType)
+}
+
+func TestClaimWithoutConsensus(t *testing.T) {
+	h, bcApp, _ := setupHarness(t)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.FixFailAckPackage, 0)
+
+	h.SideChain.SendPackage(testChannelID, sdk.SynCrossChainPackageType, []byte("hello"), 0)
+	h.Validators = h.Validators[:2]
+	h.Relay()
+	require.Empty(t, bcApp.syn)
+	require.Len(t, h.SideChain.PendingPackages(), 1)
+
+	// the pending claim can be shown with the packages decoded
+	querier := oracle.NewQuerier(h.OracleKeeper, h.App.Cdc)
+	bz, err := h.App.Cdc.MarshalJSON(oracle.QueryProphecyPackagesParams{ChainId: SideChainID, Sequence: 0})
+	require.NoError(t, err)
+	res, sdkErr := querier(h.Context(), []string{oracle.QueryProphecyPackages}, abci.RequestQuery{Data: bz})
+	require.Nil(t, sdkErr)
+	var claims []oTypes.DecodedClaim
+	require.NoError(t, json.Unmarshal(res, &claims))
+	require.Len(t, claims, 1)
+	require.Len(t, claims[0].Validators, 2)
+	require.Len(t, claims[0].Packages, 1)
+	require.Equal(t, "test", claims[0].Packages[0].ChannelName)
+	require.Equal(t, "syn", claims[0].Packages[0].PackageType)
+	require.Equal(t, hex.EncodeToString([]byte("hello")), claims[0].Packages[0].RawPayload)
+}
+
+type testStakeApp struct {
+	validatorSets []stakeTypes.IbcValidatorSetPackage
+}
+
+func (app *testStakeApp) HandleSynPackage(payload []byte) ([]byte, error) {
+	var validatorSet stakeTypes.IbcValidatorSetPackage
+	if err := rlp.DecodeBytes(payload, &validatorSet); err != nil {
+		return nil, err
+	}
+	app.validatorSets = append(app.validatorSets, validatorSet)
+	return rlp.EncodeToBytes(sTypes.CommonAckPackage{Code: 0})
+}
+
+func (app *testStakeApp) HandleAckPackage(payload []byte) {}
+
+func (app *testStakeApp) HandleFailAckPackage(payload []byte) {}
+
+func (app *testStakeApp) latestPowers() map[string]uint64 {
+	powers := make(map[string]uint64)
+	for _, val := range app.validatorSets[len(app.validatorSets)-1].ValidatorSet {
+		powers[string(val.ConsAddr)] = val.Power
+	}
+	return powers
+}
+
+func TestSideChainStakingRoundTrip(t *testing.T) {
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.LaunchBscUpgrade, 1)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.IBCPackageCleanup, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.LaunchBscUpgrade, 0)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.IBCPackageCleanup, 0)
+	h := NewHarness(t, 4)
+	stakeApp := &testStakeApp{}
+	h.SideChain.SetApp(stakeKeeper.ChannelId, stakeApp)
+	h.Start()
+
+	val, delegator := h.Validators[0], h.Validators[1]
+	consAddr := []byte(val.Addr)
+	create := stake.NewMsgCreateSideChainValidator(sdk.ValAddress(val.Addr), validatorBond,
+		stake.NewDescription("side", "", "", ""), stake.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		SideChainName, consAddr, consAddr)
+	delegate := stake.NewMsgSideChainDelegate(SideChainName, delegator.Addr, sdk.ValAddress(val.Addr), sdk.NewCoin(sdk.NativeTokenSymbol, 50e8))
+	for _, res := range h.NextBlock(h.GenTx(val, create), h.GenTx(delegator, delegate)) {
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	// the validator set is sent to the side chain from the second breathe block on
+	h.NextBreatheBlock()
+	require.Nil(t, h.Relay())
+	h.NextBreatheBlock()
+
+	// the side chain acks the validator set, the ack is relayed back and confirms the package
+	results := h.Relay()
+	require.Len(t, results, len(h.Validators))
+	require.True(t, results[0].IsOK(), results[0].Log)
+	require.Len(t, stakeApp.validatorSets, 1)
+	require.Equal(t, uint64(150e8), stakeApp.latestPowers()[string(consAddr)])
+	ctx := h.Context()
+	require.Equal(t, uint64(1), h.SideChainKeeper.GetReceiveSequence(ctx, SideChainID, stakeKeeper.ChannelId))
+	require.Equal(t, uint64(1), h.IbcKeeper.GetConfirmedSequence(ctx, SideChainID, stakeKeeper.ChannelId))
+
+	undelegate := stake.NewMsgSideChainUndelegate(SideChainName, delegator.Addr, sdk.ValAddress(val.Addr), sdk.NewCoin(sdk.NativeTokenSymbol, 50e8))
+	for _, res := range h.NextBreatheBlock(h.GenTx(delegator, undelegate)) {
+		require.True(t, res.IsOK(), res.Log)
+	}
+	results = h.Relay()
+	require.True(t, results[0].IsOK(), results[0].Log)
+	require.Len(t, stakeApp.validatorSets, 2)
+	require.Equal(t, uint64(100e8), stakeApp.latestPowers()[string(consAddr)])
+	ctx = h.Context()
+	require.Equal(t, uint64(2), h.SideChainKeeper.GetReceiveSequence(ctx, SideChainID, stakeKeeper.ChannelId))
+	require.Equal(t, uint64(2), h.IbcKeeper.GetConfirmedSequence(ctx, SideChainID, stakeKeeper.ChannelId))
+	require.Empty(t, h.SideChain.PendingPackages())
+}
+
+func ibcRelayerFee(t *testing.T, h *Harness) int64 {
+	fee, err := h.IbcKeeper.GetRelayerFeeParam(h.Context(), SideChainName)
+	require.NoError(t, err)
+	return fee.Int64()
+}
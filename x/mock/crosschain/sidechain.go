@@ -0,0 +1,141 @@
+package crosschain
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	oTypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/x/sidechain"
+	sTypes "github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+// SideChainApp is the application bound to a channel on the mock side chain.
+type SideChainApp interface {
+	// HandleSynPackage returns the payload of the ack package, no ack package is sent back if it is nil.
+	// If an error is returned, a fail ack package with the original payload is sent back instead.
+	HandleSynPackage(payload []byte) ([]byte, error)
+	HandleAckPackage(payload []byte)
+	HandleFailAckPackage(payload []byte)
+}
+
+// ReceivedPackage is a package the mock side chain received from the Binance Chain
+type ReceivedPackage struct {
+	ChannelID   sdk.ChannelID
+	Sequence    uint64
+	PackageType sdk.CrossChainPackageType
+	RelayerFee  int64
+	Payload     []byte // payload without the package header
+}
+
+// SideChain is an in-process fake destination chain. It consumes the packages written by ibc.Keeper
+// and queues the packages to be relayed back to the Binance Chain.
+type SideChain struct {
+	ChainID sdk.ChainID
+	Name    string
+
+	apps             map[sdk.ChannelID]SideChainApp
+	receiveSequences map[sdk.ChannelID]uint64
+	sendSequences    map[sdk.ChannelID]uint64
+	pending          oTypes.Packages
+
+	Received []ReceivedPackage
+}
+
+func NewSideChain(name string, chainID sdk.ChainID) *SideChain {
+	return &SideChain{
+		ChainID:          chainID,
+		Name:             name,
+		apps:             make(map[sdk.ChannelID]SideChainApp),
+		receiveSequences: make(map[sdk.ChannelID]uint64),
+		sendSequences:    make(map[sdk.ChannelID]uint64),
+	}
+}
+
+// SetApp binds the application to the channel on the side chain
+func (sc *SideChain) SetApp(channelID sdk.ChannelID, app SideChainApp) {
+	sc.apps[channelID] = app
+}
+
+// SendPackage queues a package to be relayed to the Binance Chain, it returns the sequence of the package
+func (sc *SideChain) SendPackage(channelID sdk.ChannelID, packageType sdk.CrossChainPackageType, payload []byte, relayerFee int64) uint64 {
+	sequence := sc.sendSequences[channelID]
+	sc.sendSequences[channelID] = sequence + 1
+
+	header := sTypes.EncodePackageHeader(packageType, *big.NewInt(relayerFee))
+	sc.pending = append(sc.pending, oTypes.Package{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Payload:   append(header, payload...),
+	})
+	return sequence
+}
+
+// PendingPackages returns the packages not relayed yet
+func (sc *SideChain) PendingPackages() oTypes.Packages {
+	return sc.pending
+}
+
+// ReceivedPackages returns the packages received from the channel
+func (sc *SideChain) ReceivedPackages(channelID sdk.ChannelID) []ReceivedPackage {
+	packages := make([]ReceivedPackage, 0)
+	for _, pack := range sc.Received {
+		if pack.ChannelID == channelID {
+			packages = append(packages, pack)
+		}
+	}
+	return packages
+}
+
+// Consume reads the new packages written to the side chain by ibc.Keeper and delivers them to the applications.
+func (sc *SideChain) Consume(ctx sdk.Context, scKeeper sidechain.Keeper, ibcKeeper ibc.Keeper) error {
+	for _, channelID := range scKeeper.GetChannelIDs(ctx) {
+		for {
+			sequence := sc.receiveSequences[channelID]
+			bz, err := ibcKeeper.GetIBCPackageById(ctx, sc.ChainID, channelID, sequence)
+			if err != nil {
+				return err
+			}
+			if bz == nil {
+				break
+			}
+			sc.receiveSequences[channelID] = sequence + 1
+
+			packageType, relayerFee, err := sTypes.DecodePackageHeader(bz)
+			if err != nil {
+				return fmt.Errorf("invalid package %d of channel %d: %v", sequence, channelID, err)
+			}
+			pack := ReceivedPackage{
+				ChannelID:   channelID,
+				Sequence:    sequence,
+				PackageType: packageType,
+				RelayerFee:  relayerFee.Int64(),
+				Payload:     bz[sTypes.PackageHeaderLength:],
+			}
+			sc.Received = append(sc.Received, pack)
+			sc.deliver(pack)
+		}
+	}
+	return nil
+}
+
+func (sc *SideChain) deliver(pack ReceivedPackage) {
+	app := sc.apps[pack.ChannelID]
+	if app == nil {
+		return
+	}
+	switch pack.PackageType {
+	case sdk.SynCrossChainPackageType:
+		ack, err := app.HandleSynPackage(pack.Payload)
+		if err != nil {
+			sc.SendPackage(pack.ChannelID, sdk.FailAckCrossChainPackageType, pack.Payload, 0)
+		} else if ack != nil {
+			sc.SendPackage(pack.ChannelID, sdk.AckCrossChainPackageType, ack, 0)
+		}
+	case sdk.AckCrossChainPackageType:
+		app.HandleAckPackage(pack.Payload)
+	case sdk.FailAckCrossChainPackageType:
+		app.HandleFailAckPackage(pack.Payload)
+	}
+}
@@ -10,11 +10,17 @@ const (
 	SuccessStatusText = types.SuccessStatusText
 	FailedStatusText  = types.FailedStatusText
 	DefaultParamSpace = keeper.DefaultParamSpace
+
+	EventTypeProphecyExpired = types.EventTypeProphecyExpired
+	EventTypeValidatorAbsent = types.EventTypeValidatorAbsent
+
+	EventTypeWithdrawRelayerReward = types.EventTypeWithdrawRelayerReward
 )
 
 var (
 	// functions aliases
-	NewKeeper = keeper.NewKeeper
+	NewKeeper          = keeper.NewKeeper
+	RegisterInvariants = keeper.RegisterInvariants
 
 	NewClaim                         = types.NewClaim
 	ErrProphecyNotFound              = types.ErrProphecyNotFound
@@ -30,6 +36,8 @@ var (
 	NewProphecy = types.NewProphecy
 	NewStatus   = types.NewStatus
 
+	NewValidatorParticipation = types.NewValidatorParticipation
+
 	// variable aliases
 	StatusTextToString = types.StatusTextToString
 	StringToStatusText = types.StringToStatusText
@@ -37,6 +45,9 @@ var (
 	NewClaimMsg = types.NewClaimMsg
 	RouteOracle = types.RouteOracle
 	GetClaimId  = types.GetClaimId
+
+	NewWithdrawRelayerRewardMsg = types.NewWithdrawRelayerRewardMsg
+	NewProofClaimMsg            = types.NewProofClaimMsg
 )
 
 type (
@@ -48,4 +59,12 @@ type (
 	StatusText = types.StatusText
 
 	ClaimMsg = types.ClaimMsg
+
+	WithdrawRelayerRewardMsg = types.WithdrawRelayerRewardMsg
+	ProofClaimMsg            = types.ProofClaimMsg
+
+	ValidatorParticipation = types.ValidatorParticipation
+	AbsencePenalty         = types.AbsencePenalty
+	OracleHooks            = types.OracleHooks
+	RelayerReward          = types.RelayerReward
 )
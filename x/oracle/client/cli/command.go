@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	flagChainId  = "cross-chain-id"
+	flagSequence = "sequence"
+)
+
+func AddCommands(cmd *cobra.Command, cdc *amino.Codec) {
+
+	oracleCmd := &cobra.Command{
+		Use:   "oracle",
+		Short: "oracle commands",
+	}
+	oracleCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryProphecy(cdc),
+			GetCmdQueryProphecyPackages(cdc),
+			GetCmdQueryPendingProphecies(cdc),
+			GetCmdQueryValidatorProphecies(cdc),
+			GetCmdQueryParticipation(cdc),
+			GetCmdQueryRelayerReward(cdc))...)
+	oracleCmd.AddCommand(
+		client.PostCommands(
+			GetCmdWithdrawRelayerReward(cdc))...)
+	cmd.AddCommand(oracleCmd)
+}
@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+// GetCmdQueryProphecy implements the command to query the prophecy of a cross chain sequence.
+func GetCmdQueryProphecy(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prophecy",
+		Short: "Query the prophecy of a cross chain sequence",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := sdk.ParseChainID(viper.GetString(flagChainId))
+			if err != nil {
+				return err
+			}
+			params := oracle.QueryProphecyParams{
+				ChainId:  chainId,
+				Sequence: viper.GetUint64(flagSequence),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryProphecy), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagChainId, "", "the cross chain id of the side chain")
+	cmd.Flags().Uint64(flagSequence, 0, "the sequence of the relay packages channel")
+	return cmd
+}
+
+// GetCmdQueryProphecyPackages implements the command to query the claims of a prophecy with the packages decoded as JSON.
+func GetCmdQueryProphecyPackages(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prophecy-packages",
+		Short: "Query the claimed packages of a cross chain sequence with their payloads decoded by the payload schemas of the channels",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := sdk.ParseChainID(viper.GetString(flagChainId))
+			if err != nil {
+				return err
+			}
+			params := oracle.QueryProphecyPackagesParams{
+				ChainId:  chainId,
+				Sequence: viper.GetUint64(flagSequence),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryProphecyPackages), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagChainId, "", "the cross chain id of the side chain")
+	cmd.Flags().Uint64(flagSequence, 0, "the sequence of the relay packages channel")
+	return cmd
+}
+
+// GetCmdQueryPendingProphecies implements the command to query all the pending prophecies.
+func GetCmdQueryPendingProphecies(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-prophecies",
+		Short: "Query all the prophecies that are still pending",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryPendingProphecies), nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryValidatorProphecies implements the command to query the pending prophecies a validator has claimed.
+func GetCmdQueryValidatorProphecies(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-prophecies [validator-addr]",
+		Short: "Query the pending prophecies a validator has claimed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(oracle.QueryValidatorPropheciesParams{ValidatorAddr: valAddr})
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryValidatorProphecies), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryParticipation implements the command to query the claim participation of one or all validators.
+func GetCmdQueryParticipation(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participation [validator-addr]",
+		Short: "Query the claim participation of a validator, or of all validators if no address is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryParticipations), nil)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(res))
+				return nil
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(oracle.QueryParticipationParams{ValidatorAddr: valAddr})
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryParticipation), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryRelayerReward implements the command to query the relayer reward of one or all validators.
+func GetCmdQueryRelayerReward(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-reward [validator-addr]",
+		Short: "Query the unwithdrawn relayer reward of a validator, or of all validators if no address is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryRelayerRewards), nil)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(res))
+				return nil
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(oracle.QueryRelayerRewardParams{ValidatorAddr: valAddr})
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", oracle.RouteOracle, oracle.QueryRelayerReward), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
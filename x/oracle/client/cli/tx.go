@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+)
+
+// GetCmdWithdrawRelayerReward implements the command to withdraw the relayer reward of a validator.
+func GetCmdWithdrawRelayerReward(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-relayer-reward",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the relayer reward credited to the validator to its operator account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			valAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := oracle.NewWithdrawRelayerRewardMsg(sdk.ValAddress(valAddr))
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,32 @@
+package oracle
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	if sdk.IsUpgrade(sdk.OracleLiveness) {
+		absentValidators := keeper.EvaluateParticipations(ctx)
+		for _, valAddr := range absentValidators {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeValidatorAbsent,
+				sdk.NewAttribute(types.AbsentValidator, valAddr.String()),
+			))
+		}
+	}
+
+	if !sdk.IsUpgrade(sdk.ProphecyExpiry) {
+		return
+	}
+
+	expired := keeper.ExpireProphecies(ctx)
+	for _, prophecy := range expired {
+		ctx.Logger().With("module", "oracle").Info("prophecy expired", "id", prophecy.ID, "createHeight", prophecy.CreateHeight)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeProphecyExpired,
+			sdk.NewAttribute(types.ProphecyExpiredId, prophecy.ID),
+			sdk.NewAttribute(types.ProphecyExpiredCreateHeight, strconv.FormatInt(prophecy.CreateHeight, 10)),
+		))
+	}
+}
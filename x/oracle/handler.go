@@ -18,6 +18,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.ClaimMsg:
 			return handleClaimMsg(ctx, keeper, msg)
+		case types.WithdrawRelayerRewardMsg:
+			return handleWithdrawRelayerRewardMsg(ctx, keeper, msg)
+		case types.ProofClaimMsg:
+			return handleProofClaimMsg(ctx, keeper, msg)
 		default:
 			errMsg := "Unrecognized oracle msg type"
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -31,6 +35,15 @@ func handleClaimMsg(ctx sdk.Context, oracleKeeper Keeper, msg ClaimMsg) sdk.Resu
 
 	sequence := oracleKeeper.ScKeeper.GetReceiveSequence(ctx, msg.ChainId, types.RelayPackagesChannelId)
 	if sequence != msg.Sequence {
+		// the slower validators can still claim the prophecies which succeeded in the latest grace blocks
+		if sdk.IsUpgrade(sdk.OracleLiveness) && msg.Sequence < sequence {
+			if found, sdkErr := oracleKeeper.ProcessLateClaim(ctx, claim); found {
+				if sdkErr != nil {
+					return sdkErr.Result()
+				}
+				return sdk.Result{}
+			}
+		}
 		return types.ErrInvalidSequence(fmt.Sprintf("current sequence of channel %d is %d", types.RelayPackagesChannelId, sequence)).Result()
 	}
 
@@ -54,32 +67,101 @@ func handleClaimMsg(ctx sdk.Context, oracleKeeper Keeper, msg ClaimMsg) sdk.Resu
 		return types.ErrInvalidPayload("decode packages error").Result()
 	}
 
+	// the validators whose claims made up the successful prophecy share the relayer fee
+	relayers := prophecy.ClaimValidators[prophecy.Status.FinalClaim]
+
+	events, sdkErr := handlePackages(ctx, oracleKeeper, msg.ChainId, relayers, packages)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	// delete prophecy when execute claim success
+	oracleKeeper.DeleteProphecy(ctx, prophecy.ID)
+	oracleKeeper.ScKeeper.IncrReceiveSequence(ctx, msg.ChainId, types.RelayPackagesChannelId)
+
+	// the participation is evaluated by the end blocker after the grace blocks
+	if sdk.IsUpgrade(sdk.OracleLiveness) {
+		oracleKeeper.SetFinalizedProphecy(ctx, types.NewFinalizedProphecy(prophecy, ctx.BlockHeight()))
+	}
+
+	return sdk.Result{
+		Events: events,
+	}
+}
+
+// handleProofClaimMsg executes the packages proved against the block trusted by the light client, the validator
+// submitting the proof takes the relayer fee, and the pending prophecy of the sequence is dropped.
+func handleProofClaimMsg(ctx sdk.Context, oracleKeeper Keeper, msg types.ProofClaimMsg) sdk.Result {
+	if !sdk.IsUpgrade(sdk.OracleProofClaim) {
+		return sdk.ErrMsgNotSupported("ProofClaimMsg is not supported before the OracleProofClaim upgrade").Result()
+	}
+
+	sequence := oracleKeeper.ScKeeper.GetReceiveSequence(ctx, msg.ChainId, types.RelayPackagesChannelId)
+	if sequence != msg.Sequence {
+		return types.ErrInvalidSequence(fmt.Sprintf("current sequence of channel %d is %d", types.RelayPackagesChannelId, sequence)).Result()
+	}
+
+	packages, sdkErr := oracleKeeper.VerifyProofClaim(ctx, msg)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	events, sdkErr := handlePackages(ctx, oracleKeeper, msg.ChainId, []sdk.ValAddress{sdk.ValAddress(msg.ValidatorAddress)}, packages)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	oracleKeeper.DeleteProphecy(ctx, types.GetClaimId(msg.ChainId, types.RelayPackagesChannelId, msg.Sequence))
+	oracleKeeper.ScKeeper.IncrReceiveSequence(ctx, msg.ChainId, types.RelayPackagesChannelId)
+
+	return sdk.Result{
+		Events: events,
+	}
+}
+
+func handleWithdrawRelayerRewardMsg(ctx sdk.Context, oracleKeeper Keeper, msg types.WithdrawRelayerRewardMsg) sdk.Result {
+	if !sdk.IsUpgrade(sdk.RelayerReward) {
+		return sdk.ErrMsgNotSupported("WithdrawRelayerRewardMsg is not supported before the RelayerReward upgrade").Result()
+	}
+
+	amount, err := oracleKeeper.WithdrawRelayerReward(ctx, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
+
+	if ctx.IsDeliverTx() {
+		oracleKeeper.Pool.AddAddrs([]sdk.AccAddress{types.RelayerRewardPoolAddr, sdk.AccAddress(msg.ValidatorAddr)})
+	}
+
+	return sdk.Result{
+		Events: sdk.Events{sdk.NewEvent(types.EventTypeWithdrawRelayerReward,
+			sdk.NewAttribute(types.RelayerRewardValidator, msg.ValidatorAddr.String()),
+			sdk.NewAttribute(types.RelayerRewardAmount, strconv.FormatInt(amount, 10)),
+		)},
+	}
+}
+
+// handlePackages executes the packages in order and increases the receive sequences of their channels
+func handlePackages(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, relayers []sdk.ValAddress, packages types.Packages) ([]sdk.Event, sdk.Error) {
 	events := make([]sdk.Event, 0, len(packages))
 	for _, pack := range packages {
-		event, sdkErr := handlePackage(ctx, oracleKeeper, msg.ChainId, &pack)
+		event, sdkErr := handlePackage(ctx, oracleKeeper, chainId, relayers, &pack)
 		if sdkErr != nil {
 			// only do log, but let reset package get chance to execute.
 			ctx.Logger().With("module", "oracle").Error(fmt.Sprintf("process package failed, channel=%d, sequence=%d, error=%v", pack.ChannelId, pack.Sequence, sdkErr))
-			return sdkErr.Result()
+			return nil, sdkErr
 		} else {
 			ctx.Logger().With("module", "oracle").Info(fmt.Sprintf("process package success, channel=%d, sequence=%d", pack.ChannelId, pack.Sequence))
 		}
 		events = append(events, event)
 
 		// increase channel sequence
-		oracleKeeper.ScKeeper.IncrReceiveSequence(ctx, msg.ChainId, pack.ChannelId)
-	}
-
-	// delete prophecy when execute claim success
-	oracleKeeper.DeleteProphecy(ctx, prophecy.ID)
-	oracleKeeper.ScKeeper.IncrReceiveSequence(ctx, msg.ChainId, types.RelayPackagesChannelId)
-
-	return sdk.Result{
-		Events: events,
+		oracleKeeper.ScKeeper.IncrReceiveSequence(ctx, chainId, pack.ChannelId)
 	}
+	return events, nil
 }
 
-func handlePackage(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, pack *types.Package) (sdk.Event, sdk.Error) {
+func handlePackage(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, relayers []sdk.ValAddress, pack *types.Package) (sdk.Event, sdk.Error) {
 	logger := ctx.Logger().With("module", "x/oracle")
 
 	crossChainApp := oracleKeeper.ScKeeper.GetCrossChainApp(ctx, pack.ChannelId)
@@ -112,7 +194,16 @@ func handlePackage(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, pa
 		return sdk.Event{}, sdkErr
 	}
 
-	if ctx.IsDeliverTx() {
+	if sdk.IsUpgrade(sdk.RelayerReward) {
+		sdkErr = oracleKeeper.AllocateRelayerReward(ctx, relayers, feeAmount)
+		if sdkErr != nil {
+			return sdk.Event{}, sdkErr
+		}
+		if ctx.IsDeliverTx() {
+			// add changed accounts
+			oracleKeeper.Pool.AddAddrs([]sdk.AccAddress{sdk.PegAccount, types.RelayerRewardPoolAddr})
+		}
+	} else if ctx.IsDeliverTx() {
 		// add changed accounts
 		oracleKeeper.Pool.AddAddrs([]sdk.AccAddress{sdk.PegAccount})
 
@@ -127,12 +218,12 @@ func handlePackage(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, pa
 	}
 
 	cacheCtx, write := ctx.CacheContext()
-	crash, result := executeClaim(cacheCtx, crossChainApp, pack.Payload, packageType, feeAmount)
+	crash, result := executeClaim(cacheCtx, oracleKeeper, chainId, crossChainApp, pack, packageType, feeAmount)
 	if result.IsOk() {
 		write()
 	} else if ctx.IsDeliverTx() {
 		oracleKeeper.Metrics.ErrNumOfChannels.With("channel_id", fmt.Sprintf("%d", pack.ChannelId)).Add(1)
-		destChainName, err := oracleKeeper.ScKeeper.GetDestChainName(chainId)
+		destChainName, err := oracleKeeper.ScKeeper.GetDestChainName(ctx, chainId)
 		if err != nil {
 			logger.Error("failed to find name of dest chain", "chainId", chainId)
 		} else {
@@ -140,6 +231,11 @@ func handlePackage(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, pa
 		}
 	}
 
+	// the destination chain answers our syn packages in order
+	if sdk.IsUpgrade(sdk.IBCPackageCleanup) && packageType != sdk.SynCrossChainPackageType {
+		oracleKeeper.IbcKeeper.ConfirmSynPackage(ctx, chainId, pack.ChannelId)
+	}
+
 	// write ack package
 	var sendSequence int64 = -1
 	if packageType == sdk.SynCrossChainPackageType {
@@ -206,11 +302,12 @@ func handlePackage(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, pa
 	return event, nil
 }
 
-func executeClaim(ctx sdk.Context, app sdk.CrossChainApplication, payload []byte, packageType sdk.CrossChainPackageType, relayerFee int64) (crash bool, result sdk.ExecuteResult) {
+func executeClaim(ctx sdk.Context, oracleKeeper Keeper, chainId sdk.ChainID, app sdk.CrossChainApplication, pack *types.Package,
+	packageType sdk.CrossChainPackageType, relayerFee int64) (crash bool, result sdk.ExecuteResult) {
+	logger := ctx.Logger().With("module", "oracle")
 	defer func() {
 		if r := recover(); r != nil {
 			log := fmt.Sprintf("recovered: %v\nstack:\n%v", r, string(debug.Stack()))
-			logger := ctx.Logger().With("module", "oracle")
 			logger.Error("execute claim panic", "err_log", log)
 			crash = true
 			result = sdk.ExecuteResult{
@@ -219,13 +316,20 @@ func executeClaim(ctx sdk.Context, app sdk.CrossChainApplication, payload []byte
 		}
 	}()
 
+	payload := pack.Payload[sTypes.PackageHeaderLength:]
 	switch packageType {
 	case sdk.SynCrossChainPackageType:
-		result = app.ExecuteSynPackage(ctx, payload[sTypes.PackageHeaderLength:], relayerFee)
+		// the package is valued by the application, so the traffic is recorded in the recovered path as well
+		if limitErr := oracleKeeper.ScKeeper.RecordChannelTraffic(ctx, sTypes.TrafficInbound, chainId, pack.ChannelId, payload); limitErr != nil {
+			// the refused package is failed back to the source chain
+			logger.Error("refuse package exceeding the channel rate limit", "channelID", pack.ChannelId, "sequence", pack.Sequence, "err", limitErr)
+			return true, sdk.ExecuteResult{Err: limitErr}
+		}
+		result = app.ExecuteSynPackage(ctx, payload, relayerFee)
 	case sdk.AckCrossChainPackageType:
-		result = app.ExecuteAckPackage(ctx, payload[sTypes.PackageHeaderLength:])
+		result = app.ExecuteAckPackage(ctx, payload)
 	case sdk.FailAckCrossChainPackageType:
-		result = app.ExecuteFailAckPackage(ctx, payload[sTypes.PackageHeaderLength:])
+		result = app.ExecuteFailAckPackage(ctx, payload)
 	default:
 		panic(fmt.Sprintf("receive unexpected package type %d", packageType))
 	}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// RegisterInvariants registers the oracle invariants, outstanding returns the coins locked in the peg account by the
+// cross chain transfers which are not settled yet, it is provided by the cross chain apps of the node and may be nil
+func RegisterInvariants(ir sdk.InvariantRouter, bk bank.Keeper, outstanding func(ctx sdk.Context) sdk.Coins) {
+	ir.RegisterRoute(types.RouteOracle, "peg-account", PegAccountInvariant(bk, outstanding))
+}
+
+// PegAccountInvariant checks that the balance of the peg account is non-negative and covers the outstanding cross
+// chain transfers
+func PegAccountInvariant(bk bank.Keeper, outstanding func(ctx sdk.Context) sdk.Coins) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		balance := bk.GetCoins(ctx, sdk.PegAccount)
+		if !balance.IsNotNegative() {
+			return fmt.Errorf("peg account has a negative balance of %s", balance)
+		}
+		if outstanding == nil {
+			return nil
+		}
+
+		expected := outstanding(ctx)
+		if !balance.IsGTE(expected) {
+			return fmt.Errorf("peg account holds %s, less than the outstanding cross chain transfers %s", balance, expected)
+		}
+		return nil
+	}
+}
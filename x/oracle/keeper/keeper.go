@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/pubsub"
@@ -30,8 +32,12 @@ type Keeper struct {
 	IbcKeeper   ibc.Keeper
 	BkKeeper    bank.Keeper
 
+	lightClient types.LightClient
+
 	Metrics   *metrics.Metrics
 	pubServer *pubsub.Server
+
+	hooks types.OracleHooks
 }
 
 // Parameter store
@@ -65,12 +71,184 @@ func (k Keeper) GetConsensusNeeded(ctx sdk.Context) (consensusNeeded sdk.Dec) {
 	return
 }
 
+// GetProphecyExpireBlocks returns the number of blocks a pending prophecy is kept, 0 means prophecies never expire
+func (k Keeper) GetProphecyExpireBlocks(ctx sdk.Context) (expireBlocks int64) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyProphecyExpire, &expireBlocks)
+	return
+}
+
+// GetParticipationWindow returns the number of successful prophecies over which the participation of validators is tracked
+func (k Keeper) GetParticipationWindow(ctx sdk.Context) (window int64) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyParticipationWindow, &window)
+	return
+}
+
+// GetMinParticipationPerWindow returns the minimum number of prophecies a validator should claim in a window
+func (k Keeper) GetMinParticipationPerWindow(ctx sdk.Context) int64 {
+	minParticipation := sdk.ZeroDec()
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyMinParticipationPerWindow, &minParticipation)
+	return sdk.NewDec(k.GetParticipationWindow(ctx)).Mul(minParticipation).RawInt()
+}
+
+// GetAbsencePenalty returns the penalty applied to the validators whose participation is too low
+func (k Keeper) GetAbsencePenalty(ctx sdk.Context) types.AbsencePenalty {
+	penalty := types.AbsencePenalty{SlashFraction: sdk.ZeroDec()}
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyAbsenceSlashFraction, &penalty.SlashFraction)
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyAbsenceJailDuration, &penalty.JailDuration)
+	return penalty
+}
+
+// GetRelayerRewardDistribution returns how the relayer fee is shared among the validators
+func (k Keeper) GetRelayerRewardDistribution(ctx sdk.Context) (distribution string) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyRelayerRewardDistribution, &distribution)
+	return
+}
+
+// GetProofConfirmations returns the number of blocks the block of a proof-backed claim should be below the latest
+// header synced by the light client, it is the default before the param is set
+func (k Keeper) GetProofConfirmations(ctx sdk.Context) int64 {
+	confirmations := types.DefaultProofConfirmations
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyProofConfirmations, &confirmations)
+	return confirmations
+}
+
+// GetParams returns all the oracle params, the params not set yet are left as zero values
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.Params{
+		MinParticipationPerWindow: sdk.ZeroDec(),
+		AbsenceSlashFraction:      sdk.ZeroDec(),
+	}
+	for _, pair := range params.KeyValuePairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return params
+}
+
+// Set the oracle hooks
+func (k Keeper) WithHooks(oh types.OracleHooks) Keeper {
+	if k.hooks != nil {
+		panic("cannot set oracle hooks twice")
+	}
+	k.hooks = oh
+	return k
+}
+
 func (k *Keeper) EnablePrometheusMetrics() {
 	k.Metrics = metrics.PrometheusMetrics()
 }
 
 func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	sdk.Upgrade(sdk.ProphecyExpiry, func() {
+		pb := paramsBeforeProphecyExpiry{ConsensusNeeded: params.ConsensusNeeded}
+		k.paramSpace.SetParamSet(ctx, &pb)
+	}, nil, func() {
+		sdk.Upgrade(sdk.OracleLiveness, func() {
+			pb := paramsBeforeOracleLiveness{
+				ConsensusNeeded:      params.ConsensusNeeded,
+				ProphecyExpireBlocks: params.ProphecyExpireBlocks,
+			}
+			k.paramSpace.SetParamSet(ctx, &pb)
+		}, nil, func() {
+			sdk.Upgrade(sdk.RelayerReward, func() {
+				pb := paramsBeforeRelayerReward{
+					ConsensusNeeded:           params.ConsensusNeeded,
+					ProphecyExpireBlocks:      params.ProphecyExpireBlocks,
+					ParticipationWindow:       params.ParticipationWindow,
+					MinParticipationPerWindow: params.MinParticipationPerWindow,
+					AbsenceSlashFraction:      params.AbsenceSlashFraction,
+					AbsenceJailDuration:       params.AbsenceJailDuration,
+				}
+				k.paramSpace.SetParamSet(ctx, &pb)
+			}, nil, func() {
+				sdk.Upgrade(sdk.OracleProofClaim, func() {
+					pb := paramsBeforeOracleProofClaim{
+						ConsensusNeeded:           params.ConsensusNeeded,
+						ProphecyExpireBlocks:      params.ProphecyExpireBlocks,
+						ParticipationWindow:       params.ParticipationWindow,
+						MinParticipationPerWindow: params.MinParticipationPerWindow,
+						AbsenceSlashFraction:      params.AbsenceSlashFraction,
+						AbsenceJailDuration:       params.AbsenceJailDuration,
+						RelayerRewardDistribution: params.RelayerRewardDistribution,
+					}
+					k.paramSpace.SetParamSet(ctx, &pb)
+				}, nil, func() {
+					k.paramSpace.SetParamSet(ctx, &params)
+				})
+			})
+		})
+	})
+}
+
+// in order to be compatible with before
+type paramsBeforeProphecyExpiry struct {
+	ConsensusNeeded sdk.Dec `json:"ConsensusNeeded"`
+}
+
+// Implements params.ParamSet
+func (p *paramsBeforeProphecyExpiry) KeyValuePairs() param.KeyValuePairs {
+	return param.KeyValuePairs{
+		{types.ParamStoreKeyProphecyParams, &p.ConsensusNeeded},
+	}
+}
+
+// in order to be compatible with before
+type paramsBeforeOracleLiveness struct {
+	ConsensusNeeded      sdk.Dec `json:"ConsensusNeeded"`
+	ProphecyExpireBlocks int64   `json:"prophecy_expire_blocks"`
+}
+
+// Implements params.ParamSet
+func (p *paramsBeforeOracleLiveness) KeyValuePairs() param.KeyValuePairs {
+	return param.KeyValuePairs{
+		{types.ParamStoreKeyProphecyParams, &p.ConsensusNeeded},
+		{types.ParamStoreKeyProphecyExpire, &p.ProphecyExpireBlocks},
+	}
+}
+
+// in order to be compatible with before
+type paramsBeforeRelayerReward struct {
+	ConsensusNeeded           sdk.Dec       `json:"ConsensusNeeded"`
+	ProphecyExpireBlocks      int64         `json:"prophecy_expire_blocks"`
+	ParticipationWindow       int64         `json:"participation_window"`
+	MinParticipationPerWindow sdk.Dec       `json:"min_participation_per_window"`
+	AbsenceSlashFraction      sdk.Dec       `json:"absence_slash_fraction"`
+	AbsenceJailDuration       time.Duration `json:"absence_jail_duration"`
+}
+
+// Implements params.ParamSet
+func (p *paramsBeforeRelayerReward) KeyValuePairs() param.KeyValuePairs {
+	return param.KeyValuePairs{
+		{types.ParamStoreKeyProphecyParams, &p.ConsensusNeeded},
+		{types.ParamStoreKeyProphecyExpire, &p.ProphecyExpireBlocks},
+		{types.ParamStoreKeyParticipationWindow, &p.ParticipationWindow},
+		{types.ParamStoreKeyMinParticipationPerWindow, &p.MinParticipationPerWindow},
+		{types.ParamStoreKeyAbsenceSlashFraction, &p.AbsenceSlashFraction},
+		{types.ParamStoreKeyAbsenceJailDuration, &p.AbsenceJailDuration},
+	}
+}
+
+// in order to be compatible with before
+type paramsBeforeOracleProofClaim struct {
+	ConsensusNeeded           sdk.Dec       `json:"ConsensusNeeded"`
+	ProphecyExpireBlocks      int64         `json:"prophecy_expire_blocks"`
+	ParticipationWindow       int64         `json:"participation_window"`
+	MinParticipationPerWindow sdk.Dec       `json:"min_participation_per_window"`
+	AbsenceSlashFraction      sdk.Dec       `json:"absence_slash_fraction"`
+	AbsenceJailDuration       time.Duration `json:"absence_jail_duration"`
+	RelayerRewardDistribution string        `json:"relayer_reward_distribution"`
+}
+
+// Implements params.ParamSet
+func (p *paramsBeforeOracleProofClaim) KeyValuePairs() param.KeyValuePairs {
+	return param.KeyValuePairs{
+		{types.ParamStoreKeyProphecyParams, &p.ConsensusNeeded},
+		{types.ParamStoreKeyProphecyExpire, &p.ProphecyExpireBlocks},
+		{types.ParamStoreKeyParticipationWindow, &p.ParticipationWindow},
+		{types.ParamStoreKeyMinParticipationPerWindow, &p.MinParticipationPerWindow},
+		{types.ParamStoreKeyAbsenceSlashFraction, &p.AbsenceSlashFraction},
+		{types.ParamStoreKeyAbsenceJailDuration, &p.AbsenceJailDuration},
+		{types.ParamStoreKeyRelayerRewardDistribution, &p.RelayerRewardDistribution},
+	}
 }
 
 func (k *Keeper) SetPbsbServer(p *pubsub.Server) {
@@ -99,6 +277,11 @@ func (k Keeper) GetProphecy(ctx sdk.Context, id string) (types.Prophecy, bool) {
 // DeleteProphecy delete prophecy for a given id
 func (k Keeper) DeleteProphecy(ctx sdk.Context, id string) {
 	store := ctx.KVStore(k.storeKey)
+	if sdk.IsUpgrade(sdk.ProphecyExpiry) {
+		if prophecy, found := k.GetProphecy(ctx, id); found && prophecy.CreateHeight > 0 {
+			store.Delete(GetProphecyExpireQueueKey(prophecy.CreateHeight, id))
+		}
+	}
 	store.Delete([]byte(id))
 }
 
@@ -131,6 +314,10 @@ func (k Keeper) ProcessClaim(ctx sdk.Context, claim types.Claim) (types.Prophecy
 	prophecy, found := k.GetProphecy(ctx, claim.ID)
 	if !found {
 		prophecy = types.NewProphecy(claim.ID)
+		if sdk.IsUpgrade(sdk.ProphecyExpiry) {
+			prophecy.CreateHeight = ctx.BlockHeight()
+			k.enqueueProphecy(ctx, prophecy)
+		}
 	}
 
 	switch prophecy.Status.Text {
@@ -147,6 +334,89 @@ func (k Keeper) ProcessClaim(ctx sdk.Context, claim types.Claim) (types.Prophecy
 	return prophecy, nil
 }
 
+// enqueueProphecy adds the prophecy to the expire queue
+func (k Keeper) enqueueProphecy(ctx sdk.Context, prophecy types.Prophecy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetProphecyExpireQueueKey(prophecy.CreateHeight, prophecy.ID), []byte{})
+}
+
+// IteratePendingProphecies iterates over the prophecies in the expire queue in the order of their create height
+func (k Keeper) IteratePendingProphecies(ctx sdk.Context, handler func(prophecy types.Prophecy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ProphecyExpireQueuePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := splitProphecyExpireQueueKey(iterator.Key())
+		prophecy, found := k.GetProphecy(ctx, id)
+		if !found || prophecy.Status.Text != types.PendingStatusText {
+			continue
+		}
+		if handler(prophecy) {
+			break
+		}
+	}
+}
+
+// ExpireProphecies removes all the pending prophecies that were created more than ProphecyExpireBlocks blocks ago,
+// and returns the removed prophecies.
+func (k Keeper) ExpireProphecies(ctx sdk.Context) []types.Prophecy {
+	expireBlocks := k.GetProphecyExpireBlocks(ctx)
+	if expireBlocks <= 0 || ctx.BlockHeight() < expireBlocks {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	// all the prophecies created at or before this height are expired
+	endKey := GetProphecyExpireQueueHeightPrefix(ctx.BlockHeight() - expireBlocks + 1)
+	iterator := store.Iterator(ProphecyExpireQueuePrefix, endKey)
+	var ids []string
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := splitProphecyExpireQueueKey(iterator.Key())
+		ids = append(ids, id)
+	}
+	iterator.Close()
+
+	expired := make([]types.Prophecy, 0, len(ids))
+	for _, id := range ids {
+		prophecy, found := k.GetProphecy(ctx, id)
+		if !found {
+			ctx.Logger().With("module", "oracle").Error("prophecy in expire queue not found", "id", id)
+			continue
+		}
+		k.DeleteProphecy(ctx, id)
+		expired = append(expired, prophecy)
+	}
+	return expired
+}
+
+// InitProphecyExpireQueue records the create height of the prophecies stored before the ProphecyExpiry upgrade
+// and puts them into the expire queue, the current height is regarded as their create height.
+func (k Keeper) InitProphecyExpireQueue(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(ProphecyKeyStart, ProphecyKeyEnd)
+	var prophecies []types.Prophecy
+	for ; iterator.Valid(); iterator.Next() {
+		var dbProphecy types.DBProphecy
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dbProphecy)
+		prophecy, err := dbProphecy.DeserializeFromDB()
+		if err != nil {
+			panic(err)
+		}
+		prophecies = append(prophecies, prophecy)
+	}
+	iterator.Close()
+
+	for _, prophecy := range prophecies {
+		if prophecy.CreateHeight > 0 {
+			continue
+		}
+		prophecy.CreateHeight = ctx.BlockHeight()
+		k.setProphecy(ctx, prophecy)
+		k.enqueueProphecy(ctx, prophecy)
+	}
+}
+
 func (k Keeper) checkActiveValidator(ctx sdk.Context, validatorAddress sdk.ValAddress) bool {
 	validator, found := k.stakeKeeper.GetValidator(ctx, validatorAddress)
 	if !found {
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -278,3 +280,340 @@ func TestNonValidator(t *testing.T) {
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "claim must be made by actively bonded validator"))
 }
+
+func TestProphecyExpire(t *testing.T) {
+	mapp, _, keeper, sk, addrs, _, _ := getMockApp(t, 3)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ProphecyExpiry, 1)
+	sdk.UpgradeMgr.SetHeight(1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.ProphecyExpiry, 0)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+	stakeHandler := stake.NewStakeHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs))
+	for i, addr := range addrs {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	createValidators(t, stakeHandler, ctx, valAddrs, []int64{5, 5, 5})
+	stake.EndBlocker(ctx, sk)
+	keeper.SetParams(ctx, types.Params{ConsensusNeeded: sdk.NewDecWithPrec(6, 1), ProphecyExpireBlocks: 100})
+	require.Equal(t, int64(100), keeper.GetProphecyExpireBlocks(ctx))
+
+	ctx = ctx.WithBlockHeight(10)
+	_, err := keeper.ProcessClaim(ctx, types.NewClaim(TestID, valAddrs[0], TestString))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(20)
+	_, err = keeper.ProcessClaim(ctx, types.NewClaim(AlternateTestID, valAddrs[0], TestString))
+	require.NoError(t, err)
+	_, err = keeper.ProcessClaim(ctx, types.NewClaim(TestID, valAddrs[1], AlternateTestString))
+	require.NoError(t, err)
+
+	prophecy, found := keeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, int64(10), prophecy.CreateHeight)
+
+	var pending []string
+	keeper.IteratePendingProphecies(ctx, func(prophecy types.Prophecy) bool {
+		pending = append(pending, prophecy.ID)
+		return false
+	})
+	require.Equal(t, []string{TestID, AlternateTestID}, pending)
+
+	ctx = ctx.WithBlockHeight(109)
+	require.Len(t, keeper.ExpireProphecies(ctx), 0)
+
+	ctx = ctx.WithBlockHeight(110)
+	expired := keeper.ExpireProphecies(ctx)
+	require.Len(t, expired, 1)
+	require.Equal(t, TestID, expired[0].ID)
+	_, found = keeper.GetProphecy(ctx, TestID)
+	require.False(t, found)
+
+	// a finalized prophecy is removed from the expire queue together with the prophecy
+	prophecy, err = keeper.ProcessClaim(ctx, types.NewClaim(AlternateTestID, valAddrs[1], TestString))
+	require.NoError(t, err)
+	require.Equal(t, types.SuccessStatusText, prophecy.Status.Text)
+	keeper.DeleteProphecy(ctx, AlternateTestID)
+
+	ctx = ctx.WithBlockHeight(1000)
+	require.Len(t, keeper.ExpireProphecies(ctx), 0)
+	pending = nil
+	keeper.IteratePendingProphecies(ctx, func(prophecy types.Prophecy) bool {
+		pending = append(pending, prophecy.ID)
+		return false
+	})
+	require.Len(t, pending, 0)
+}
+
+func TestInitProphecyExpireQueue(t *testing.T) {
+	mapp, _, keeper, sk, addrs, _, _ := getMockApp(t, 3)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+	stakeHandler := stake.NewStakeHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs))
+	for i, addr := range addrs {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	createValidators(t, stakeHandler, ctx, valAddrs, []int64{5, 5, 5})
+	stake.EndBlocker(ctx, sk)
+	keeper.SetParams(ctx, types.Params{ConsensusNeeded: sdk.NewDecWithPrec(6, 1)})
+
+	// prophecies created before the upgrade have no create height
+	_, err := keeper.ProcessClaim(ctx, types.NewClaim(TestID, valAddrs[0], TestString))
+	require.NoError(t, err)
+	prophecy, found := keeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, int64(0), prophecy.CreateHeight)
+
+	// the claim ids next to the store prefixes are still prophecies, and the other keys are never decoded as ones
+	edgeIDs := []string{" :0:0", "~:0:0"}
+	for _, id := range edgeIDs {
+		_, err = keeper.ProcessClaim(ctx, types.NewClaim(id, valAddrs[0], TestString))
+		require.NoError(t, err)
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set([]byte{0x06, '1'}, []byte{0xff})
+	store.Set([]byte{0x1f, '1'}, []byte{0xff})
+	store.Set([]byte{0x7f, '1'}, []byte{0xff})
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ProphecyExpiry, 50)
+	sdk.UpgradeMgr.SetHeight(50)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.ProphecyExpiry, 0)
+
+	ctx = ctx.WithBlockHeight(50)
+	keeper.InitProphecyExpireQueue(ctx)
+	keeper.SetParams(ctx, types.Params{ConsensusNeeded: sdk.NewDecWithPrec(6, 1), ProphecyExpireBlocks: 100})
+
+	prophecy, found = keeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, int64(50), prophecy.CreateHeight)
+	require.Equal(t, TestString, prophecy.ValidatorClaims[valAddrs[0].String()])
+	for _, id := range edgeIDs {
+		prophecy, found = keeper.GetProphecy(ctx, id)
+		require.True(t, found)
+		require.Equal(t, int64(50), prophecy.CreateHeight)
+	}
+
+	ctx = ctx.WithBlockHeight(150)
+	expired := keeper.ExpireProphecies(ctx)
+	require.Len(t, expired, 3)
+}
+
+type mockOracleHooks struct {
+	absent    []sdk.ValAddress
+	penalties []types.AbsencePenalty
+}
+
+func (h *mockOracleHooks) OnValidatorAbsent(_ sdk.Context, valAddr sdk.ValAddress, penalty types.AbsencePenalty) {
+	h.absent = append(h.absent, valAddr)
+	h.penalties = append(h.penalties, penalty)
+}
+
+func TestProphecyParticipation(t *testing.T) {
+	mapp, _, keeper, sk, addrs, _, _ := getMockApp(t, 3)
+	hooks := &mockOracleHooks{}
+	keeper = keeper.WithHooks(hooks)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ProphecyExpiry, 1)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.OracleLiveness, 1)
+	sdk.UpgradeMgr.SetHeight(1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.ProphecyExpiry, 0)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.OracleLiveness, 0)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+	stakeHandler := stake.NewStakeHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs))
+	for i, addr := range addrs {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	createValidators(t, stakeHandler, ctx, valAddrs, []int64{5, 5, 5})
+	stake.EndBlocker(ctx, sk)
+
+	penalty := types.AbsencePenalty{SlashFraction: sdk.NewDecWithPrec(1, 3), JailDuration: time.Hour}
+	keeper.SetParams(ctx, types.Params{
+		ConsensusNeeded:           sdk.NewDecWithPrec(6, 1),
+		ProphecyExpireBlocks:      100,
+		ParticipationWindow:       100,
+		MinParticipationPerWindow: sdk.NewDecWithPrec(5, 1),
+		AbsenceSlashFraction:      penalty.SlashFraction,
+		AbsenceJailDuration:       penalty.JailDuration,
+	})
+	require.Equal(t, int64(100), keeper.GetParticipationWindow(ctx))
+	require.Equal(t, int64(50), keeper.GetMinParticipationPerWindow(ctx))
+	require.Equal(t, penalty, keeper.GetAbsencePenalty(ctx))
+
+	// validator 2 never claims, validator 1 claims every other prophecy
+	for i := 0; i < 99; i++ {
+		prophecy := types.NewProphecy(fmt.Sprintf("%d", i))
+		prophecy.AddClaim(valAddrs[0], TestString)
+		if i%2 == 0 {
+			prophecy.AddClaim(valAddrs[1], TestString)
+		}
+		require.Empty(t, keeper.HandleProphecyParticipation(ctx, types.NewFinalizedProphecy(prophecy, 0)))
+	}
+
+	participation, found := keeper.GetValidatorParticipation(ctx, valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, int64(99), participation.IndexOffset)
+	require.Equal(t, int64(99), participation.MissedClaimsCounter)
+	participation, found = keeper.GetValidatorParticipation(ctx, valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, int64(49), participation.MissedClaimsCounter)
+
+	prophecy := types.NewProphecy("99")
+	prophecy.AddClaim(valAddrs[0], TestString)
+	absent := keeper.HandleProphecyParticipation(ctx, types.NewFinalizedProphecy(prophecy, 0))
+	require.Equal(t, []sdk.ValAddress{valAddrs[2]}, absent)
+	require.Equal(t, []sdk.ValAddress{valAddrs[2]}, hooks.absent)
+	require.Equal(t, []types.AbsencePenalty{penalty}, hooks.penalties)
+
+	// the participation of the absent validator is reset
+	participation, found = keeper.GetValidatorParticipation(ctx, valAddrs[2])
+	require.True(t, found)
+	require.Equal(t, int64(0), participation.IndexOffset)
+	require.Equal(t, int64(0), participation.MissedClaimsCounter)
+	require.False(t, keeper.getValidatorMissedProphecyBitArray(ctx, valAddrs[2], 0))
+
+	// the window slides over the oldest prophecies, the claim of validator 1 overwrites its miss of prophecy 1
+	participation, _ = keeper.GetValidatorParticipation(ctx, valAddrs[1])
+	require.Equal(t, int64(50), participation.MissedClaimsCounter)
+	for _, id := range []string{"100", "101"} {
+		prophecy = types.NewProphecy(id)
+		prophecy.AddClaim(valAddrs[1], TestString)
+		require.Empty(t, keeper.HandleProphecyParticipation(ctx, types.NewFinalizedProphecy(prophecy, 0)))
+	}
+	participation, _ = keeper.GetValidatorParticipation(ctx, valAddrs[1])
+	require.Equal(t, int64(49), participation.MissedClaimsCounter)
+
+	var participations []types.ValidatorParticipation
+	keeper.IterateValidatorParticipations(ctx, func(participation types.ValidatorParticipation) bool {
+		participations = append(participations, participation)
+		return false
+	})
+	require.Len(t, participations, 3)
+
+	// the slower validators can claim a successful prophecy in the grace blocks
+	ctx = ctx.WithBlockHeight(10)
+	prophecy = types.NewProphecy("102")
+	prophecy.AddClaim(valAddrs[0], TestString)
+	prophecy.Status.FinalClaim = TestString
+	keeper.SetFinalizedProphecy(ctx, types.NewFinalizedProphecy(prophecy, 10))
+
+	lateCtx := ctx.WithBlockHeight(10 + types.ParticipationGraceBlocks)
+	found, err := keeper.ProcessLateClaim(lateCtx, types.NewClaim("102", valAddrs[1], TestString))
+	require.True(t, found)
+	require.Nil(t, err)
+	found, err = keeper.ProcessLateClaim(lateCtx, types.NewClaim("102", valAddrs[1], TestString))
+	require.True(t, found)
+	require.NotNil(t, err)
+	found, err = keeper.ProcessLateClaim(lateCtx, types.NewClaim("102", valAddrs[2], "wrong"))
+	require.True(t, found)
+	require.NotNil(t, err)
+	found, _ = keeper.ProcessLateClaim(lateCtx.WithBlockHeight(11+types.ParticipationGraceBlocks), types.NewClaim("102", valAddrs[2], TestString))
+	require.False(t, found)
+
+	// the participation is evaluated after the grace blocks
+	require.Empty(t, keeper.EvaluateParticipations(ctx))
+	_, found = keeper.GetFinalizedProphecy(ctx, "102")
+	require.True(t, found)
+	require.Empty(t, keeper.EvaluateParticipations(lateCtx))
+	_, found = keeper.GetFinalizedProphecy(ctx, "102")
+	require.False(t, found)
+	participation, _ = keeper.GetValidatorParticipation(ctx, valAddrs[1])
+	require.Equal(t, int64(49), participation.MissedClaimsCounter)
+	participation, _ = keeper.GetValidatorParticipation(ctx, valAddrs[2])
+	require.Equal(t, int64(3), participation.MissedClaimsCounter)
+}
+
+func TestRelayerReward(t *testing.T) {
+	mapp, ck, keeper, sk, addrs, _, _ := getMockApp(t, 3)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	for _, upgrade := range []string{sdk.ProphecyExpiry, sdk.OracleLiveness, sdk.RelayerReward} {
+		sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 1)
+		defer sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 0)
+	}
+	sdk.UpgradeMgr.SetHeight(1)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+	stakeHandler := stake.NewStakeHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs))
+	for i, addr := range addrs {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	createValidators(t, stakeHandler, ctx, valAddrs, []int64{10, 20, 30})
+	stake.EndBlocker(ctx, sk)
+
+	params := types.Params{
+		ConsensusNeeded:           sdk.NewDecWithPrec(6, 1),
+		ProphecyExpireBlocks:      100,
+		ParticipationWindow:       100,
+		MinParticipationPerWindow: sdk.NewDecWithPrec(5, 1),
+		AbsenceSlashFraction:      sdk.ZeroDec(),
+		RelayerRewardDistribution: types.RelayerRewardDistributionByPower,
+	}
+	keeper.SetParams(ctx, params)
+	require.Equal(t, params, keeper.GetParams(ctx))
+
+	// shared by power, the remainder goes to the validator with the highest power
+	err := keeper.AllocateRelayerReward(ctx, []sdk.ValAddress{valAddrs[2], valAddrs[0], valAddrs[1]}, 100)
+	require.NoError(t, err)
+	for i, expected := range []int64{16, 33, 51} {
+		reward, found := keeper.GetRelayerReward(ctx, valAddrs[i])
+		require.True(t, found)
+		require.Equal(t, expected, reward.Amount)
+	}
+	require.Equal(t, int64(100), ck.GetCoins(ctx, types.RelayerRewardPoolAddr).AmountOf(sdk.NativeTokenSymbol))
+
+	// shared evenly
+	params.RelayerRewardDistribution = types.RelayerRewardDistributionEvenly
+	keeper.SetParams(ctx, params)
+	err = keeper.AllocateRelayerReward(ctx, []sdk.ValAddress{valAddrs[0], valAddrs[1]}, 10)
+	require.NoError(t, err)
+	reward, _ := keeper.GetRelayerReward(ctx, valAddrs[0])
+	require.Equal(t, int64(21), reward.Amount)
+	reward, _ = keeper.GetRelayerReward(ctx, valAddrs[1])
+	require.Equal(t, int64(38), reward.Amount)
+
+	var rewards []types.RelayerReward
+	keeper.IterateRelayerRewards(ctx, func(reward types.RelayerReward) bool {
+		rewards = append(rewards, reward)
+		return false
+	})
+	require.Len(t, rewards, 3)
+
+	// withdraw
+	balance := ck.GetCoins(ctx, addrs[0]).AmountOf(sdk.NativeTokenSymbol)
+	amount, err := keeper.WithdrawRelayerReward(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, int64(21), amount)
+	require.Equal(t, balance+21, ck.GetCoins(ctx, addrs[0]).AmountOf(sdk.NativeTokenSymbol))
+	require.Equal(t, int64(89), ck.GetCoins(ctx, types.RelayerRewardPoolAddr).AmountOf(sdk.NativeTokenSymbol))
+	_, found := keeper.GetRelayerReward(ctx, valAddrs[0])
+	require.False(t, found)
+
+	_, err = keeper.WithdrawRelayerReward(ctx, valAddrs[0])
+	require.Error(t, err)
+}
+
+func TestPegAccountInvariant(t *testing.T) {
+	mapp, bk, _, _, _, _, _ := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+
+	_, _, err := bk.AddCoins(ctx, sdk.PegAccount, sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)})
+	require.Nil(t, err)
+	require.Nil(t, PegAccountInvariant(bk, nil)(ctx))
+
+	outstanding := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)}
+	invariant := PegAccountInvariant(bk, func(sdk.Context) sdk.Coins { return outstanding })
+	require.Nil(t, invariant(ctx))
+
+	outstanding = sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 101)}
+	require.NotNil(t, invariant(ctx))
+}
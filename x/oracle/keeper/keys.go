@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prophecies are stored under their raw claim id, which only contains printable characters, so the prophecies are
+// the keys in [ProphecyKeyStart, ProphecyKeyEnd) and the prefixes below never collide with them.
+var (
+	ProphecyKeyStart = []byte{0x20} // the first printable character
+	ProphecyKeyEnd   = []byte{0x7f} // the end of the printable characters
+
+	ProphecyExpireQueuePrefix          = []byte{0x01} // prefix for the queue of prophecies indexed by create height
+	ValidatorParticipationKey          = []byte{0x02} // prefix for the claim participation of validators
+	ValidatorMissedProphecyBitArrayKey = []byte{0x03} // prefix for the missed prophecy bit array of validators
+	RelayerRewardKey                   = []byte{0x04} // prefix for the relayer reward of validators
+	FinalizedProphecyKey               = []byte{0x05} // prefix for the successful prophecies whose participation is not evaluated yet
+)
+
+const heightLength = 8
+
+// GetProphecyExpireQueueKey returns the key of a prophecy in the expire queue:
+// 0x01 | create height (big endian) | prophecy id
+func GetProphecyExpireQueueKey(createHeight int64, id string) []byte {
+	return append(GetProphecyExpireQueueHeightPrefix(createHeight), []byte(id)...)
+}
+
+// GetProphecyExpireQueueHeightPrefix returns the prefix of all prophecies created at the given height
+func GetProphecyExpireQueueHeightPrefix(createHeight int64) []byte {
+	key := make([]byte, len(ProphecyExpireQueuePrefix)+heightLength)
+	copy(key, ProphecyExpireQueuePrefix)
+	binary.BigEndian.PutUint64(key[len(ProphecyExpireQueuePrefix):], uint64(createHeight))
+	return key
+}
+
+func splitProphecyExpireQueueKey(key []byte) (createHeight int64, id string) {
+	prefixLength := len(ProphecyExpireQueuePrefix)
+	createHeight = int64(binary.BigEndian.Uint64(key[prefixLength : prefixLength+heightLength]))
+	id = string(key[prefixLength+heightLength:])
+	return
+}
+
+// GetValidatorParticipationKey returns the key of the claim participation of a validator:
+// 0x02 | validator operator address
+func GetValidatorParticipationKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorParticipationKey, valAddr.Bytes()...)
+}
+
+// GetValidatorMissedProphecyBitArrayPrefixKey returns the prefix of the missed prophecy bit array of a validator
+func GetValidatorMissedProphecyBitArrayPrefixKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorMissedProphecyBitArrayKey, valAddr.Bytes()...)
+}
+
+// GetValidatorMissedProphecyBitArrayKey returns the key of an index in the missed prophecy bit array of a validator:
+// 0x03 | validator operator address | index (little endian)
+func GetValidatorMissedProphecyBitArrayKey(valAddr sdk.ValAddress, index int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(index))
+	return append(GetValidatorMissedProphecyBitArrayPrefixKey(valAddr), b...)
+}
+
+// GetRelayerRewardKey returns the key of the relayer reward of a validator:
+// 0x04 | validator operator address
+func GetRelayerRewardKey(valAddr sdk.ValAddress) []byte {
+	return append(RelayerRewardKey, valAddr.Bytes()...)
+}
+
+// GetFinalizedProphecyKey returns the key of a successful prophecy whose participation is not evaluated yet:
+// 0x05 | prophecy id
+func GetFinalizedProphecyKey(id string) []byte {
+	return append(FinalizedProphecyKey, []byte(id)...)
+}
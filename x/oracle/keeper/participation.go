@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// GetValidatorParticipation returns the claim participation of a validator
+func (k Keeper) GetValidatorParticipation(ctx sdk.Context, valAddr sdk.ValAddress) (participation types.ValidatorParticipation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorParticipationKey(valAddr))
+	if bz == nil {
+		return participation, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &participation)
+	return participation, true
+}
+
+func (k Keeper) setValidatorParticipation(ctx sdk.Context, participation types.ValidatorParticipation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(participation)
+	store.Set(GetValidatorParticipationKey(participation.ValidatorAddr), bz)
+}
+
+// IterateValidatorParticipations iterates over the claim participation of all tracked validators
+func (k Keeper) IterateValidatorParticipations(ctx sdk.Context, handler func(participation types.ValidatorParticipation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorParticipationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var participation types.ValidatorParticipation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &participation)
+		if handler(participation) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getValidatorMissedProphecyBitArray(ctx sdk.Context, valAddr sdk.ValAddress, index int64) (missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorMissedProphecyBitArrayKey(valAddr, index))
+	if bz == nil {
+		// lazy: treat empty key as not missed
+		return false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
+	return
+}
+
+func (k Keeper) setValidatorMissedProphecyBitArray(ctx sdk.Context, valAddr sdk.ValAddress, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(missed)
+	store.Set(GetValidatorMissedProphecyBitArrayKey(valAddr, index), bz)
+}
+
+func (k Keeper) clearValidatorMissedProphecyBitArray(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorMissedProphecyBitArrayPrefixKey(valAddr))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetFinalizedProphecy returns the successful prophecy whose participation is not evaluated yet
+func (k Keeper) GetFinalizedProphecy(ctx sdk.Context, id string) (prophecy types.FinalizedProphecy, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetFinalizedProphecyKey(id))
+	if bz == nil {
+		return prophecy, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &prophecy)
+	return prophecy, true
+}
+
+// SetFinalizedProphecy keeps the successful prophecy until its participation is evaluated after the grace blocks
+func (k Keeper) SetFinalizedProphecy(ctx sdk.Context, prophecy types.FinalizedProphecy) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(prophecy)
+	store.Set(GetFinalizedProphecyKey(prophecy.ID), bz)
+}
+
+// ProcessLateClaim records the claim of a prophecy which succeeded in the latest grace blocks, found is false if
+// there is no such prophecy
+func (k Keeper) ProcessLateClaim(ctx sdk.Context, claim types.Claim) (found bool, err sdk.Error) {
+	prophecy, found := k.GetFinalizedProphecy(ctx, claim.ID)
+	if !found || prophecy.IsGraceOver(ctx.BlockHeight()) {
+		return false, nil
+	}
+	if !k.checkActiveValidator(ctx, claim.ValidatorAddress) {
+		return true, types.ErrInvalidValidator()
+	}
+	if prophecy.HasClaimed(claim.ValidatorAddress) {
+		return true, types.ErrDuplicateMessage()
+	}
+	// only the final claim counts, a late claim can not change the executed packages
+	if claim.Payload != prophecy.FinalClaim {
+		return true, types.ErrInvalidClaim()
+	}
+
+	prophecy.AddClaimant(claim.ValidatorAddress)
+	k.SetFinalizedProphecy(ctx, prophecy)
+	return true, nil
+}
+
+// EvaluateParticipations evaluates the participation of the successful prophecies whose grace blocks are over.
+// It returns the validators found absent.
+func (k Keeper) EvaluateParticipations(ctx sdk.Context) (absentValidators []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, FinalizedProphecyKey)
+	var prophecies []types.FinalizedProphecy
+	for ; iterator.Valid(); iterator.Next() {
+		var prophecy types.FinalizedProphecy
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &prophecy)
+		if prophecy.IsGraceOver(ctx.BlockHeight() + 1) {
+			prophecies = append(prophecies, prophecy)
+		}
+	}
+	iterator.Close()
+
+	// evaluate in the order the prophecies succeeded
+	sort.SliceStable(prophecies, func(i, j int) bool {
+		return prophecies[i].FinalizeHeight < prophecies[j].FinalizeHeight
+	})
+	for _, prophecy := range prophecies {
+		store.Delete(GetFinalizedProphecyKey(prophecy.ID))
+		absentValidators = append(absentValidators, k.HandleProphecyParticipation(ctx, prophecy)...)
+	}
+	return absentValidators
+}
+
+// HandleProphecyParticipation records for every bonded validator whether it claimed the successful prophecy,
+// and hands the validators whose participation dropped below the threshold to the oracle hooks.
+// It returns the validators found absent.
+func (k Keeper) HandleProphecyParticipation(ctx sdk.Context, prophecy types.FinalizedProphecy) (absentValidators []sdk.ValAddress) {
+	window := k.GetParticipationWindow(ctx)
+	if window <= 0 {
+		return nil
+	}
+	logger := ctx.Logger().With("module", "oracle")
+	maxMissed := window - k.GetMinParticipationPerWindow(ctx)
+
+	for _, validator := range k.stakeKeeper.GetBondedValidatorsByPower(ctx) {
+		valAddr := validator.GetOperator()
+		participation, found := k.GetValidatorParticipation(ctx, valAddr)
+		if !found {
+			participation = types.NewValidatorParticipation(valAddr, ctx.BlockHeight())
+		}
+		index := participation.IndexOffset % window
+		participation.IndexOffset++
+
+		// the counter just tracks the sum of the bit array,
+		// so that we do not need to read the whole array each time
+		previous := k.getValidatorMissedProphecyBitArray(ctx, valAddr, index)
+		missed := !prophecy.HasClaimed(valAddr)
+		switch {
+		case !previous && missed:
+			k.setValidatorMissedProphecyBitArray(ctx, valAddr, index, true)
+			participation.MissedClaimsCounter++
+		case previous && !missed:
+			k.setValidatorMissedProphecyBitArray(ctx, valAddr, index, false)
+			participation.MissedClaimsCounter--
+		default:
+			// bit array value at this index has not changed, no need to update counter
+		}
+
+		if participation.IndexOffset >= window && participation.MissedClaimsCounter > maxMissed {
+			logger.Info(fmt.Sprintf("Validator %s missed %d of the latest %d prophecies, threshold %d",
+				valAddr, participation.MissedClaimsCounter, window, maxMissed))
+			if k.hooks != nil {
+				k.hooks.OnValidatorAbsent(ctx, valAddr, k.GetAbsencePenalty(ctx))
+			}
+			absentValidators = append(absentValidators, valAddr)
+			// reset the counter & array so that the validator won't be punished again immediately.
+			participation.MissedClaimsCounter = 0
+			participation.IndexOffset = 0
+			k.clearValidatorMissedProphecyBitArray(ctx, valAddr)
+		}
+
+		k.setValidatorParticipation(ctx, participation)
+	}
+	return absentValidators
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// SetLightClient sets the light client verifying the proof-backed claims after the OracleProofClaim upgrade
+func (k *Keeper) SetLightClient(lightClient types.LightClient) {
+	k.lightClient = lightClient
+}
+
+// VerifyProofClaim verifies the receipts of the claimed block against the receipt root trusted by the light client,
+// and returns the packages of the claimed sequence emitted in the block. The block should be confirmed by the
+// ProofConfirmations blocks synced after it, so that a header signed by a few validators is not trusted alone.
+func (k Keeper) VerifyProofClaim(ctx sdk.Context, msg types.ProofClaimMsg) (types.Packages, sdk.Error) {
+	if k.lightClient == nil {
+		return nil, types.ErrInvalidProof("no light client to verify the proof")
+	}
+	if !k.checkActiveValidator(ctx, sdk.ValAddress(msg.ValidatorAddress)) {
+		return nil, types.ErrInvalidValidator()
+	}
+
+	sideChainId, err := k.ScKeeper.GetDestChainName(ctx, msg.ChainId)
+	if err != nil {
+		return nil, types.ErrInvalidProof(err.Error())
+	}
+	latest, found := k.lightClient.LatestHeight(ctx, sideChainId)
+	if !found {
+		return nil, types.ErrInvalidProof(fmt.Sprintf("%s is not tracked by the light client", sideChainId))
+	}
+	if confirmations := uint64(k.GetProofConfirmations(ctx)); msg.Height+confirmations > latest {
+		return nil, types.ErrInvalidProof(fmt.Sprintf("block %d of %s is not confirmed by %d blocks, the latest block is %d",
+			msg.Height, sideChainId, confirmations, latest))
+	}
+	receiptHash, found := k.lightClient.TrustedReceiptHash(ctx, sideChainId, msg.Height)
+	if !found {
+		return nil, types.ErrInvalidProof(fmt.Sprintf("block %d of %s is not trusted by the light client", msg.Height, sideChainId))
+	}
+	receipts, err := bsc.VerifyReceiptsProof(receiptHash, msg.TxCount, bsc.NewProofDB(msg.Proof))
+	if err != nil {
+		return nil, types.ErrInvalidProof(err.Error())
+	}
+
+	packages, err := types.PackagesFromReceipts(receipts, k.ScKeeper.GetSrcChainID(), msg.Sequence)
+	if err != nil {
+		return nil, types.ErrInvalidProof(err.Error())
+	}
+	if len(packages) == 0 {
+		return nil, types.ErrInvalidProof(fmt.Sprintf("no package of sequence %d in block %d", msg.Sequence, msg.Height))
+	}
+	return packages, nil
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+type mockLightClient struct {
+	receiptHashes map[uint64]bsc.Hash
+	latest        uint64
+}
+
+func (lc mockLightClient) TrustedReceiptHash(_ sdk.Context, sideChainId string, height uint64) (bsc.Hash, bool) {
+	if sideChainId != "bsc" {
+		return bsc.Hash{}, false
+	}
+	hash, found := lc.receiptHashes[height]
+	return hash, found
+}
+
+func (lc mockLightClient) LatestHeight(_ sdk.Context, sideChainId string) (uint64, bool) {
+	return lc.latest, sideChainId == "bsc"
+}
+
+func abiWord(v uint64) []byte {
+	return bsc.BytesToHash(new(big.Int).SetUint64(v).Bytes()).Bytes()
+}
+
+func genCrossChainPackageLog(chainId sdk.ChainID, oracleSequence, packageSequence uint64, channelId sdk.ChannelID, payload []byte) bsc.Log {
+	data := append(abiWord(uint64(chainId)), abiWord(64)...)
+	data = append(data, abiWord(uint64(len(payload)))...)
+	data = append(data, payload...)
+	data = append(data, make([]byte, (32-len(payload)%32)%32)...)
+	return bsc.Log{
+		Address: types.CrossChainContractAddr,
+		Topics: []bsc.Hash{types.CrossChainPackageEventTopic, bsc.BytesToHash(abiWord(oracleSequence)),
+			bsc.BytesToHash(abiWord(packageSequence)), bsc.BytesToHash(abiWord(uint64(channelId)))},
+		Data: data,
+	}
+}
+
+// genSingleReceiptProof returns the receipt root of a block with only one transaction, and the proof of its receipt,
+// the trie has only the leaf of the key rlp(0) = 0x80
+func genSingleReceiptProof(t *testing.T, logs []bsc.Log) (bsc.Hash, [][]byte) {
+	var bloom bsc.Bloom
+	for _, log := range logs {
+		bloom.Add(log.Address[:])
+		for _, topic := range log.Topics {
+			bloom.Add(topic[:])
+		}
+	}
+	receipt, err := rlp.EncodeToBytes([]interface{}{[]byte{1}, uint64(21000), bloom, logs})
+	require.NoError(t, err)
+	leaf, err := rlp.EncodeToBytes([]interface{}{[]byte{0x20, 0x80}, receipt})
+	require.NoError(t, err)
+	return bsc.BytesToHash(bsc.Keccak256(leaf)), [][]byte{leaf}
+}
+
+func TestVerifyProofClaim(t *testing.T) {
+	mapp, _, keeper, sk, addrs, _, _ := getMockApp(t, 3)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+	stakeHandler := stake.NewStakeHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs))
+	for i, addr := range addrs {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	createValidators(t, stakeHandler, ctx, valAddrs[:2], []int64{5, 5})
+	stake.EndBlocker(ctx, sk)
+
+	srcChainId, destChainId := sdk.ChainID(1), sdk.ChainID(56)
+	keeper.ScKeeper.SetSrcChainID(srcChainId)
+	require.NoError(t, keeper.ScKeeper.RegisterDestChain("bsc", destChainId))
+
+	logs := []bsc.Log{
+		genCrossChainPackageLog(srcChainId, 7, 10, 2, []byte("first package")),
+		// the package of another oracle sequence or to another chain is ignored
+		genCrossChainPackageLog(srcChainId, 6, 9, 2, []byte("previous package")),
+		genCrossChainPackageLog(sdk.ChainID(2), 7, 3, 2, []byte("package to another chain")),
+		genCrossChainPackageLog(srcChainId, 7, 0, 3, make([]byte, 64)),
+	}
+	root, proof := genSingleReceiptProof(t, logs)
+
+	msg := types.NewProofClaimMsg(destChainId, 7, 100, 1, proof, addrs[0])
+	require.Nil(t, msg.ValidateBasic())
+
+	_, err := keeper.VerifyProofClaim(ctx, msg)
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	// the block is too recent to be confirmed
+	receiptHashes := map[uint64]bsc.Hash{100: root, 101: bsc.EmptyRootHash}
+	keeper.SetLightClient(mockLightClient{receiptHashes: receiptHashes, latest: 100 + uint64(types.DefaultProofConfirmations) - 1})
+	_, err = keeper.VerifyProofClaim(ctx, msg)
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	keeper.SetLightClient(mockLightClient{receiptHashes: receiptHashes, latest: 100 + uint64(types.DefaultProofConfirmations)})
+	packages, err := keeper.VerifyProofClaim(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, types.Packages{
+		{ChannelId: 2, Sequence: 10, Payload: []byte("first package")},
+		{ChannelId: 3, Sequence: 0, Payload: make([]byte, 64)},
+	}, packages)
+
+	// not a validator
+	_, err = keeper.VerifyProofClaim(ctx, types.NewProofClaimMsg(destChainId, 7, 100, 1, proof, addrs[2]))
+	require.Equal(t, types.CodeInvalidValidator, err.Code())
+
+	// the block is not trusted
+	_, err = keeper.VerifyProofClaim(ctx, types.NewProofClaimMsg(destChainId, 7, 99, 1, proof, addrs[0]))
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	// the receipts are not complete
+	_, err = keeper.VerifyProofClaim(ctx, types.NewProofClaimMsg(destChainId, 7, 100, 2, proof, addrs[0]))
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	// the proof of another block
+	keeper.SetLightClient(mockLightClient{receiptHashes: receiptHashes, latest: 200})
+	_, err = keeper.VerifyProofClaim(ctx, types.NewProofClaimMsg(destChainId, 7, 101, 1, proof, addrs[0]))
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+
+	// no package of the sequence in the block
+	_, err = keeper.VerifyProofClaim(ctx, types.NewProofClaimMsg(destChainId, 8, 100, 1, proof, addrs[0]))
+	require.Equal(t, types.CodeInvalidProof, err.Code())
+}
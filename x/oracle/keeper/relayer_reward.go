@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// GetRelayerReward returns the relayer reward credited to a validator
+func (k Keeper) GetRelayerReward(ctx sdk.Context, valAddr sdk.ValAddress) (reward types.RelayerReward, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetRelayerRewardKey(valAddr))
+	if bz == nil {
+		return reward, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &reward)
+	return reward, true
+}
+
+func (k Keeper) setRelayerReward(ctx sdk.Context, reward types.RelayerReward) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(reward)
+	store.Set(GetRelayerRewardKey(reward.ValidatorAddr), bz)
+}
+
+func (k Keeper) deleteRelayerReward(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetRelayerRewardKey(valAddr))
+}
+
+// IterateRelayerRewards iterates over the relayer rewards of all validators
+func (k Keeper) IterateRelayerRewards(ctx sdk.Context, handler func(reward types.RelayerReward) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RelayerRewardKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reward types.RelayerReward
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &reward)
+		if handler(reward) {
+			break
+		}
+	}
+}
+
+// AllocateRelayerReward moves the relayer fee of a package into the relayer reward pool, and credits it to the
+// validators whose claims made up the successful prophecy, in proportion to their voting power or evenly
+// according to the RelayerRewardDistribution param. The remainder of the division goes to the validator
+// with the highest weight.
+func (k Keeper) AllocateRelayerReward(ctx sdk.Context, relayers []sdk.ValAddress, amount int64) sdk.Error {
+	if amount <= 0 || len(relayers) == 0 {
+		return nil
+	}
+
+	// the claim validators of a prophecy are collected from a map, sort them to be deterministic
+	sorted := make([]sdk.ValAddress, len(relayers))
+	copy(sorted, relayers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+
+	weights := make([]int64, len(sorted))
+	totalWeight := int64(0)
+	if k.GetRelayerRewardDistribution(ctx) == types.RelayerRewardDistributionByPower {
+		for i, valAddr := range sorted {
+			validator, found := k.stakeKeeper.GetValidator(ctx, valAddr)
+			if found && validator.GetStatus().Equal(sdk.Bonded) {
+				weights[i] = validator.GetPower().RawInt()
+				totalWeight += weights[i]
+			}
+		}
+	}
+	// share evenly if required, or if none of the relayers is still bonded
+	if totalWeight <= 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+		totalWeight = int64(len(weights))
+	}
+
+	_, _, err := k.BkKeeper.AddCoins(ctx, types.RelayerRewardPoolAddr, sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, amount)})
+	if err != nil {
+		return err
+	}
+
+	shares := make([]int64, len(sorted))
+	allocated := int64(0)
+	highest := 0
+	for i, weight := range weights {
+		share := new(big.Int).Mul(big.NewInt(amount), big.NewInt(weight))
+		shares[i] = share.Quo(share, big.NewInt(totalWeight)).Int64()
+		allocated += shares[i]
+		if weight > weights[highest] {
+			highest = i
+		}
+	}
+	shares[highest] += amount - allocated
+
+	for i, valAddr := range sorted {
+		if shares[i] == 0 {
+			continue
+		}
+		reward, found := k.GetRelayerReward(ctx, valAddr)
+		if !found {
+			reward = types.RelayerReward{ValidatorAddr: valAddr}
+		}
+		reward.Amount += shares[i]
+		k.setRelayerReward(ctx, reward)
+	}
+	return nil
+}
+
+// WithdrawRelayerReward transfers all the relayer reward credited to a validator to its operator account
+func (k Keeper) WithdrawRelayerReward(ctx sdk.Context, valAddr sdk.ValAddress) (int64, sdk.Error) {
+	reward, found := k.GetRelayerReward(ctx, valAddr)
+	if !found || reward.Amount <= 0 {
+		return 0, types.ErrNoRelayerReward(valAddr.String())
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, reward.Amount)}
+	_, _, err := k.BkKeeper.SubtractCoins(ctx, types.RelayerRewardPoolAddr, coins)
+	if err != nil {
+		return 0, err
+	}
+	_, _, err = k.BkKeeper.AddCoins(ctx, sdk.AccAddress(valAddr), coins)
+	if err != nil {
+		return 0, err
+	}
+	k.deleteRelayerReward(ctx, valAddr)
+	return reward.Amount, nil
+}
@@ -9,6 +9,33 @@ func RegisterUpgradeBeginBlocker(keeper Keeper) {
 	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.LaunchBscUpgrade, func(ctx sdk.Context) {
 		keeper.SetParams(ctx, types.Params{ConsensusNeeded: types.DefaultConsensusNeeded})
 	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.ProphecyExpiry, func(ctx sdk.Context) {
+		keeper.SetParams(ctx, types.Params{
+			ConsensusNeeded:      keeper.GetConsensusNeeded(ctx),
+			ProphecyExpireBlocks: types.DefaultProphecyExpireBlocks,
+		})
+		keeper.InitProphecyExpireQueue(ctx)
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.OracleLiveness, func(ctx sdk.Context) {
+		keeper.SetParams(ctx, types.Params{
+			ConsensusNeeded:           keeper.GetConsensusNeeded(ctx),
+			ProphecyExpireBlocks:      keeper.GetProphecyExpireBlocks(ctx),
+			ParticipationWindow:       types.DefaultParticipationWindow,
+			MinParticipationPerWindow: types.DefaultMinParticipationPerWindow,
+			AbsenceSlashFraction:      types.DefaultAbsenceSlashFraction,
+			AbsenceJailDuration:       types.DefaultAbsenceJailDuration,
+		})
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.RelayerReward, func(ctx sdk.Context) {
+		params := keeper.GetParams(ctx)
+		params.RelayerRewardDistribution = types.DefaultRelayerRewardDistribution
+		keeper.SetParams(ctx, params)
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.OracleProofClaim, func(ctx sdk.Context) {
+		params := keeper.GetParams(ctx)
+		params.ProofConfirmations = types.DefaultProofConfirmations
+		keeper.SetParams(ctx, params)
+	})
 
 	err := keeper.ScKeeper.RegisterChannel(types.RelayPackagesChannelName, types.RelayPackagesChannelId, nil)
 	if err != nil {
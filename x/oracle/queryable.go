@@ -0,0 +1,234 @@
+package oracle
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	sTypes "github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+// query endpoints supported by the oracle Querier
+const (
+	QueryProphecy            = "prophecy"
+	QueryPendingProphecies   = "pendingProphecies"
+	QueryValidatorProphecies = "validatorProphecies"
+	QueryParticipation       = "participation"
+	QueryParticipations      = "participations"
+	QueryRelayerReward       = "relayerReward"
+	QueryRelayerRewards      = "relayerRewards"
+	QueryProphecyPackages    = "prophecyPackages"
+)
+
+// Params for query 'custom/oracle/prophecy'
+type QueryProphecyParams struct {
+	ChainId  sdk.ChainID `json:"chain_id"`
+	Sequence uint64      `json:"sequence"`
+}
+
+// Params for query 'custom/oracle/prophecyPackages', the claims of the prophecy are returned with the packages decoded
+type QueryProphecyPackagesParams struct {
+	ChainId  sdk.ChainID `json:"chain_id"`
+	Sequence uint64      `json:"sequence"`
+}
+
+// Params for query 'custom/oracle/validatorProphecies'
+type QueryValidatorPropheciesParams struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+}
+
+// Params for query 'custom/oracle/participation'
+type QueryParticipationParams struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+}
+
+// Params for query 'custom/oracle/relayerReward'
+type QueryRelayerRewardParams struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+}
+
+func NewQuerier(keeper Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryProphecy:
+			var params QueryProphecyParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			return queryProphecy(ctx, cdc, keeper, params)
+		case QueryProphecyPackages:
+			var params QueryProphecyPackagesParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			return queryProphecyPackages(ctx, keeper, params)
+		case QueryPendingProphecies:
+			return queryPendingProphecies(ctx, cdc, keeper)
+		case QueryValidatorProphecies:
+			var params QueryValidatorPropheciesParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			return queryValidatorProphecies(ctx, cdc, keeper, params)
+		case QueryParticipation:
+			var params QueryParticipationParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			return queryParticipation(ctx, cdc, keeper, params)
+		case QueryParticipations:
+			return queryParticipations(ctx, cdc, keeper)
+		case QueryRelayerReward:
+			var params QueryRelayerRewardParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+			}
+			return queryRelayerReward(ctx, cdc, keeper, params)
+		case QueryRelayerRewards:
+			return queryRelayerRewards(ctx, cdc, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown oracle query endpoint")
+		}
+	}
+}
+
+func queryProphecy(ctx sdk.Context, cdc *codec.Codec, keeper Keeper, params QueryProphecyParams) ([]byte, sdk.Error) {
+	id := types.GetClaimId(params.ChainId, types.RelayPackagesChannelId, params.Sequence)
+	prophecy, found := keeper.GetProphecy(ctx, id)
+	if !found {
+		return nil, types.ErrProphecyNotFound()
+	}
+	return marshalQueryResult(cdc, prophecy)
+}
+
+func queryProphecyPackages(ctx sdk.Context, keeper Keeper, params QueryProphecyPackagesParams) ([]byte, sdk.Error) {
+	id := types.GetClaimId(params.ChainId, types.RelayPackagesChannelId, params.Sequence)
+	prophecy, found := keeper.GetProphecy(ctx, id)
+	if !found {
+		return nil, types.ErrProphecyNotFound()
+	}
+
+	payloads := make([]string, 0, len(prophecy.ClaimValidators))
+	for payload := range prophecy.ClaimValidators {
+		payloads = append(payloads, payload)
+	}
+	sort.Strings(payloads)
+
+	claims := make([]types.DecodedClaim, 0, len(payloads))
+	for _, payload := range payloads {
+		claims = append(claims, decodeClaim(ctx, keeper, payload, prophecy.ClaimValidators[payload]))
+	}
+
+	// the decoded payloads are arbitrary types which are not registered in the codec
+	bz, err := json.MarshalIndent(claims, "", "  ")
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func decodeClaim(ctx sdk.Context, keeper Keeper, payload string, validators []sdk.ValAddress) types.DecodedClaim {
+	claim := types.DecodedClaim{
+		Validators: validators,
+		Packages:   make([]sTypes.DecodedPackage, 0),
+	}
+	bz, err := hex.DecodeString(payload)
+	if err != nil {
+		claim.RawPayload = payload
+		claim.DecodeError = err.Error()
+		return claim
+	}
+	packages := types.Packages{}
+	if err := rlp.DecodeBytes(bz, &packages); err != nil {
+		claim.RawPayload = payload
+		claim.DecodeError = err.Error()
+		return claim
+	}
+	for _, pack := range packages {
+		claim.Packages = append(claim.Packages, keeper.ScKeeper.DecodePackage(ctx, pack.ChannelId, pack.Sequence, pack.Payload))
+	}
+	return claim
+}
+
+func queryPendingProphecies(ctx sdk.Context, cdc *codec.Codec, keeper Keeper) ([]byte, sdk.Error) {
+	prophecies := make([]types.Prophecy, 0)
+	keeper.IteratePendingProphecies(ctx, func(prophecy types.Prophecy) bool {
+		prophecies = append(prophecies, prophecy)
+		return false
+	})
+	return marshalQueryResult(cdc, prophecies)
+}
+
+func queryValidatorProphecies(ctx sdk.Context, cdc *codec.Codec, keeper Keeper, params QueryValidatorPropheciesParams) ([]byte, sdk.Error) {
+	if len(params.ValidatorAddr) != sdk.AddrLen {
+		return nil, sdk.ErrInvalidAddress(params.ValidatorAddr.String())
+	}
+	validator := params.ValidatorAddr.String()
+	prophecies := make([]types.Prophecy, 0)
+	keeper.IteratePendingProphecies(ctx, func(prophecy types.Prophecy) bool {
+		if _, ok := prophecy.ValidatorClaims[validator]; ok {
+			prophecies = append(prophecies, prophecy)
+		}
+		return false
+	})
+	return marshalQueryResult(cdc, prophecies)
+}
+
+func queryParticipation(ctx sdk.Context, cdc *codec.Codec, keeper Keeper, params QueryParticipationParams) ([]byte, sdk.Error) {
+	if len(params.ValidatorAddr) != sdk.AddrLen {
+		return nil, sdk.ErrInvalidAddress(params.ValidatorAddr.String())
+	}
+	participation, found := keeper.GetValidatorParticipation(ctx, params.ValidatorAddr)
+	if !found {
+		return nil, types.ErrParticipationNotFound(params.ValidatorAddr.String())
+	}
+	return marshalQueryResult(cdc, participation)
+}
+
+func queryParticipations(ctx sdk.Context, cdc *codec.Codec, keeper Keeper) ([]byte, sdk.Error) {
+	participations := make([]types.ValidatorParticipation, 0)
+	keeper.IterateValidatorParticipations(ctx, func(participation types.ValidatorParticipation) bool {
+		participations = append(participations, participation)
+		return false
+	})
+	return marshalQueryResult(cdc, participations)
+}
+
+func queryRelayerReward(ctx sdk.Context, cdc *codec.Codec, keeper Keeper, params QueryRelayerRewardParams) ([]byte, sdk.Error) {
+	if len(params.ValidatorAddr) != sdk.AddrLen {
+		return nil, sdk.ErrInvalidAddress(params.ValidatorAddr.String())
+	}
+	reward, found := keeper.GetRelayerReward(ctx, params.ValidatorAddr)
+	if !found {
+		reward = types.RelayerReward{ValidatorAddr: params.ValidatorAddr}
+	}
+	return marshalQueryResult(cdc, reward)
+}
+
+func queryRelayerRewards(ctx sdk.Context, cdc *codec.Codec, keeper Keeper) ([]byte, sdk.Error) {
+	rewards := make([]types.RelayerReward, 0)
+	keeper.IterateRelayerRewards(ctx, func(reward types.RelayerReward) bool {
+		rewards = append(rewards, reward)
+		return false
+	})
+	return marshalQueryResult(cdc, rewards)
+}
+
+func marshalQueryResult(cdc *codec.Codec, result interface{}) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(cdc, result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sTypes "github.com/cosmos/cosmos-sdk/x/sidechain/types"
 )
 
 const (
@@ -31,3 +32,11 @@ func NewClaim(id string, validatorAddress sdk.ValAddress, payload string) Claim
 		Payload:          payload,
 	}
 }
+
+// DecodedClaim is a claim of a prophecy with the packages in it decoded, RawPayload is kept if the claim can not be decoded
+type DecodedClaim struct {
+	Validators  []sdk.ValAddress        `json:"validators"`
+	Packages    []sTypes.DecodedPackage `json:"packages"`
+	RawPayload  string                  `json:"raw_payload,omitempty"`
+	DecodeError string                  `json:"decode_error,omitempty"`
+}
@@ -24,6 +24,9 @@ const (
 	CodeInvalidLengthOfPayload        sdk.CodeType = 1011
 	CodeFeeOverflow                   sdk.CodeType = 1012
 	CodeInvalidPayload                sdk.CodeType = 1013
+	CodeParticipationNotFound         sdk.CodeType = 1014
+	CodeNoRelayerReward               sdk.CodeType = 1015
+	CodeInvalidProof                  sdk.CodeType = 1016
 )
 
 func ErrProphecyNotFound() sdk.Error {
@@ -85,3 +88,15 @@ func ErrFeeOverflow(msg string) sdk.Error {
 func ErrInvalidPayload(msg string) sdk.Error {
 	return sdk.NewError(DefaultCodespace, CodeInvalidPayload, msg)
 }
+
+func ErrParticipationNotFound(validator string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeParticipationNotFound, fmt.Sprintf("no participation found for validator %s", validator))
+}
+
+func ErrNoRelayerReward(validator string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNoRelayerReward, fmt.Sprintf("no relayer reward for validator %s", validator))
+}
+
+func ErrInvalidProof(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidProof, msg)
+}
@@ -1,6 +1,7 @@
 package types // noalias
 
 import (
+	"github.com/cosmos/cosmos-sdk/bsc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 )
@@ -12,3 +13,10 @@ type StakingKeeper interface {
 	GetLastTotalPower(ctx sdk.Context) (power int64)
 	GetBondedValidatorsByPower(ctx sdk.Context) []stake.Validator
 }
+
+// LightClient defines the expected light client of the side chains, which verifies the proof-backed claims
+type LightClient interface {
+	TrustedReceiptHash(ctx sdk.Context, sideChainId string, height uint64) (bsc.Hash, bool)
+	// LatestHeight returns the height of the latest header synced of the side chain
+	LatestHeight(ctx sdk.Context, sideChainId string) (uint64, bool)
+}
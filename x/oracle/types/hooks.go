@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleHooks event hooks for the oracle module
+type OracleHooks interface {
+	// OnValidatorAbsent is called when the claim participation of a bonded validator drops below the
+	// MinParticipationPerWindow, the implementation is responsible for slashing or jailing the validator.
+	OnValidatorAbsent(ctx sdk.Context, valAddr sdk.ValAddress, penalty AbsencePenalty)
+}
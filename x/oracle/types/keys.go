@@ -11,3 +11,23 @@ const (
 	ClaimCrash           = "ClaimCrash"
 	ClaimPackageType     = "ClaimPackageType"
 )
+
+const (
+	EventTypeProphecyExpired = "prophecyExpired"
+
+	ProphecyExpiredId           = "ProphecyId"
+	ProphecyExpiredCreateHeight = "ProphecyCreateHeight"
+)
+
+const (
+	EventTypeValidatorAbsent = "validatorAbsent"
+
+	AbsentValidator = "AbsentValidator"
+)
+
+const (
+	EventTypeWithdrawRelayerReward = "withdrawRelayerReward"
+
+	RelayerRewardValidator = "RelayerRewardValidator"
+	RelayerRewardAmount    = "RelayerRewardAmount"
+)
@@ -11,10 +11,17 @@ import (
 const (
 	RouteOracle = "oracle"
 
-	ClaimMsgType = "oracleClaim"
+	ClaimMsgType                 = "oracleClaim"
+	WithdrawRelayerRewardMsgType = "oracleWithdrawRelayerReward"
+	ProofClaimMsgType            = "oracleProofClaim"
+
+	// MaxProofNodes is the max number of the trie nodes in a ProofClaimMsg
+	MaxProofNodes = 4096
 )
 
 var _ sdk.Msg = ClaimMsg{}
+var _ sdk.Msg = WithdrawRelayerRewardMsg{}
+var _ sdk.Msg = ProofClaimMsg{}
 
 type Packages []Package
 
@@ -75,3 +82,107 @@ func (msg ClaimMsg) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// WithdrawRelayerRewardMsg withdraws the relayer reward credited to a validator to its operator account
+type WithdrawRelayerRewardMsg struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+}
+
+func NewWithdrawRelayerRewardMsg(validatorAddr sdk.ValAddress) WithdrawRelayerRewardMsg {
+	return WithdrawRelayerRewardMsg{
+		ValidatorAddr: validatorAddr,
+	}
+}
+
+// nolint
+func (msg WithdrawRelayerRewardMsg) Route() string { return RouteOracle }
+func (msg WithdrawRelayerRewardMsg) Type() string  { return WithdrawRelayerRewardMsgType }
+func (msg WithdrawRelayerRewardMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+func (msg WithdrawRelayerRewardMsg) String() string {
+	return fmt.Sprintf("WithdrawRelayerReward{%v}", msg.ValidatorAddr.String())
+}
+
+// GetSignBytes - Get the bytes for the message signer to sign on
+func (msg WithdrawRelayerRewardMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (msg WithdrawRelayerRewardMsg) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), RelayerRewardPoolAddr)
+}
+
+// ValidateBasic is used to quickly disqualify obviously invalid messages quickly
+func (msg WithdrawRelayerRewardMsg) ValidateBasic() sdk.Error {
+	if len(msg.ValidatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.ValidatorAddr.String())
+	}
+	return nil
+}
+
+// ProofClaimMsg claims the packages of an oracle sequence with the proof of all the receipts of the side chain block
+// emitting them, the block should be trusted by the light client. The packages are executed without the prophecy.
+type ProofClaimMsg struct {
+	ChainId          sdk.ChainID    `json:"chain_id"`
+	Sequence         uint64         `json:"sequence"`
+	Height           uint64         `json:"height"`
+	TxCount          uint64         `json:"tx_count"`
+	Proof            [][]byte       `json:"proof"`
+	ValidatorAddress sdk.AccAddress `json:"validator_address"`
+}
+
+func NewProofClaimMsg(chainId sdk.ChainID, sequence uint64, height uint64, txCount uint64, proof [][]byte, validatorAddr sdk.AccAddress) ProofClaimMsg {
+	return ProofClaimMsg{
+		ChainId:          chainId,
+		Sequence:         sequence,
+		Height:           height,
+		TxCount:          txCount,
+		Proof:            proof,
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// nolint
+func (msg ProofClaimMsg) Route() string { return RouteOracle }
+func (msg ProofClaimMsg) Type() string  { return ProofClaimMsgType }
+func (msg ProofClaimMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ValidatorAddress}
+}
+
+func (msg ProofClaimMsg) String() string {
+	return fmt.Sprintf("ProofClaim{%v#%v#%v#%v#%v}",
+		msg.ChainId, msg.Sequence, msg.Height, msg.TxCount, msg.ValidatorAddress.String())
+}
+
+// GetSignBytes - Get the bytes for the message signer to sign on
+func (msg ProofClaimMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (msg ProofClaimMsg) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+// ValidateBasic is used to quickly disqualify obviously invalid messages quickly
+func (msg ProofClaimMsg) ValidateBasic() sdk.Error {
+	if len(msg.ValidatorAddress) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.ValidatorAddress.String())
+	}
+	if msg.TxCount == 0 {
+		return ErrInvalidProof("the block of the packages has no transaction")
+	}
+	if len(msg.Proof) == 0 || len(msg.Proof) > MaxProofNodes {
+		return ErrInvalidProof(fmt.Sprintf("the number of proof nodes should be between 1 and %d", MaxProofNodes))
+	}
+	return nil
+}
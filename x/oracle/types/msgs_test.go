@@ -38,3 +38,27 @@ func TestClaimMsg(t *testing.T) {
 		}
 	}
 }
+
+func TestWithdrawRelayerRewardMsg(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	msg := NewWithdrawRelayerRewardMsg(sdk.ValAddress(addrs[0]))
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+
+	msg = NewWithdrawRelayerRewardMsg(sdk.ValAddress{1})
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestProofClaimMsg(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	msg := NewProofClaimMsg(1, 1, 100, 2, [][]byte{common.RandBytes(64)}, addrs[0])
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+
+	require.NotNil(t, NewProofClaimMsg(1, 1, 100, 0, msg.Proof, addrs[0]).ValidateBasic())
+	require.NotNil(t, NewProofClaimMsg(1, 1, 100, 2, nil, addrs[0]).ValidateBasic())
+	require.NotNil(t, NewProofClaimMsg(1, 1, 100, 2, make([][]byte, MaxProofNodes+1), addrs[0]).ValidateBasic())
+	require.NotNil(t, NewProofClaimMsg(1, 1, 100, 2, msg.Proof, sdk.AccAddress{1}).ValidateBasic())
+}
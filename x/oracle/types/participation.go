@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorParticipation tracks how many of the latest successful prophecies a validator did not claim.
+// The missed prophecies are recorded in a bit array indexed by IndexOffset % ParticipationWindow.
+type ValidatorParticipation struct {
+	ValidatorAddr       sdk.ValAddress `json:"validator_addr"`
+	StartHeight         int64          `json:"start_height"`          // height at which the validator was first tracked
+	IndexOffset         int64          `json:"index_offset"`          // number of successful prophecies the validator has been tracked for
+	MissedClaimsCounter int64          `json:"missed_claims_counter"` // missed prophecies counter in the current window
+}
+
+// NewValidatorParticipation returns a new ValidatorParticipation
+func NewValidatorParticipation(valAddr sdk.ValAddress, startHeight int64) ValidatorParticipation {
+	return ValidatorParticipation{
+		ValidatorAddr: valAddr,
+		StartHeight:   startHeight,
+	}
+}
+
+// Return human readable validator participation
+func (p ValidatorParticipation) String() string {
+	return fmt.Sprintf(`Validator: %s
+  Start Height:          %d
+  Index Offset:          %d
+  Missed Claims Counter: %d`,
+		p.ValidatorAddr, p.StartHeight, p.IndexOffset, p.MissedClaimsCounter)
+}
+
+// ParticipationGraceBlocks is the number of blocks after a prophecy succeeds during which the slower validators can
+// still claim it, the participation of the prophecy is only evaluated after the grace blocks.
+const ParticipationGraceBlocks int64 = 20
+
+// FinalizedProphecy is a successful prophecy whose participation is not evaluated yet
+type FinalizedProphecy struct {
+	ID             string   `json:"id"`
+	FinalClaim     string   `json:"final_claim"`
+	FinalizeHeight int64    `json:"finalize_height"`
+	Claimants      []string `json:"claimants"` // bech32 addresses of the validators which claimed the prophecy, sorted
+}
+
+// NewFinalizedProphecy returns the FinalizedProphecy of a successful prophecy
+func NewFinalizedProphecy(prophecy Prophecy, finalizeHeight int64) FinalizedProphecy {
+	claimants := make([]string, 0, len(prophecy.ValidatorClaims))
+	for validator := range prophecy.ValidatorClaims {
+		claimants = append(claimants, validator)
+	}
+	sort.Strings(claimants)
+	return FinalizedProphecy{
+		ID:             prophecy.ID,
+		FinalClaim:     prophecy.Status.FinalClaim,
+		FinalizeHeight: finalizeHeight,
+		Claimants:      claimants,
+	}
+}
+
+// HasClaimed returns whether the validator claimed the prophecy
+func (p FinalizedProphecy) HasClaimed(valAddr sdk.ValAddress) bool {
+	validator := valAddr.String()
+	i := sort.SearchStrings(p.Claimants, validator)
+	return i < len(p.Claimants) && p.Claimants[i] == validator
+}
+
+// AddClaimant records a late claim of the validator
+func (p *FinalizedProphecy) AddClaimant(valAddr sdk.ValAddress) {
+	p.Claimants = append(p.Claimants, valAddr.String())
+	sort.Strings(p.Claimants)
+}
+
+// IsGraceOver returns whether the late claims of the prophecy are no longer accepted at the height
+func (p FinalizedProphecy) IsGraceOver(height int64) bool {
+	return height > p.FinalizeHeight+ParticipationGraceBlocks
+}
+
+// AbsencePenalty is the penalty applied to a validator whose claim participation dropped below
+// the MinParticipationPerWindow in the latest ParticipationWindow successful prophecies.
+type AbsencePenalty struct {
+	SlashFraction sdk.Dec       `json:"slash_fraction"` // 0 means no slash
+	JailDuration  time.Duration `json:"jail_duration"`  // 0 means no jail
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// CrossChainContractAddr is the address of the CrossChain system contract on BSC emitting the cross chain packages
+	CrossChainContractAddr = bsc.Address{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x00}
+
+	// CrossChainPackageEventTopic is the topic of the event of the CrossChain contract:
+	// crossChainPackage(uint16 chainId, uint64 indexed oracleSequence, uint64 indexed packageSequence, uint8 indexed channelId, bytes payload)
+	CrossChainPackageEventTopic = bsc.BytesToHash(bsc.Keccak256([]byte("crossChainPackage(uint16,uint64,uint64,uint8,bytes)")))
+)
+
+const abiWordLength = 32
+
+// PackagesFromReceipts collects the packages of the oracle sequence to the chain from the crossChainPackage events in
+// the receipts of a block, in the order they are emitted
+func PackagesFromReceipts(receipts []*bsc.Receipt, chainId sdk.ChainID, oracleSequence uint64) (Packages, error) {
+	packages := Packages{}
+	for _, receipt := range receipts {
+		if !receipt.Succeeded() {
+			continue
+		}
+		for _, log := range receipt.Logs {
+			if log.Address != CrossChainContractAddr || len(log.Topics) != 4 || log.Topics[0] != CrossChainPackageEventTopic {
+				continue
+			}
+			sequence, ok := abiUint(log.Topics[1][:], 64)
+			if !ok {
+				return nil, errors.New("invalid oracle sequence of the crossChainPackage event")
+			}
+			if sequence != oracleSequence {
+				continue
+			}
+			packageSequence, ok := abiUint(log.Topics[2][:], 64)
+			if !ok {
+				return nil, errors.New("invalid package sequence of the crossChainPackage event")
+			}
+			channelId, ok := abiUint(log.Topics[3][:], 8)
+			if !ok {
+				return nil, errors.New("invalid channel id of the crossChainPackage event")
+			}
+			destChainId, payload, err := decodeCrossChainPackageData(log.Data)
+			if err != nil {
+				return nil, err
+			}
+			if destChainId != uint64(chainId) {
+				continue
+			}
+			packages = append(packages, Package{
+				ChannelId: sdk.ChannelID(channelId),
+				Sequence:  packageSequence,
+				Payload:   payload,
+			})
+		}
+	}
+	return packages, nil
+}
+
+// decodeCrossChainPackageData decodes the abi encoding of the non-indexed (uint16 chainId, bytes payload) of the event
+func decodeCrossChainPackageData(data []byte) (uint64, []byte, error) {
+	if len(data) < 3*abiWordLength {
+		return 0, nil, errors.New("the data of the crossChainPackage event is too short")
+	}
+	chainId, ok := abiUint(data[:abiWordLength], 16)
+	if !ok {
+		return 0, nil, errors.New("invalid chain id of the crossChainPackage event")
+	}
+	offset, ok := abiUint(data[abiWordLength:2*abiWordLength], 32)
+	if !ok || offset+abiWordLength > uint64(len(data)) {
+		return 0, nil, errors.New("invalid payload offset of the crossChainPackage event")
+	}
+	length, ok := abiUint(data[offset:offset+abiWordLength], 32)
+	if !ok || offset+abiWordLength+length > uint64(len(data)) {
+		return 0, nil, errors.New("invalid payload length of the crossChainPackage event")
+	}
+	start := offset + abiWordLength
+	return chainId, data[start : start+length], nil
+}
+
+// abiUint decodes the abi word of an unsigned integer of the bit size
+func abiUint(word []byte, bitSize int) (uint64, bool) {
+	value := new(big.Int).SetBytes(word)
+	if value.BitLen() > bitSize {
+		return 0, false
+	}
+	return value.Uint64(), true
+}
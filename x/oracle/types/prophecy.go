@@ -3,26 +3,102 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
+const (
+	// DefaultProphecyExpireBlocks defines the default number of blocks after which a prophecy
+	// that is still pending will be removed, it is about one day with 1 second block interval.
+	DefaultProphecyExpireBlocks int64 = 86400
+
+	MinProphecyExpireBlocks int64 = 100
+	MaxProphecyExpireBlocks int64 = 10 * DefaultProphecyExpireBlocks
+
+	// DefaultParticipationWindow defines the default number of successful prophecies over which
+	// the claim participation of validators is tracked.
+	DefaultParticipationWindow int64 = 1000
+
+	MinParticipationWindow int64 = 100
+	MaxParticipationWindow int64 = 100 * DefaultParticipationWindow
+
+	MaxAbsenceJailDuration = 60 * 60 * 24 * 14 * time.Second
+
+	// DefaultProofConfirmations defines the default number of blocks the block of a proof-backed claim
+	// should be below the latest header synced by the light client.
+	DefaultProofConfirmations int64 = 15
+
+	MinProofConfirmations int64 = 1
+	MaxProofConfirmations int64 = 1000
+)
+
 var (
 	// DefaultConsensusNeeded defines the default consensus value required for a
 	// prophecy to be finalized
 	DefaultConsensusNeeded      sdk.Dec = sdk.NewDecWithPrec(7, 1)
 	ParamStoreKeyProphecyParams         = []byte("prophecyParams")
+	ParamStoreKeyProphecyExpire         = []byte("prophecyExpireBlocks")
+
+	DefaultMinParticipationPerWindow = sdk.NewDecWithPrec(5, 1)
+	// DefaultAbsenceSlashFraction is zero, absent validators are only jailed by default
+	DefaultAbsenceSlashFraction = sdk.ZeroDec()
+	DefaultAbsenceJailDuration  = 60 * 60 * 24 * time.Second
+
+	ParamStoreKeyParticipationWindow       = []byte("participationWindow")
+	ParamStoreKeyMinParticipationPerWindow = []byte("minParticipationPerWindow")
+	ParamStoreKeyAbsenceSlashFraction      = []byte("absenceSlashFraction")
+	ParamStoreKeyAbsenceJailDuration       = []byte("absenceJailDuration")
+
+	ParamStoreKeyRelayerRewardDistribution = []byte("relayerRewardDistribution")
+
+	ParamStoreKeyProofConfirmations = []byte("proofConfirmations")
 )
 
 type Params struct {
-	ConsensusNeeded sdk.Dec `json:"ConsensusNeeded"` //  Minimum deposit for a proposal to enter voting period.
+	ConsensusNeeded      sdk.Dec `json:"ConsensusNeeded"`        //  Minimum deposit for a proposal to enter voting period.
+	ProphecyExpireBlocks int64   `json:"prophecy_expire_blocks"` // Number of blocks a pending prophecy is kept before it expires.
+
+	ParticipationWindow       int64         `json:"participation_window"`         // Number of successful prophecies over which the participation of validators is tracked.
+	MinParticipationPerWindow sdk.Dec       `json:"min_participation_per_window"` // Minimum ratio of prophecies in the window a validator should claim.
+	AbsenceSlashFraction      sdk.Dec       `json:"absence_slash_fraction"`       // Fraction of the bonded tokens slashed from an absent validator, 0 means no slash.
+	AbsenceJailDuration       time.Duration `json:"absence_jail_duration"`        // Duration an absent validator is jailed for, 0 means no jail.
+
+	RelayerRewardDistribution string `json:"relayer_reward_distribution"` // How the relayer fee is shared among the validators claimed a successful prophecy, "power" or "even".
+
+	ProofConfirmations int64 `json:"proof_confirmations"` // Number of blocks the block of a proof-backed claim should be below the latest header synced by the light client.
 }
 
 func (p *Params) UpdateCheck() error {
 	if p.ConsensusNeeded.IsNil() || p.ConsensusNeeded.GT(sdk.OneDec()) || p.ConsensusNeeded.LT(sdk.NewDecWithPrec(5, 1)) {
 		return fmt.Errorf("the value should be in range 0.5 to 1")
 	}
+	if sdk.IsUpgrade(sdk.ProphecyExpiry) &&
+		(p.ProphecyExpireBlocks < MinProphecyExpireBlocks || p.ProphecyExpireBlocks > MaxProphecyExpireBlocks) {
+		return fmt.Errorf("the prophecy_expire_blocks should be in range %d to %d", MinProphecyExpireBlocks, MaxProphecyExpireBlocks)
+	}
+	if sdk.IsUpgrade(sdk.OracleLiveness) {
+		if p.ParticipationWindow < MinParticipationWindow || p.ParticipationWindow > MaxParticipationWindow {
+			return fmt.Errorf("the participation_window should be in range %d to %d", MinParticipationWindow, MaxParticipationWindow)
+		}
+		if p.MinParticipationPerWindow.IsNil() || p.MinParticipationPerWindow.LT(sdk.ZeroDec()) || p.MinParticipationPerWindow.GT(sdk.OneDec()) {
+			return fmt.Errorf("the min_participation_per_window should be in range 0 to 1")
+		}
+		if p.AbsenceSlashFraction.IsNil() || p.AbsenceSlashFraction.LT(sdk.ZeroDec()) || p.AbsenceSlashFraction.GT(sdk.NewDecWithPrec(1, 2)) {
+			return fmt.Errorf("the absence_slash_fraction should be in range 0 to 0.01")
+		}
+		if p.AbsenceJailDuration < 0 || p.AbsenceJailDuration > MaxAbsenceJailDuration {
+			return fmt.Errorf("the absence_jail_duration should be in range 0 to %s", MaxAbsenceJailDuration)
+		}
+	}
+	if sdk.IsUpgrade(sdk.RelayerReward) && !IsValidRelayerRewardDistribution(p.RelayerRewardDistribution) {
+		return fmt.Errorf("the relayer_reward_distribution should be %q or %q", RelayerRewardDistributionByPower, RelayerRewardDistributionEvenly)
+	}
+	if sdk.IsUpgrade(sdk.OracleProofClaim) &&
+		(p.ProofConfirmations < MinProofConfirmations || p.ProofConfirmations > MaxProofConfirmations) {
+		return fmt.Errorf("the proof_confirmations should be in range %d to %d", MinProofConfirmations, MaxProofConfirmations)
+	}
 	return nil
 }
 
@@ -33,6 +109,13 @@ func (p *Params) GetParamAttribute() (string, bool) {
 func (p *Params) KeyValuePairs() params.KeyValuePairs {
 	return params.KeyValuePairs{
 		{ParamStoreKeyProphecyParams, &p.ConsensusNeeded},
+		{ParamStoreKeyProphecyExpire, &p.ProphecyExpireBlocks},
+		{ParamStoreKeyParticipationWindow, &p.ParticipationWindow},
+		{ParamStoreKeyMinParticipationPerWindow, &p.MinParticipationPerWindow},
+		{ParamStoreKeyAbsenceSlashFraction, &p.AbsenceSlashFraction},
+		{ParamStoreKeyAbsenceJailDuration, &p.AbsenceJailDuration},
+		{ParamStoreKeyRelayerRewardDistribution, &p.RelayerRewardDistribution},
+		{ParamStoreKeyProofConfirmations, &p.ProofConfirmations},
 	}
 }
 
@@ -45,6 +128,9 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 type Prophecy struct {
 	ID     string `json:"id"`
 	Status Status `json:"status"`
+	// CreateHeight is the height of the block in which the first claim of the prophecy was made,
+	// it is only recorded after the ProphecyExpiry upgrade.
+	CreateHeight int64 `json:"create_height"`
 
 	//WARNING: Mappings are nondeterministic in Amino,
 	// an so iterating over them could result in consensus failure. New code should not iterate over the below 2 mappings.
@@ -61,6 +147,7 @@ type DBProphecy struct {
 	ID              string `json:"id"`
 	Status          Status `json:"status"`
 	ValidatorClaims []byte `json:"validator_claims"`
+	CreateHeight    int64  `json:"create_height"`
 }
 
 // SerializeForDB serializes a prophecy into a DBProphecy
@@ -74,6 +161,7 @@ func (prophecy Prophecy) SerializeForDB() (DBProphecy, error) {
 		ID:              prophecy.ID,
 		Status:          prophecy.Status,
 		ValidatorClaims: validatorClaims,
+		CreateHeight:    prophecy.CreateHeight,
 	}, nil
 }
 
@@ -96,6 +184,7 @@ func (dbProphecy DBProphecy) DeserializeFromDB() (Prophecy, error) {
 	return Prophecy{
 		ID:              dbProphecy.ID,
 		Status:          dbProphecy.Status,
+		CreateHeight:    dbProphecy.CreateHeight,
 		ClaimValidators: claimValidators,
 		ValidatorClaims: validatorClaims,
 	}, nil
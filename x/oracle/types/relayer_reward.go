@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// the relayer fee is shared in proportion to the voting power of the validators
+	RelayerRewardDistributionByPower = "power"
+	// the relayer fee is shared evenly among the validators
+	RelayerRewardDistributionEvenly = "even"
+
+	DefaultRelayerRewardDistribution = RelayerRewardDistributionByPower
+)
+
+var (
+	// RelayerRewardPoolAddr holds the relayer fees which have not been withdrawn by the validators yet
+	RelayerRewardPoolAddr = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainRelayerRewardPool")))
+)
+
+func IsValidRelayerRewardDistribution(distribution string) bool {
+	return distribution == RelayerRewardDistributionByPower || distribution == RelayerRewardDistributionEvenly
+}
+
+// RelayerReward is the relayer fee credited to a validator for taking part in successful prophecies,
+// the amount is in native token.
+type RelayerReward struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Amount        int64          `json:"amount"`
+}
+
+// Return human readable relayer reward
+func (r RelayerReward) String() string {
+	return fmt.Sprintf(`Relayer Reward:
+  Validator: %s
+  Amount:    %d`, r.ValidatorAddr, r.Amount)
+}
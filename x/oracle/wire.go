@@ -12,5 +12,7 @@ func RegisterWire(cdc *codec.Codec) {
 	cdc.RegisterConcrete(Status{}, "oracle/Status", nil)
 	cdc.RegisterConcrete(DBProphecy{}, "oracle/DBProphecy", nil)
 	cdc.RegisterConcrete(ClaimMsg{}, "oracle/ClaimMsg", nil)
+	cdc.RegisterConcrete(WithdrawRelayerRewardMsg{}, "oracle/WithdrawRelayerRewardMsg", nil)
+	cdc.RegisterConcrete(ProofClaimMsg{}, "oracle/ProofClaimMsg", nil)
 	cdc.RegisterConcrete(&types.Params{}, "params/OracleParamSet", nil)
 }
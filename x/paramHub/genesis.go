@@ -30,13 +30,18 @@ const (
 	RefundHTLTFee  = 37500
 
 	// stake fee
-	CreateValidatorFee          = 10e8
-	RemoveValidatorFee          = 1e8
-	CreateSideChainValidatorFee = 10e8
-	EditSideChainValidatorFee   = 1e8
-	SideChainDelegateFee        = 1e5
-	SideChainRedelegateFee      = 3e5
-	SideChainUndelegateFee      = 2e5
+	CreateValidatorFee           = 10e8
+	RemoveValidatorFee           = 1e8
+	CreateSideChainValidatorFee  = 10e8
+	EditSideChainValidatorFee    = 1e8
+	SideChainDelegateFee         = 1e5
+	SideChainRedelegateFee       = 3e5
+	SideChainUndelegateFee       = 2e5
+	SideChainCancelUnbondingFee  = 1e5
+	CancelUnbondingFee           = 1e5
+	SideChainSetAutoCompoundFee  = 1e5
+	SideChainLiquidDelegateFee   = 1e5
+	SideChainLiquidUndelegateFee = 2e5
 
 	// slashing fee
 	BscSubmitEvidenceFee = 10e8
@@ -71,6 +76,9 @@ const (
 	MiniIssueFee   = 3e8
 	MiniSetUriFee  = 37500
 	MiniListingFee = 8e8
+
+	// crisis fee, asserting an invariant may iterate over all the accounts
+	VerifyInvariantFee = 10e8
 )
 
 var DefaultGenesisState = param.GenesisState{
@@ -76,6 +76,32 @@ func RegisterUpgradeBeginBlocker(paramHub *ParamHub) {
 		}
 		paramHub.UpdateFeeParams(ctx, updateFeeParams)
 	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.SideChainAutoCompound, func(ctx sdk.Context) {
+		updateFeeParams := []param.FeeParam{
+			&param.FixedFeeParams{MsgType: "side_set_auto_compound", Fee: SideChainSetAutoCompoundFee, FeeFor: sdk.FeeForProposer},
+		}
+		paramHub.UpdateFeeParams(ctx, updateFeeParams)
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.LiquidStaking, func(ctx sdk.Context) {
+		updateFeeParams := []param.FeeParam{
+			&param.FixedFeeParams{MsgType: "side_liquid_delegate", Fee: SideChainLiquidDelegateFee, FeeFor: sdk.FeeForProposer},
+			&param.FixedFeeParams{MsgType: "side_liquid_undelegate", Fee: SideChainLiquidUndelegateFee, FeeFor: sdk.FeeForProposer},
+		}
+		paramHub.UpdateFeeParams(ctx, updateFeeParams)
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.CancelUnbonding, func(ctx sdk.Context) {
+		updateFeeParams := []param.FeeParam{
+			&param.FixedFeeParams{MsgType: "side_cancel_unbonding", Fee: SideChainCancelUnbondingFee, FeeFor: sdk.FeeForProposer},
+			&param.FixedFeeParams{MsgType: "cancel_unbonding", Fee: CancelUnbondingFee, FeeFor: sdk.FeeForProposer},
+		}
+		paramHub.UpdateFeeParams(ctx, updateFeeParams)
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.Crisis, func(ctx sdk.Context) {
+		updateFeeParams := []param.FeeParam{
+			&param.FixedFeeParams{MsgType: "verify_invariant", Fee: VerifyInvariantFee, FeeFor: sdk.FeeForProposer},
+		}
+		paramHub.UpdateFeeParams(ctx, updateFeeParams)
+	})
 }
 
 func EndBreatheBlock(ctx sdk.Context, paramHub *ParamHub) {
@@ -105,6 +131,11 @@ func init() {
 		"side_delegate":            fees.FixedFeeCalculatorGen,
 		"side_redelegate":          fees.FixedFeeCalculatorGen,
 		"side_undelegate":          fees.FixedFeeCalculatorGen,
+		"side_cancel_unbonding":    fees.FixedFeeCalculatorGen,
+		"cancel_unbonding":         fees.FixedFeeCalculatorGen,
+		"side_set_auto_compound":   fees.FixedFeeCalculatorGen,
+		"side_liquid_delegate":     fees.FixedFeeCalculatorGen,
+		"side_liquid_undelegate":   fees.FixedFeeCalculatorGen,
 		"bsc_submit_evidence":      fees.FixedFeeCalculatorGen,
 		"side_chain_unjail":        fees.FixedFeeCalculatorGen,
 		"dexList":                  fees.FixedFeeCalculatorGen,
@@ -135,5 +166,6 @@ func init() {
 		"dexListMini":              fees.FixedFeeCalculatorGen,
 		"tinyIssueMsg":             fees.FixedFeeCalculatorGen,
 		"miniIssueMsg":             fees.FixedFeeCalculatorGen,
+		"verify_invariant":         fees.FixedFeeCalculatorGen,
 	}
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/hex"
+
 	"github.com/cosmos/cosmos-sdk/bsc/rlp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/paramHub/types"
@@ -21,3 +23,15 @@ func (keeper *Keeper) SaveParamChangeToIbc(ctx sdk.Context, sideChainId string,
 	}
 	return keeper.ibcKeeper.CreateIBCSyncPackage(ctx, sideChainId, ChannelName, bz)
 }
+
+// decodeParamChangePayload decodes the payload of a param change package, the value and target
+// are shown in hex as the ones in the proposal description.
+func decodeParamChangePayload(payload []byte) (interface{}, error) {
+	var paramChange types.CSCParamChange
+	if err := rlp.DecodeBytes(payload, &paramChange); err != nil {
+		return nil, err
+	}
+	paramChange.Value = hex.EncodeToString(paramChange.ValueBytes)
+	paramChange.Target = hex.EncodeToString(paramChange.TargetBytes)
+	return &paramChange, nil
+}
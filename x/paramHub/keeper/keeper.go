@@ -97,6 +97,13 @@ func (keeper Keeper) initIbc() {
 	if err != nil {
 		panic(fmt.Sprintf("register ibc channel failed, channel=%s, err=%s", ChannelName, err.Error()))
 	}
+	err = keeper.ScKeeper.RegisterPayloadSchema(ChannelId, sTypes.PayloadSchema{
+		Syn: decodeParamChangePayload,
+		Ack: sTypes.RLPPayloadDecoder(sTypes.CommonAckPackage{}),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("register payload schema failed, channel=%s, err=%s", ChannelName, err.Error()))
+	}
 }
 
 func (keeper *Keeper) EndBreatheBlock(ctx sdk.Context) {
@@ -57,6 +57,8 @@ var (
 		"side_delegate":         {},
 		"side_redelegate":       {},
 		"side_undelegate":       {},
+		"side_cancel_unbonding": {},
+		"cancel_unbonding":      {},
 
 		"bsc_submit_evidence": {},
 		"side_chain_unjail":   {},
@@ -68,6 +70,8 @@ var (
 		"miniIssueMsg":         {},
 		"miniTokensSetURI":     {},
 		"dexListMini":          {},
+
+		"verify_invariant": {},
 	}
 
 	ValidTransferFeeMsgTypes = map[string]struct{}{
@@ -22,9 +22,13 @@ func AddCommands(cmd *cobra.Command, cdc *amino.Codec) {
 	}
 	dexCmd.AddCommand(
 		client.PostCommands(
-			SubmitChannelManageProposalCmd(cdc))...)
+			SubmitChannelManageProposalCmd(cdc),
+			SubmitChannelRegistryProposalCmd(cdc),
+			SubmitDestChainRegistryProposalCmd(cdc),
+			SubmitChanRateLimitProposalCmd(cdc))...)
 	dexCmd.AddCommand(
 		client.GetCommands(
-			ShowChannelPermissionCmd(cdc))...)
+			ShowChannelPermissionCmd(cdc),
+			ShowCrossChainRegistryCmd(cdc))...)
 	cmd.AddCommand(dexCmd)
 }
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+const (
+	flagMaxPackagesPerBlock = "max-packages-per-block"
+	flagMaxValuePerWindow   = "max-value-per-window"
+	flagValueWindow         = "value-window"
+)
+
+func SubmitChanRateLimitProposalCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-channel-rate-limit-proposal",
+		Short: "Submit a proposal to set the rate limit of a cross chain channel, zero limits remove the rate limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sideChainId := viper.GetString(flagSideChainId)
+			if sideChainId == "" {
+				return fmt.Errorf("missing side-chain-id")
+			}
+			setting := types.ChanRateLimitSetting{
+				SideChainId: sideChainId,
+				ChannelId:   sdk.ChannelID(viper.GetUint(flagChannelId)),
+				RateLimit: types.ChanRateLimit{
+					MaxPackagesPerBlock: viper.GetInt64(flagMaxPackagesPerBlock),
+					MaxValuePerWindow:   viper.GetInt64(flagMaxValuePerWindow),
+					ValueWindow:         viper.GetInt64(flagValueWindow),
+				},
+			}
+			if err := setting.Check(); err != nil {
+				return err
+			}
+			return submitProposal(cdc, gov.ProposalTypeManageChanRateLimit, setting)
+		},
+	}
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain")
+	cmd.Flags().Uint8(flagChannelId, 0, "the id of the channel")
+	cmd.Flags().Int64(flagMaxPackagesPerBlock, 0, "max syn packages of the channel in each direction per block, 0 means no limit")
+	cmd.Flags().Int64(flagMaxValuePerWindow, 0, "max value of the syn packages of the channel in each direction per value window, 0 means no limit")
+	cmd.Flags().Int64(flagValueWindow, 0, "number of blocks of a value window")
+	addProposalFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+const (
+	flagAction        = "action"
+	flagChannelName   = "channel-name"
+	flagDestChainId   = "dest-chain-id"
+	flagDestChainName = "dest-chain-name"
+)
+
+func SubmitChannelRegistryProposalCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-channel-registry-proposal",
+		Short: "Submit a proposal to add, pause, resume or retire a cross chain channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			setting := types.ChannelRegistrySetting{
+				Action:      types.RegistryAction(viper.GetString(flagAction)),
+				ChannelName: viper.GetString(flagChannelName),
+				ChannelId:   sdk.ChannelID(viper.GetUint(flagChannelId)),
+			}
+			if err := setting.Check(); err != nil {
+				return err
+			}
+			return submitProposal(cdc, gov.ProposalTypeManageChannel, setting)
+		},
+	}
+	cmd.Flags().String(flagAction, "", "the action on the channel, one of add, pause, resume and retire")
+	cmd.Flags().String(flagChannelName, "", "the name of the channel, only needed to add a channel")
+	cmd.Flags().Uint8(flagChannelId, 0, "the id of the channel")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func SubmitDestChainRegistryProposalCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dest-chain-registry-proposal",
+		Short: "Submit a proposal to add, pause, resume or retire a cross chain destination chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			setting := types.DestChainRegistrySetting{
+				Action:        types.RegistryAction(viper.GetString(flagAction)),
+				DestChainName: viper.GetString(flagDestChainName),
+				ChainId:       sdk.ChainID(viper.GetUint(flagDestChainId)),
+			}
+			if err := setting.Check(); err != nil {
+				return err
+			}
+			return submitProposal(cdc, gov.ProposalTypeManageDestChain, setting)
+		},
+	}
+	cmd.Flags().String(flagAction, "", "the action on the destination chain, one of add, pause, resume and retire")
+	cmd.Flags().String(flagDestChainName, "", "the name of the destination chain, only needed to add a destination chain")
+	cmd.Flags().Uint16(flagDestChainId, 0, "the id of the destination chain")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func ShowCrossChainRegistryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-registry",
+		Short: "Show the registered cross chain channels and destination chains",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cliCtx.Query("custom/sideChain/crossChainRegistry", nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTitle, "", "title of proposal")
+	cmd.Flags().Int64(flagVotingPeriod, 7*24*60*60, "voting period in seconds")
+	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+}
+
+func submitProposal(cdc *codec.Codec, proposalType gov.ProposalKind, setting interface{}) error {
+	txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+	title := viper.GetString(flagTitle)
+	initialDeposit := viper.GetString(flagDeposit)
+	votingPeriodInSeconds := viper.GetInt64(flagVotingPeriod)
+
+	fromAddr, err := cliCtx.GetFromAddress()
+	if err != nil {
+		return err
+	}
+	amount, err := sdk.ParseCoins(initialDeposit)
+	if err != nil {
+		return err
+	}
+	settingBz, err := cdc.MarshalJSON(setting)
+	if err != nil {
+		return err
+	}
+
+	if votingPeriodInSeconds <= 0 {
+		return errors.New("voting period should be positive")
+	}
+
+	votingPeriod := time.Duration(votingPeriodInSeconds) * time.Second
+	if votingPeriod > gov.MaxVotingPeriod {
+		return fmt.Errorf("voting period should less than %d seconds", gov.MaxVotingPeriod/time.Second)
+	}
+
+	msg := gov.NewMsgSubmitProposal(title, string(settingBz), proposalType, fromAddr, amount, votingPeriod)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if cliCtx.GenerateOnly {
+		return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+	}
+	return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+}
@@ -1,6 +1,11 @@
 package sidechain
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
 
 type crossChainConfig struct {
 	srcChainID sdk.ChainID
@@ -9,6 +14,8 @@ type crossChainConfig struct {
 	channelIDToName map[sdk.ChannelID]string
 	channelIDToApp  map[sdk.ChannelID]sdk.CrossChainApplication
 
+	channelIDToSchema map[sdk.ChannelID]types.PayloadSchema
+
 	destChainNameToID map[string]sdk.ChainID
 	destChainIDToName map[sdk.ChainID]string
 }
@@ -21,6 +28,25 @@ func newCrossChainCfg() *crossChainConfig {
 		destChainNameToID: make(map[string]sdk.ChainID),
 		destChainIDToName: make(map[sdk.ChainID]string),
 		channelIDToApp:    make(map[sdk.ChannelID]sdk.CrossChainApplication),
+		channelIDToSchema: make(map[sdk.ChannelID]types.PayloadSchema),
 	}
 	return config
 }
+
+func (cfg *crossChainConfig) sortedChannelIDs() []sdk.ChannelID {
+	ids := make([]sdk.ChannelID, 0, len(cfg.channelIDToName))
+	for id := range cfg.channelIDToName {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (cfg *crossChainConfig) sortedDestChainIDs() []sdk.ChainID {
+	ids := make([]sdk.ChainID, 0, len(cfg.destChainIDToName))
+	for id := range cfg.destChainIDToName {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -17,7 +17,7 @@ func TestInitCrossChainID(t *testing.T) {
 }
 
 func TestRegisterCrossChainChannel(t *testing.T) {
-	_, keeper := CreateTestInput(t, true)
+	ctx, keeper := CreateTestInput(t, true)
 	require.NoError(t, keeper.RegisterChannel("bind", sdk.ChannelID(1), nil))
 	require.NoError(t, keeper.RegisterChannel("transfer", sdk.ChannelID(2), nil))
 	require.NoError(t, keeper.RegisterChannel("timeout", sdk.ChannelID(3), nil))
@@ -25,17 +25,17 @@ func TestRegisterCrossChainChannel(t *testing.T) {
 	require.Error(t, keeper.RegisterChannel("staking", sdk.ChannelID(5), nil))
 	require.Error(t, keeper.RegisterChannel("staking-new", sdk.ChannelID(4), nil))
 
-	channeID, err := keeper.GetChannelID("transfer")
+	channeID, err := keeper.GetChannelID(ctx, "transfer")
 	require.NoError(t, err)
 	require.Equal(t, sdk.ChannelID(2), channeID)
 
-	channeID, err = keeper.GetChannelID("staking")
+	channeID, err = keeper.GetChannelID(ctx, "staking")
 	require.NoError(t, err)
 	require.Equal(t, sdk.ChannelID(4), channeID)
 }
 
 func TestRegisterDestChainID(t *testing.T) {
-	_, keeper := CreateTestInput(t, true)
+	ctx, keeper := CreateTestInput(t, true)
 	require.NoError(t, keeper.RegisterDestChain("bsc", sdk.ChainID(1)))
 	require.NoError(t, keeper.RegisterDestChain("ethereum", sdk.ChainID(2)))
 	require.NoError(t, keeper.RegisterDestChain("btc", sdk.ChainID(3)))
@@ -44,11 +44,11 @@ func TestRegisterDestChainID(t *testing.T) {
 	require.Error(t, keeper.RegisterDestChain("mock", sdk.ChainID(4)))
 	require.Error(t, keeper.RegisterDestChain("cosmos::", sdk.ChainID(5)))
 
-	destChainID, err := keeper.GetDestChainID("bsc")
+	destChainID, err := keeper.GetDestChainID(ctx, "bsc")
 	require.NoError(t, err)
 	require.Equal(t, sdk.ChainID(1), destChainID)
 
-	destChainID, err = keeper.GetDestChainID("btc")
+	destChainID, err = keeper.GetDestChainID(ctx, "btc")
 	require.NoError(t, err)
 	require.Equal(t, sdk.ChainID(3), destChainID)
 }
@@ -7,9 +7,19 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = 31
 
-	CodeInvalidSideChainId sdk.CodeType = 101
+	CodeInvalidSideChainId       sdk.CodeType = 101
+	CodeChannelRateLimitExceeded sdk.CodeType = 102
+	CodeInvalidPackageValue      sdk.CodeType = 103
 )
 
 func ErrInvalidSideChainId(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidSideChainId, msg)
 }
+
+func ErrChannelRateLimitExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeChannelRateLimitExceeded, msg)
+}
+
+func ErrInvalidPackageValue(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPackageValue, msg)
+}
@@ -35,11 +35,76 @@ func (hooks ChanPermissionSettingHooks) OnProposalSubmitted(ctx sdk.Context, pro
 	if err := changeParam.Check(); err != nil {
 		return err
 	}
-	if _, ok := hooks.k.cfg.destChainNameToID[changeParam.SideChainId]; !ok {
+	if _, err := hooks.k.GetDestChainID(ctx, changeParam.SideChainId); err != nil {
 		return fmt.Errorf("the SideChainId do not exist")
 	}
-	if _, ok := hooks.k.cfg.channelIDToName[changeParam.ChannelId]; !ok {
+	if _, err := hooks.k.GetChannelName(ctx, changeParam.ChannelId); err != nil {
 		return fmt.Errorf("the ChannelId do not exist")
 	}
 	return nil
 }
+
+//---------------------    CrossChainRegistryHooks  -----------------
+type CrossChainRegistryHooks struct {
+	cdc *amino.Codec
+	k   *Keeper
+}
+
+func NewCrossChainRegistryHook(cdc *amino.Codec, keeper *Keeper) CrossChainRegistryHooks {
+	return CrossChainRegistryHooks{cdc, keeper}
+}
+
+var _ gov.GovHooks = CrossChainRegistryHooks{}
+
+func (hooks CrossChainRegistryHooks) OnProposalSubmitted(ctx sdk.Context, proposal gov.Proposal) error {
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		return fmt.Errorf("%s proposal is not supported before the %s upgrade", proposal.GetProposalType(), sdk.CrossChainRegistry)
+	}
+
+	switch proposal.GetProposalType() {
+	case gov.ProposalTypeManageChannel:
+		var setting types.ChannelRegistrySetting
+		err := hooks.cdc.UnmarshalJSON([]byte(proposal.GetDescription()), &setting)
+		if err != nil {
+			return fmt.Errorf("get broken data when unmarshal ChannelRegistrySetting msg, err %v", err)
+		}
+		return hooks.k.ValidateChannelRegistrySetting(ctx, setting)
+	case gov.ProposalTypeManageDestChain:
+		var setting types.DestChainRegistrySetting
+		err := hooks.cdc.UnmarshalJSON([]byte(proposal.GetDescription()), &setting)
+		if err != nil {
+			return fmt.Errorf("get broken data when unmarshal DestChainRegistrySetting msg, err %v", err)
+		}
+		return hooks.k.ValidateDestChainRegistrySetting(ctx, setting)
+	default:
+		panic(fmt.Sprintf("received wrong type of proposal %x", proposal.GetProposalType()))
+	}
+}
+
+//---------------------    ChanRateLimitSettingHooks  -----------------
+type ChanRateLimitSettingHooks struct {
+	cdc *amino.Codec
+	k   *Keeper
+}
+
+func NewChanRateLimitSettingHook(cdc *amino.Codec, keeper *Keeper) ChanRateLimitSettingHooks {
+	return ChanRateLimitSettingHooks{cdc, keeper}
+}
+
+var _ gov.GovHooks = ChanRateLimitSettingHooks{}
+
+func (hooks ChanRateLimitSettingHooks) OnProposalSubmitted(ctx sdk.Context, proposal gov.Proposal) error {
+	if proposal.GetProposalType() != gov.ProposalTypeManageChanRateLimit {
+		panic(fmt.Sprintf("received wrong type of proposal %x", proposal.GetProposalType()))
+	}
+	if !sdk.IsUpgrade(sdk.ChannelRateLimit) {
+		return fmt.Errorf("%s proposal is not supported before the %s upgrade", proposal.GetProposalType(), sdk.ChannelRateLimit)
+	}
+
+	var setting types.ChanRateLimitSetting
+	err := hooks.cdc.UnmarshalJSON([]byte(proposal.GetDescription()), &setting)
+	if err != nil {
+		return fmt.Errorf("get broken data when unmarshal ChanRateLimitSetting msg, err %v", err)
+	}
+	return hooks.k.ValidateChanRateLimitSetting(ctx, setting)
+}
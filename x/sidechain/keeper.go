@@ -21,6 +21,8 @@ type Keeper struct {
 	cfg        *crossChainConfig
 	cdc        *codec.Codec
 
+	tripCollector *channelTripCollector
+
 	govKeeper *gov.Keeper
 	ibcKeeper IbcKeeper
 }
@@ -36,6 +38,8 @@ func NewKeeper(storeKey sdk.StoreKey, paramspace params.Subspace, cdc *codec.Cod
 		paramspace: paramspace.WithTypeTable(ParamTypeTable()),
 		cfg:        newCrossChainCfg(),
 		cdc:        cdc,
+
+		tripCollector: newChannelTripCollector(),
 	}
 }
 
@@ -148,14 +152,56 @@ func (k *Keeper) GetChannelSendPermissions(ctx sdk.Context, destChainID sdk.Chai
 	return permissions
 }
 
-func (k *Keeper) GetChannelID(channelName string) (sdk.ChannelID, error) {
-	id, ok := k.cfg.nameToChannelID[channelName]
-	if !ok {
+func (k *Keeper) GetChannelID(ctx sdk.Context, channelName string) (sdk.ChannelID, error) {
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		id, ok := k.cfg.nameToChannelID[channelName]
+		if !ok {
+			return sdk.ChannelID(0), fmt.Errorf("non-existing channel")
+		}
+		return id, nil
+	}
+	id, found := k.getChannelIDByName(ctx, channelName)
+	if !found {
 		return sdk.ChannelID(0), fmt.Errorf("non-existing channel")
 	}
 	return id, nil
 }
 
+func (k *Keeper) GetChannelName(ctx sdk.Context, id sdk.ChannelID) (string, error) {
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		name, ok := k.cfg.channelIDToName[id]
+		if !ok {
+			return "", fmt.Errorf("non-existing channel")
+		}
+		return name, nil
+	}
+	channel, found := k.getChannelInfo(ctx, id)
+	if !found {
+		return "", fmt.Errorf("non-existing channel")
+	}
+	return channel.Name, nil
+}
+
+// GetChannelIDs returns the ids of all the registered channels in ascending order
+func (k *Keeper) GetChannelIDs(ctx sdk.Context) []sdk.ChannelID {
+	channels := k.GetRegisteredChannels(ctx)
+	ids := make([]sdk.ChannelID, 0, len(channels))
+	for _, channel := range channels {
+		ids = append(ids, channel.ChannelId)
+	}
+	return ids
+}
+
+// GetDestChainIDs returns the ids of all the registered destination chains in ascending order
+func (k *Keeper) GetDestChainIDs(ctx sdk.Context) []sdk.ChainID {
+	destChains := k.GetRegisteredDestChains(ctx)
+	ids := make([]sdk.ChainID, 0, len(destChains))
+	for _, destChain := range destChains {
+		ids = append(ids, destChain.ChainId)
+	}
+	return ids
+}
+
 func (k *Keeper) SetSrcChainID(srcChainID sdk.ChainID) {
 	k.cfg.srcChainID = srcChainID
 }
@@ -164,20 +210,34 @@ func (k *Keeper) GetSrcChainID() sdk.ChainID {
 	return k.cfg.srcChainID
 }
 
-func (k *Keeper) GetDestChainID(name string) (sdk.ChainID, error) {
-	destChainID, exist := k.cfg.destChainNameToID[name]
-	if !exist {
+func (k *Keeper) GetDestChainID(ctx sdk.Context, name string) (sdk.ChainID, error) {
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		destChainID, exist := k.cfg.destChainNameToID[name]
+		if !exist {
+			return sdk.ChainID(0), fmt.Errorf("non-existing destination chainName ")
+		}
+		return destChainID, nil
+	}
+	destChainID, found := k.getDestChainIDByName(ctx, name)
+	if !found {
 		return sdk.ChainID(0), fmt.Errorf("non-existing destination chainName ")
 	}
 	return destChainID, nil
 }
 
-func (k *Keeper) GetDestChainName(id sdk.ChainID) (string, error) {
-	destChainName, exist := k.cfg.destChainIDToName[id]
-	if !exist {
+func (k *Keeper) GetDestChainName(ctx sdk.Context, id sdk.ChainID) (string, error) {
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		destChainName, exist := k.cfg.destChainIDToName[id]
+		if !exist {
+			return "", fmt.Errorf("non-existing destination chainID")
+		}
+		return destChainName, nil
+	}
+	destChain, found := k.getDestChainInfo(ctx, id)
+	if !found {
 		return "", fmt.Errorf("non-existing destination chainID")
 	}
-	return destChainName, nil
+	return destChain.Name, nil
 }
 
 func (k *Keeper) GetSendSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) uint64 {
@@ -197,6 +257,12 @@ func (k *Keeper) IncrReceiveSequence(ctx sdk.Context, destChainID sdk.ChainID, c
 }
 
 func (k *Keeper) GetCrossChainApp(ctx sdk.Context, channelID sdk.ChannelID) sdk.CrossChainApplication {
+	if sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		// the packages of paused or retired channels are still delivered, as they were sent before
+		if _, found := k.getChannelInfo(ctx, channelID); !found {
+			return nil
+		}
+	}
 	return k.cfg.channelIDToApp[channelID]
 }
 
@@ -225,14 +291,24 @@ func (k *Keeper) incrSequence(ctx sdk.Context, destChainID sdk.ChainID, channelI
 }
 
 func EndBlock(ctx sdk.Context, k Keeper) {
+	if sdk.IsUpgrade(sdk.CrossChainRegistry) && k.govKeeper != nil {
+		k.executeRegistryProposals(ctx)
+	}
+	if sdk.IsUpgrade(sdk.ChannelRateLimit) && k.govKeeper != nil {
+		k.executeChanRateLimitProposals(ctx)
+	}
 	if sdk.IsUpgrade(sdk.LaunchBscUpgrade) && k.govKeeper != nil {
 		chanPermissions := k.getLastChanPermissionChanges(ctx)
 		// should in reverse order
 		for j := len(chanPermissions) - 1; j >= 0; j-- {
 			change := chanPermissions[j]
 			// must exist
-			id, _ := k.cfg.destChainNameToID[change.SideChainId]
+			id, _ := k.GetDestChainID(ctx, change.SideChainId)
 			k.SetChannelSendPermission(ctx, id, change.ChannelId, change.Permission)
+			if sdk.IsUpgrade(sdk.ChannelRateLimit) && change.Permission == sdk.ChannelAllow {
+				// the traffic which tripped the channel before should not trip it again
+				k.resetChannelTraffic(ctx, id, change.ChannelId)
+			}
 			_, err := k.SaveChannelSettingChangeToIbc(ctx, id, change.ChannelId, change.Permission)
 			if err != nil {
 				ctx.Logger().With("module", "side_chain").Error("failed to write cross chain channel permission change message ",
@@ -240,5 +316,6 @@ func EndBlock(ctx sdk.Context, k Keeper) {
 			}
 		}
 	}
+	k.applyChannelTrips(ctx)
 	return
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -58,3 +59,154 @@ func TestKeeper_SetSideChainIdAndStorePrefix(t *testing.T) {
 	require.Equal(t, scIds[1], "xyz")
 	require.Equal(t, scPrefixes[1], []byte{0xab})
 }
+
+func TestCrossChainRegistry(t *testing.T) {
+	ctx, keeper := CreateTestInput(t, false)
+	require.NoError(t, keeper.RegisterDestChain("bsc", sdk.ChainID(1)))
+	require.NoError(t, keeper.RegisterChannel("bind", sdk.ChannelID(1), nil))
+	require.NoError(t, keeper.RegisterChannel("staking", sdk.ChannelID(8), nil))
+	require.NoError(t, keeper.RegisterChannel("transfer", sdk.ChannelID(2), nil))
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.CrossChainRegistry, 10)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.CrossChainRegistry, 0)
+	sdk.UpgradeMgr.SetHeight(9)
+	require.True(t, keeper.IsChannelActive(ctx, sdk.ChainID(1), sdk.ChannelID(2)))
+	require.Len(t, keeper.GetRegisteredChannels(ctx), 3)
+
+	sdk.UpgradeMgr.SetHeight(10)
+	require.Len(t, keeper.GetRegisteredChannels(ctx), 0)
+	_, err := keeper.GetChannelID(ctx, "bind")
+	require.Error(t, err)
+
+	keeper.InitRegistryFromConfig(ctx)
+	keeper.setChannelInfo(ctx, types.ChannelInfo{Name: "transfer", ChannelId: sdk.ChannelID(2), Status: types.StatusRetired})
+	channelID, err := keeper.GetChannelID(ctx, "staking")
+	require.NoError(t, err)
+	require.Equal(t, sdk.ChannelID(8), channelID)
+	require.Equal(t, []sdk.ChannelID{1, 2, 8}, keeper.GetChannelIDs(ctx))
+	require.Equal(t, []sdk.ChainID{1}, keeper.GetDestChainIDs(ctx))
+	require.True(t, keeper.IsChannelActive(ctx, sdk.ChainID(1), sdk.ChannelID(1)))
+	require.False(t, keeper.IsChannelActive(ctx, sdk.ChainID(1), sdk.ChannelID(2)))
+	require.False(t, keeper.IsChannelActive(ctx, sdk.ChainID(2), sdk.ChannelID(1)))
+
+	// add a channel and a destination chain
+	require.Error(t, keeper.applyChannelRegistrySetting(ctx, types.ChannelRegistrySetting{Action: types.RegistryActionAdd, ChannelName: "bind", ChannelId: sdk.ChannelID(3)}))
+	require.Error(t, keeper.applyChannelRegistrySetting(ctx, types.ChannelRegistrySetting{Action: types.RegistryActionAdd, ChannelName: "oracle", ChannelId: sdk.ChannelID(8)}))
+	require.Error(t, keeper.applyChannelRegistrySetting(ctx, types.ChannelRegistrySetting{Action: types.RegistryActionAdd, ChannelName: "gov", ChannelId: types.GovChannelId}))
+	require.NoError(t, keeper.applyChannelRegistrySetting(ctx, types.ChannelRegistrySetting{Action: types.RegistryActionAdd, ChannelName: "oracle", ChannelId: sdk.ChannelID(3)}))
+	require.NoError(t, keeper.applyDestChainRegistrySetting(ctx, types.DestChainRegistrySetting{Action: types.RegistryActionAdd, DestChainName: "eth", ChainId: sdk.ChainID(2)}))
+	destChainName, err := keeper.GetDestChainName(ctx, sdk.ChainID(2))
+	require.NoError(t, err)
+	require.Equal(t, "eth", destChainName)
+	destChainID, err := keeper.GetDestChainID(ctx, "eth")
+	require.NoError(t, err)
+	require.Equal(t, sdk.ChainID(2), destChainID)
+	channelID, err = keeper.GetChannelID(ctx, "oracle")
+	require.NoError(t, err)
+	require.Equal(t, sdk.ChannelID(3), channelID)
+	require.True(t, keeper.IsChannelActive(ctx, sdk.ChainID(2), sdk.ChannelID(3)))
+	require.Nil(t, keeper.GetCrossChainApp(ctx, sdk.ChannelID(3)))
+
+	// pause, resume and retire
+	require.Error(t, keeper.applyChannelRegistrySetting(ctx, types.ChannelRegistrySetting{Action: types.RegistryActionResume, ChannelId: sdk.ChannelID(3)}))
+	require.NoError(t, keeper.applyChannelRegistrySetting(ctx, types.ChannelRegistrySetting{Action: types.RegistryActionPause, ChannelId: sdk.ChannelID(3)}))
+	require.False(t, keeper.IsChannelActive(ctx, sdk.ChainID(2), sdk.ChannelID(3)))
+	require.NoError(t, keeper.applyChannelRegistrySetting(ctx, types.ChannelRegistrySetting{Action: types.RegistryActionResume, ChannelId: sdk.ChannelID(3)}))
+	require.True(t, keeper.IsChannelActive(ctx, sdk.ChainID(2), sdk.ChannelID(3)))
+	require.NoError(t, keeper.applyDestChainRegistrySetting(ctx, types.DestChainRegistrySetting{Action: types.RegistryActionRetire, ChainId: sdk.ChainID(2)}))
+	require.False(t, keeper.IsChannelActive(ctx, sdk.ChainID(2), sdk.ChannelID(3)))
+	require.Error(t, keeper.applyDestChainRegistrySetting(ctx, types.DestChainRegistrySetting{Action: types.RegistryActionResume, ChainId: sdk.ChainID(2)}))
+	require.Error(t, keeper.applyDestChainRegistrySetting(ctx, types.DestChainRegistrySetting{Action: types.RegistryActionPause, ChainId: sdk.ChainID(3)}))
+
+	res, sdkErr := NewQuerier(keeper)(ctx, []string{QueryCrossChainRegistry}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+	var registry types.CrossChainRegistry
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &registry))
+	require.Equal(t, []types.ChannelInfo{
+		{Name: "bind", ChannelId: sdk.ChannelID(1), Status: types.StatusActive},
+		{Name: "transfer", ChannelId: sdk.ChannelID(2), Status: types.StatusRetired},
+		{Name: "oracle", ChannelId: sdk.ChannelID(3), Status: types.StatusActive},
+		{Name: "staking", ChannelId: sdk.ChannelID(8), Status: types.StatusActive},
+	}, registry.Channels)
+	require.Equal(t, []types.DestChainInfo{
+		{Name: "bsc", ChainId: sdk.ChainID(1), Status: types.StatusActive},
+		{Name: "eth", ChainId: sdk.ChainID(2), Status: types.StatusRetired},
+	}, registry.DestChains)
+}
+
+type valuedApp struct{}
+
+func (valuedApp) ExecuteSynPackage(ctx sdk.Context, payload []byte, relayerFee int64) sdk.ExecuteResult {
+	return sdk.ExecuteResult{}
+}
+
+func (valuedApp) ExecuteAckPackage(ctx sdk.Context, payload []byte) sdk.ExecuteResult {
+	return sdk.ExecuteResult{}
+}
+
+func (valuedApp) ExecuteFailAckPackage(ctx sdk.Context, payload []byte) sdk.ExecuteResult {
+	return sdk.ExecuteResult{}
+}
+
+func (valuedApp) GetSynPackageValue(payload []byte) (int64, error) {
+	return int64(payload[0]), nil
+}
+
+func TestChannelRateLimit(t *testing.T) {
+	ctx, keeper := CreateTestInput(t, false)
+	destChainID := sdk.ChainID(1)
+	channelID := sdk.ChannelID(2)
+	require.NoError(t, keeper.RegisterDestChain("bsc", destChainID))
+	require.NoError(t, keeper.RegisterChannel("transfer", channelID, valuedApp{}))
+	keeper.SetChannelSendPermission(ctx, destChainID, channelID, sdk.ChannelAllow)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ChannelRateLimit, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.ChannelRateLimit, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	// no rate limit
+	require.NoError(t, keeper.RecordChannelTraffic(ctx, types.TrafficOutbound, destChainID, channelID, []byte{100}))
+
+	keeper.SetChannelRateLimit(ctx, destChainID, channelID, types.ChanRateLimit{MaxPackagesPerBlock: 2, MaxValuePerWindow: 10, ValueWindow: 5})
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, keeper.RecordChannelTraffic(ctx, types.TrafficOutbound, destChainID, channelID, []byte{4}))
+	require.NoError(t, keeper.RecordChannelTraffic(ctx, types.TrafficOutbound, destChainID, channelID, []byte{4}))
+	require.Error(t, keeper.RecordChannelTraffic(ctx, types.TrafficOutbound, destChainID, channelID, []byte{1}))
+	// directions are counted separately
+	require.NoError(t, keeper.RecordChannelTraffic(ctx, types.TrafficInbound, destChainID, channelID, []byte{4}))
+
+	ctx = ctx.WithBlockHeight(3)
+	require.Error(t, keeper.RecordChannelTraffic(ctx, types.TrafficOutbound, destChainID, channelID, []byte{3}))
+	require.NoError(t, keeper.RecordChannelTraffic(ctx, types.TrafficOutbound, destChainID, channelID, []byte{2}))
+	require.Equal(t, types.ChanTraffic{Height: 3, Packages: 1, WindowStartHeight: 1, WindowValue: 10},
+		keeper.GetChannelTraffic(ctx, types.TrafficOutbound, destChainID, channelID))
+
+	// the channel is forbidden at the end of the block
+	require.Equal(t, sdk.ChannelAllow, keeper.GetChannelSendPermission(ctx, destChainID, channelID))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlock(ctx, keeper)
+	require.Equal(t, sdk.ChannelForbidden, keeper.GetChannelSendPermission(ctx, destChainID, channelID))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeChannelRateLimitTripped, ctx.EventManager().Events()[0].Type)
+
+	// inbound packages are refused once the channel is forbidden, even in a new value window
+	ctx = ctx.WithBlockHeight(6)
+	require.Error(t, keeper.RecordChannelTraffic(ctx, types.TrafficInbound, destChainID, channelID, []byte{1}))
+
+	keeper.SetChannelSendPermission(ctx, destChainID, channelID, sdk.ChannelAllow)
+	require.NoError(t, keeper.RecordChannelTraffic(ctx, types.TrafficInbound, destChainID, channelID, []byte{10}))
+	require.Equal(t, types.ChanTraffic{Height: 6, Packages: 1, WindowStartHeight: 6, WindowValue: 10},
+		keeper.GetChannelTraffic(ctx, types.TrafficInbound, destChainID, channelID))
+
+	// remove the rate limit
+	keeper.SetChannelRateLimit(ctx, destChainID, channelID, types.ChanRateLimit{})
+	_, found := keeper.GetChannelRateLimit(ctx, destChainID, channelID)
+	require.False(t, found)
+	require.NoError(t, keeper.RecordChannelTraffic(ctx, types.TrafficInbound, destChainID, channelID, []byte{100}))
+
+	// a package failing the valuation trips the channel
+	keeper.SetChannelRateLimit(ctx, destChainID, channelID, types.ChanRateLimit{MaxValuePerWindow: 10, ValueWindow: 5})
+	require.Error(t, keeper.RecordChannelTraffic(ctx, types.TrafficInbound, destChainID, channelID, []byte{}))
+	EndBlock(ctx, keeper)
+	require.Equal(t, sdk.ChannelForbidden, keeper.GetChannelSendPermission(ctx, destChainID, channelID))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
 )
 
 const (
@@ -20,6 +21,16 @@ var (
 	PrefixForReceiveSequenceKey = []byte{0xf1}
 
 	PrefixForChannelPermissionKey = []byte{0xc0}
+
+	PrefixForChannelRegistryKey   = []byte{0xc1}
+	PrefixForDestChainRegistryKey = []byte{0xc2}
+
+	PrefixForChannelRateLimitKey = []byte{0xc3}
+	PrefixForChannelTrafficKey   = []byte{0xc4}
+
+	// indexes of the registered channels and destination chains by name
+	PrefixForChannelNameKey   = []byte{0xc5}
+	PrefixForDestChainNameKey = []byte{0xc6}
 )
 
 func GetSideChainStorePrefixKey(sideChainId string) []byte {
@@ -51,3 +62,37 @@ func buildChannelPermissionsPrefixKey(destChainID sdk.ChainID) []byte {
 	binary.BigEndian.PutUint16(key[prefixLength:prefixLength+destChainIDLength], uint16(destChainID))
 	return key
 }
+
+func buildChannelRegistryKey(channelID sdk.ChannelID) []byte {
+	return append(append([]byte{}, PrefixForChannelRegistryKey...), byte(channelID))
+}
+
+func buildDestChainRegistryKey(destChainID sdk.ChainID) []byte {
+	key := make([]byte, prefixLength+destChainIDLength)
+
+	copy(key[:prefixLength], PrefixForDestChainRegistryKey)
+	binary.BigEndian.PutUint16(key[prefixLength:], uint16(destChainID))
+	return key
+}
+
+func buildChannelNameKey(name string) []byte {
+	return append(append([]byte{}, PrefixForChannelNameKey...), []byte(name)...)
+}
+
+func buildDestChainNameKey(name string) []byte {
+	return append(append([]byte{}, PrefixForDestChainNameKey...), []byte(name)...)
+}
+
+func buildChannelRateLimitKey(destChainID sdk.ChainID, channelID sdk.ChannelID) []byte {
+	return buildChannelSequenceKey(destChainID, channelID, PrefixForChannelRateLimitKey)
+}
+
+func buildChannelTrafficKey(direction types.TrafficDirection, destChainID sdk.ChainID, channelID sdk.ChannelID) []byte {
+	key := make([]byte, prefixLength+1+destChainIDLength+channelIDLength)
+
+	copy(key[:prefixLength], PrefixForChannelTrafficKey)
+	key[prefixLength] = byte(direction)
+	binary.BigEndian.PutUint16(key[prefixLength+1:prefixLength+1+destChainIDLength], uint16(destChainID))
+	key[prefixLength+1+destChainIDLength] = byte(channelID)
+	return key
+}
@@ -0,0 +1,11 @@
+package sidechain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func RegisterUpgradeBeginBlocker(keeper Keeper) {
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.CrossChainRegistry, func(ctx sdk.Context) {
+		keeper.InitRegistryFromConfig(ctx)
+	})
+}
@@ -2,12 +2,16 @@ package sidechain
 
 import (
 	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 const (
-	QuerychannelSettings = "channelSettings"
+	QuerychannelSettings    = "channelSettings"
+	QueryCrossChainRegistry = "crossChainRegistry"
 )
 
 // creates a querier for staking REST endpoints
@@ -24,6 +28,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 				return nil, ErrInvalidSideChainId(DefaultCodespace, "SideChainId is missing")
 			}
 			return queryChannelSettings(ctx, k, sideChainId)
+		case QueryCrossChainRegistry:
+			return queryCrossChainRegistry(ctx, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown side chain query endpoint")
 		}
@@ -31,7 +37,7 @@ func NewQuerier(k Keeper) sdk.Querier {
 }
 
 func queryChannelSettings(ctx sdk.Context, k Keeper, sideChainId string) ([]byte, sdk.Error) {
-	id, err := k.GetDestChainID(sideChainId)
+	id, err := k.GetDestChainID(ctx, sideChainId)
 	if err != nil {
 		return nil, ErrInvalidSideChainId(DefaultCodespace, err.Error())
 	}
@@ -44,3 +50,16 @@ func queryChannelSettings(ctx sdk.Context, k Keeper, sideChainId string) ([]byte
 
 	return res, nil
 }
+
+func queryCrossChainRegistry(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	registry := types.CrossChainRegistry{
+		Channels:   k.GetRegisteredChannels(ctx),
+		DestChains: k.GetRegisteredDestChains(ctx),
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, registry)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,229 @@
+package sidechain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+type channelTrip struct {
+	destChainID sdk.ChainID
+	channelID   sdk.ChannelID
+	direction   types.TrafficDirection
+	reason      string
+}
+
+// channelTripCollector collects the channels exceeding their rate limits in DeliverTx, they are forbidden in EndBlock
+// so that the trip is kept even if the tx sending the package fails.
+type channelTripCollector struct {
+	trips []channelTrip
+}
+
+func newChannelTripCollector() *channelTripCollector {
+	return &channelTripCollector{
+		trips: nil,
+	}
+}
+
+func (k *Keeper) SetChannelRateLimit(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, rateLimit types.ChanRateLimit) {
+	kvStore := ctx.KVStore(k.storeKey)
+	if rateLimit.IsEmpty() {
+		kvStore.Delete(buildChannelRateLimitKey(destChainID, channelID))
+		return
+	}
+	kvStore.Set(buildChannelRateLimitKey(destChainID, channelID), k.cdc.MustMarshalBinaryBare(rateLimit))
+}
+
+func (k *Keeper) GetChannelRateLimit(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) (rateLimit types.ChanRateLimit, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(buildChannelRateLimitKey(destChainID, channelID))
+	if bz == nil {
+		return rateLimit, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &rateLimit)
+	return rateLimit, true
+}
+
+func (k *Keeper) GetChannelTraffic(ctx sdk.Context, direction types.TrafficDirection, destChainID sdk.ChainID, channelID sdk.ChannelID) (traffic types.ChanTraffic) {
+	bz := ctx.KVStore(k.storeKey).Get(buildChannelTrafficKey(direction, destChainID, channelID))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &traffic)
+	}
+	return traffic
+}
+
+func (k *Keeper) setChannelTraffic(ctx sdk.Context, direction types.TrafficDirection, destChainID sdk.ChainID, channelID sdk.ChannelID, traffic types.ChanTraffic) {
+	ctx.KVStore(k.storeKey).Set(buildChannelTrafficKey(direction, destChainID, channelID), k.cdc.MustMarshalBinaryBare(traffic))
+}
+
+func (k *Keeper) resetChannelTraffic(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) {
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Delete(buildChannelTrafficKey(types.TrafficOutbound, destChainID, channelID))
+	kvStore.Delete(buildChannelTrafficKey(types.TrafficInbound, destChainID, channelID))
+}
+
+// RecordChannelTraffic counts a syn package of the channel against its rate limit. If the package exceeds the limit,
+// it is not counted, an error is returned and the channel will be forbidden at the end of the block. A package
+// which can not be valued is treated as exceeding the limit.
+// Once a rate limited channel is forbidden, its inbound packages are refused as well until it is allowed again.
+func (k *Keeper) RecordChannelTraffic(ctx sdk.Context, direction types.TrafficDirection, destChainID sdk.ChainID,
+	channelID sdk.ChannelID, payload []byte) sdk.Error {
+	if !sdk.IsUpgrade(sdk.ChannelRateLimit) {
+		return nil
+	}
+	rateLimit, found := k.GetChannelRateLimit(ctx, destChainID, channelID)
+	if !found {
+		return nil
+	}
+	if direction == types.TrafficInbound && k.GetChannelSendPermission(ctx, destChainID, channelID) != sdk.ChannelAllow {
+		return ErrChannelRateLimitExceeded(DefaultCodespace, fmt.Sprintf("channel %d is forbidden", channelID))
+	}
+
+	var value int64
+	if valuer, ok := k.cfg.channelIDToApp[channelID].(sdk.CrossChainPackageValuer); ok && rateLimit.MaxValuePerWindow > 0 {
+		var err error
+		value, err = valuePackage(valuer, payload)
+		if err != nil {
+			k.collectChannelTrip(ctx, direction, destChainID, channelID, fmt.Sprintf("invalid package value: %v", err))
+			return ErrInvalidPackageValue(DefaultCodespace, err.Error())
+		}
+	}
+
+	height := ctx.BlockHeight()
+	traffic := k.GetChannelTraffic(ctx, direction, destChainID, channelID)
+	if traffic.Height != height {
+		traffic.Height = height
+		traffic.Packages = 0
+	}
+	if traffic.WindowStartHeight == 0 || height >= traffic.WindowStartHeight+rateLimit.ValueWindow {
+		traffic.WindowStartHeight = height
+		traffic.WindowValue = 0
+	}
+
+	var reason string
+	if rateLimit.MaxPackagesPerBlock > 0 && traffic.Packages+1 > rateLimit.MaxPackagesPerBlock {
+		reason = fmt.Sprintf("more than %d packages in block %d", rateLimit.MaxPackagesPerBlock, height)
+	} else if rateLimit.MaxValuePerWindow > 0 && traffic.WindowValue+value > rateLimit.MaxValuePerWindow {
+		reason = fmt.Sprintf("more than %d value in the window starting at block %d", rateLimit.MaxValuePerWindow, traffic.WindowStartHeight)
+	}
+	if reason != "" {
+		k.collectChannelTrip(ctx, direction, destChainID, channelID, reason)
+		return ErrChannelRateLimitExceeded(DefaultCodespace, fmt.Sprintf("%s traffic of channel %d exceeds the rate limit: %s", direction, channelID, reason))
+	}
+
+	traffic.Packages++
+	traffic.WindowValue += value
+	k.setChannelTraffic(ctx, direction, destChainID, channelID, traffic)
+	return nil
+}
+
+// valuePackage values the syn package by the application bound to the channel, a panic of the application is
+// returned as an error
+func valuePackage(valuer sdk.CrossChainPackageValuer, payload []byte) (value int64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			value, err = 0, fmt.Errorf("failed to value the package: %v", r)
+		}
+	}()
+
+	value, err = valuer.GetSynPackageValue(payload)
+	if err == nil && value < 0 {
+		err = fmt.Errorf("package value %d is negative", value)
+	}
+	return value, err
+}
+
+func (k *Keeper) collectChannelTrip(ctx sdk.Context, direction types.TrafficDirection, destChainID sdk.ChainID,
+	channelID sdk.ChannelID, reason string) {
+	if !ctx.IsDeliverTx() {
+		return
+	}
+	k.tripCollector.trips = append(k.tripCollector.trips, channelTrip{
+		destChainID: destChainID,
+		channelID:   channelID,
+		direction:   direction,
+		reason:      reason,
+	})
+}
+
+// applyChannelTrips forbids the channels which exceeded their rate limits in the block
+func (k *Keeper) applyChannelTrips(ctx sdk.Context) {
+	trips := k.tripCollector.trips
+	k.tripCollector.trips = nil
+
+	logger := ctx.Logger().With("module", "side_chain")
+	for _, trip := range trips {
+		if k.GetChannelSendPermission(ctx, trip.destChainID, trip.channelID) == sdk.ChannelForbidden {
+			continue
+		}
+		k.SetChannelSendPermission(ctx, trip.destChainID, trip.channelID, sdk.ChannelForbidden)
+		if k.ibcKeeper != nil {
+			if _, err := k.SaveChannelSettingChangeToIbc(ctx, trip.destChainID, trip.channelID, sdk.ChannelForbidden); err != nil {
+				logger.Error("failed to write cross chain channel permission change message ", "err", err)
+			}
+		}
+		logger.Error("channel is forbidden as it exceeds the rate limit", "destChainId", trip.destChainID,
+			"channelId", trip.channelID, "direction", trip.direction.String(), "reason", trip.reason)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeChannelRateLimitTripped,
+			sdk.NewAttribute(types.AttributeKeyDestChainId, fmt.Sprintf("%d", trip.destChainID)),
+			sdk.NewAttribute(types.AttributeKeyChannelId, fmt.Sprintf("%d", trip.channelID)),
+			sdk.NewAttribute(types.AttributeKeyDirection, trip.direction.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, trip.reason),
+		))
+	}
+}
+
+func (k *Keeper) ValidateChanRateLimitSetting(ctx sdk.Context, setting types.ChanRateLimitSetting) error {
+	if err := setting.Check(); err != nil {
+		return err
+	}
+	if _, err := k.GetDestChainID(ctx, setting.SideChainId); err != nil {
+		return fmt.Errorf("the SideChainId do not exist")
+	}
+	if _, err := k.GetChannelName(ctx, setting.ChannelId); err != nil {
+		return fmt.Errorf("the ChannelId do not exist")
+	}
+	return nil
+}
+
+// executeChanRateLimitProposals applies the passed ManageChanRateLimit proposals in the order they were submitted
+func (k *Keeper) executeChanRateLimitProposals(ctx sdk.Context) {
+	settings := make([]types.ChanRateLimitSetting, 0)
+	logger := ctx.Logger().With("module", "side_chain")
+	// It can still find the valid proposal if the block chain stop for SafeToleratePeriod time
+	backPeriod := SafeToleratePeriod + gov.MaxVotingPeriod
+	k.govKeeper.Iterate(ctx, nil, nil, gov.StatusNil, 0, true, func(proposal gov.Proposal) bool {
+		if proposal.GetProposalType() == gov.ProposalTypeManageChanRateLimit {
+			if ctx.BlockHeader().Time.Sub(proposal.GetVotingStartTime()) > backPeriod {
+				return true
+			}
+			if proposal.GetStatus() != gov.StatusPassed {
+				return false
+			}
+
+			proposal.SetStatus(gov.StatusExecuted)
+			k.govKeeper.SetProposal(ctx, proposal)
+
+			var setting types.ChanRateLimitSetting
+			err := k.cdc.UnmarshalJSON([]byte(proposal.GetDescription()), &setting)
+			if err != nil {
+				logger.Error("Get broken data when unmarshal ChanRateLimitSetting msg, will skip.",
+					"proposalId", proposal.GetProposalID(), "err", err)
+				return false
+			}
+			if err := k.ValidateChanRateLimitSetting(ctx, setting); err != nil {
+				logger.Error("The ChanRateLimitSetting proposal is invalid, will skip.",
+					"proposalId", proposal.GetProposalID(), "setting", setting, "err", err)
+				return false
+			}
+			settings = append(settings, setting)
+		}
+		return false
+	})
+
+	for j := len(settings) - 1; j >= 0; j-- {
+		destChainID, _ := k.GetDestChainID(ctx, settings[j].SideChainId)
+		k.SetChannelRateLimit(ctx, destChainID, settings[j].ChannelId, settings[j].RateLimit)
+	}
+}
@@ -0,0 +1,253 @@
+package sidechain
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+// Before the CrossChainRegistry upgrade, the channels and destination chains are the ones registered in code by
+// RegisterChannel and RegisterDestChain. After it, they are kept in the store and managed by gov proposals,
+// the CrossChainApplication bound to a channel still comes from RegisterChannel.
+
+func (k *Keeper) getChannelInfo(ctx sdk.Context, channelID sdk.ChannelID) (info types.ChannelInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(buildChannelRegistryKey(channelID))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+func (k *Keeper) setChannelInfo(ctx sdk.Context, info types.ChannelInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(buildChannelRegistryKey(info.ChannelId), k.cdc.MustMarshalBinaryBare(info))
+	store.Set(buildChannelNameKey(info.Name), []byte{byte(info.ChannelId)})
+}
+
+func (k *Keeper) getChannelIDByName(ctx sdk.Context, name string) (id sdk.ChannelID, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(buildChannelNameKey(name))
+	if bz == nil {
+		return id, false
+	}
+	return sdk.ChannelID(bz[0]), true
+}
+
+func (k *Keeper) getDestChainInfo(ctx sdk.Context, destChainID sdk.ChainID) (info types.DestChainInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(buildDestChainRegistryKey(destChainID))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+func (k *Keeper) setDestChainInfo(ctx sdk.Context, info types.DestChainInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(buildDestChainRegistryKey(info.ChainId), k.cdc.MustMarshalBinaryBare(info))
+	bz := make([]byte, destChainIDLength)
+	binary.BigEndian.PutUint16(bz, uint16(info.ChainId))
+	store.Set(buildDestChainNameKey(info.Name), bz)
+}
+
+func (k *Keeper) getDestChainIDByName(ctx sdk.Context, name string) (id sdk.ChainID, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(buildDestChainNameKey(name))
+	if bz == nil {
+		return id, false
+	}
+	return sdk.ChainID(binary.BigEndian.Uint16(bz)), true
+}
+
+// GetRegisteredChannels returns all the registered channels in ascending order of the channel id
+func (k *Keeper) GetRegisteredChannels(ctx sdk.Context) []types.ChannelInfo {
+	channels := make([]types.ChannelInfo, 0)
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		for _, id := range k.cfg.sortedChannelIDs() {
+			channels = append(channels, types.ChannelInfo{Name: k.cfg.channelIDToName[id], ChannelId: id, Status: types.StatusActive})
+		}
+		return channels
+	}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), PrefixForChannelRegistryKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ChannelInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+		channels = append(channels, info)
+	}
+	return channels
+}
+
+// GetRegisteredDestChains returns all the registered destination chains in ascending order of the chain id
+func (k *Keeper) GetRegisteredDestChains(ctx sdk.Context) []types.DestChainInfo {
+	destChains := make([]types.DestChainInfo, 0)
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		for _, id := range k.cfg.sortedDestChainIDs() {
+			destChains = append(destChains, types.DestChainInfo{Name: k.cfg.destChainIDToName[id], ChainId: id, Status: types.StatusActive})
+		}
+		return destChains
+	}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), PrefixForDestChainRegistryKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.DestChainInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+		destChains = append(destChains, info)
+	}
+	return destChains
+}
+
+// IsChannelActive returns whether syn packages can be sent to the destination chain through the channel
+func (k *Keeper) IsChannelActive(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) bool {
+	if !sdk.IsUpgrade(sdk.CrossChainRegistry) {
+		return true
+	}
+	channel, found := k.getChannelInfo(ctx, channelID)
+	if !found || channel.Status != types.StatusActive {
+		return false
+	}
+	destChain, found := k.getDestChainInfo(ctx, destChainID)
+	return found && destChain.Status == types.StatusActive
+}
+
+// InitRegistryFromConfig adds the channels and destination chains registered in code to the store as active ones,
+// it is run at the CrossChainRegistry upgrade
+func (k *Keeper) InitRegistryFromConfig(ctx sdk.Context) {
+	for _, id := range k.cfg.sortedChannelIDs() {
+		if _, found := k.getChannelInfo(ctx, id); !found {
+			k.setChannelInfo(ctx, types.ChannelInfo{Name: k.cfg.channelIDToName[id], ChannelId: id, Status: types.StatusActive})
+		}
+	}
+	for _, id := range k.cfg.sortedDestChainIDs() {
+		if _, found := k.getDestChainInfo(ctx, id); !found {
+			k.setDestChainInfo(ctx, types.DestChainInfo{Name: k.cfg.destChainIDToName[id], ChainId: id, Status: types.StatusActive})
+		}
+	}
+}
+
+func (k *Keeper) ValidateChannelRegistrySetting(ctx sdk.Context, setting types.ChannelRegistrySetting) error {
+	if err := setting.Check(); err != nil {
+		return err
+	}
+	info, found := k.getChannelInfo(ctx, setting.ChannelId)
+	if setting.Action != types.RegistryActionAdd {
+		if !found {
+			return fmt.Errorf("channel %d is not registered", setting.ChannelId)
+		}
+		_, err := setting.Action.Apply(info.Status)
+		return err
+	}
+
+	if found {
+		return fmt.Errorf("channel %d is already registered", setting.ChannelId)
+	}
+	if _, err := k.GetChannelID(ctx, setting.ChannelName); err == nil {
+		return fmt.Errorf("channel name %s is already registered", setting.ChannelName)
+	}
+	if name, ok := k.cfg.channelIDToName[setting.ChannelId]; ok && name != setting.ChannelName {
+		return fmt.Errorf("channel %d is bound to channel name %s in code", setting.ChannelId, name)
+	}
+	return nil
+}
+
+func (k *Keeper) ValidateDestChainRegistrySetting(ctx sdk.Context, setting types.DestChainRegistrySetting) error {
+	if err := setting.Check(); err != nil {
+		return err
+	}
+	info, found := k.getDestChainInfo(ctx, setting.ChainId)
+	if setting.Action != types.RegistryActionAdd {
+		if !found {
+			return fmt.Errorf("destination chain %d is not registered", setting.ChainId)
+		}
+		_, err := setting.Action.Apply(info.Status)
+		return err
+	}
+
+	if found {
+		return fmt.Errorf("destination chain %d is already registered", setting.ChainId)
+	}
+	if _, err := k.GetDestChainID(ctx, setting.DestChainName); err == nil {
+		return fmt.Errorf("destination chain name %s is already registered", setting.DestChainName)
+	}
+	if name, ok := k.cfg.destChainIDToName[setting.ChainId]; ok && name != setting.DestChainName {
+		return fmt.Errorf("destination chain %d is bound to destination chain name %s in code", setting.ChainId, name)
+	}
+	return nil
+}
+
+func (k *Keeper) applyChannelRegistrySetting(ctx sdk.Context, setting types.ChannelRegistrySetting) error {
+	if err := k.ValidateChannelRegistrySetting(ctx, setting); err != nil {
+		return err
+	}
+	if setting.Action == types.RegistryActionAdd {
+		k.setChannelInfo(ctx, types.ChannelInfo{Name: setting.ChannelName, ChannelId: setting.ChannelId, Status: types.StatusActive})
+		return nil
+	}
+	info, _ := k.getChannelInfo(ctx, setting.ChannelId)
+	info.Status, _ = setting.Action.Apply(info.Status)
+	k.setChannelInfo(ctx, info)
+	return nil
+}
+
+func (k *Keeper) applyDestChainRegistrySetting(ctx sdk.Context, setting types.DestChainRegistrySetting) error {
+	if err := k.ValidateDestChainRegistrySetting(ctx, setting); err != nil {
+		return err
+	}
+	if setting.Action == types.RegistryActionAdd {
+		k.setDestChainInfo(ctx, types.DestChainInfo{Name: setting.DestChainName, ChainId: setting.ChainId, Status: types.StatusActive})
+		return nil
+	}
+	info, _ := k.getDestChainInfo(ctx, setting.ChainId)
+	info.Status, _ = setting.Action.Apply(info.Status)
+	k.setDestChainInfo(ctx, info)
+	return nil
+}
+
+// executeRegistryProposals applies the passed ManageChannel and ManageDestChain proposals in the order they were submitted
+func (k *Keeper) executeRegistryProposals(ctx sdk.Context) {
+	proposals := make([]gov.Proposal, 0)
+	// It can still find the valid proposal if the block chain stop for SafeToleratePeriod time
+	backPeriod := SafeToleratePeriod + gov.MaxVotingPeriod
+	k.govKeeper.Iterate(ctx, nil, nil, gov.StatusNil, 0, true, func(proposal gov.Proposal) bool {
+		if proposal.GetProposalType() == gov.ProposalTypeManageChannel || proposal.GetProposalType() == gov.ProposalTypeManageDestChain {
+			if ctx.BlockHeader().Time.Sub(proposal.GetVotingStartTime()) > backPeriod {
+				return true
+			}
+			if proposal.GetStatus() != gov.StatusPassed {
+				return false
+			}
+
+			proposal.SetStatus(gov.StatusExecuted)
+			k.govKeeper.SetProposal(ctx, proposal)
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+
+	logger := ctx.Logger().With("module", "side_chain")
+	for j := len(proposals) - 1; j >= 0; j-- {
+		proposal := proposals[j]
+		var err error
+		if proposal.GetProposalType() == gov.ProposalTypeManageChannel {
+			var setting types.ChannelRegistrySetting
+			if err = k.cdc.UnmarshalJSON([]byte(proposal.GetDescription()), &setting); err == nil {
+				err = k.applyChannelRegistrySetting(ctx, setting)
+			}
+		} else {
+			var setting types.DestChainRegistrySetting
+			if err = k.cdc.UnmarshalJSON([]byte(proposal.GetDescription()), &setting); err == nil {
+				err = k.applyDestChainRegistrySetting(ctx, setting)
+			}
+		}
+		if err != nil {
+			logger.Error("The cross chain registry proposal is invalid, will skip.",
+				"proposalId", proposal.GetProposalID(), "err", err)
+			continue
+		}
+		logger.Info("The cross chain registry proposal is executed.", "proposalId", proposal.GetProposalID())
+	}
+}
@@ -0,0 +1,32 @@
+package sidechain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+// RegisterPayloadSchema declares the payloads of the packages of a channel, it is only used to show the packages
+func (k *Keeper) RegisterPayloadSchema(channelID sdk.ChannelID, schema types.PayloadSchema) error {
+	if _, ok := k.cfg.channelIDToSchema[channelID]; ok {
+		return fmt.Errorf("duplicated payload schema of channel %d", channelID)
+	}
+	k.cfg.channelIDToSchema[channelID] = schema
+	return nil
+}
+
+func (k *Keeper) GetPayloadSchema(channelID sdk.ChannelID) (types.PayloadSchema, bool) {
+	schema, ok := k.cfg.channelIDToSchema[channelID]
+	return schema, ok
+}
+
+// DecodePackage decodes a package with its header by the payload schema registered for the channel
+func (k *Keeper) DecodePackage(ctx sdk.Context, channelID sdk.ChannelID, sequence uint64, pack []byte) types.DecodedPackage {
+	var schema *types.PayloadSchema
+	if s, ok := k.cfg.channelIDToSchema[channelID]; ok {
+		schema = &s
+	}
+	channelName, _ := k.GetChannelName(ctx, channelID)
+	return types.DecodePackage(schema, channelID, channelName, sequence, pack)
+}
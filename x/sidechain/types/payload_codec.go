@@ -0,0 +1,246 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+)
+
+// The payload codec encodes a payload struct as the RLP list of its fields, the same as bsc/rlp does, but the trailing
+// fields tagged `payload:"optional"` are omitted from the list if they are zero. The side chain contracts which only
+// know the leading fields can keep decoding the payloads, and the payloads of the old contracts without the trailing
+// fields can still be decoded.
+//
+//	type TransferOutPackage struct {
+//		Amount    *big.Int
+//		Recipient []byte
+//		Memo      []byte `payload:"optional"`
+//	}
+const payloadTagOptional = "optional"
+
+// DecodeMode controls how the list elements not declared by the payload struct are treated
+type DecodeMode uint8
+
+const (
+	// StrictDecode rejects the payloads with more elements than the fields of the struct, or with trailing bytes
+	StrictDecode DecodeMode = iota
+	// LenientDecode ignores the elements beyond the fields of the struct, which are appended by newer payload versions
+	LenientDecode
+)
+
+type payloadField struct {
+	index    int
+	name     string
+	optional bool
+}
+
+type payloadStruct struct {
+	typ      reflect.Type
+	fields   []payloadField
+	required int
+}
+
+func parsePayloadStruct(typ reflect.Type) (*payloadStruct, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("payload type %v is not a struct", typ)
+	}
+	ps := &payloadStruct{typ: typ}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		rlpTag := strings.TrimSpace(f.Tag.Get("rlp"))
+		if rlpTag == "-" {
+			continue
+		}
+		if rlpTag != "" {
+			return nil, fmt.Errorf("rlp tag %q of %v.%s is not supported by the payload codec", rlpTag, typ, f.Name)
+		}
+		field := payloadField{index: i, name: f.Name}
+		switch tag := strings.TrimSpace(f.Tag.Get("payload")); tag {
+		case "":
+			if len(ps.fields) > ps.required {
+				return nil, fmt.Errorf("field %v.%s follows optional fields but is not optional", typ, f.Name)
+			}
+			ps.required++
+		case payloadTagOptional:
+			field.optional = true
+		default:
+			return nil, fmt.Errorf("unknown payload tag %q of %v.%s", tag, typ, f.Name)
+		}
+		ps.fields = append(ps.fields, field)
+	}
+	return ps, nil
+}
+
+func payloadStructOf(val interface{}) (*payloadStruct, reflect.Value, error) {
+	v := reflect.ValueOf(val)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, v, errors.New("payload is a nil pointer")
+		}
+		v = v.Elem()
+	}
+	ps, err := parsePayloadStruct(v.Type())
+	return ps, v, err
+}
+
+func (ps *payloadStruct) encode(v reflect.Value) ([]byte, error) {
+	// the zero optional fields are omitted only if all the fields after them are omitted
+	count := len(ps.fields)
+	for count > ps.required && v.Field(ps.fields[count-1].index).IsZero() {
+		count--
+	}
+	elems := make([]rlp.RawValue, 0, count)
+	for _, field := range ps.fields[:count] {
+		bz, err := rlp.EncodeToBytes(v.Field(field.index).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("encode field %s: %s", field.name, err.Error())
+		}
+		elems = append(elems, bz)
+	}
+	return rlp.EncodeToBytes(elems)
+}
+
+// decode decodes the elements of the payload list into the struct, the fields absent in the list are left zero
+func (ps *payloadStruct) decode(elems []byte, count int, v reflect.Value, mode DecodeMode) error {
+	if count < ps.required {
+		return fmt.Errorf("missing field %s of %v", ps.fields[count].name, ps.typ)
+	}
+	if mode == StrictDecode && count > len(ps.fields) {
+		return fmt.Errorf("%d elements are more than the %d fields of %v", count, len(ps.fields), ps.typ)
+	}
+	for _, field := range ps.fields {
+		if len(elems) == 0 {
+			break
+		}
+		_, _, rest, err := rlp.Split(elems)
+		if err != nil {
+			return fmt.Errorf("decode field %s: %s", field.name, err.Error())
+		}
+		if err := rlp.DecodeBytes(elems[:len(elems)-len(rest)], v.Field(field.index).Addr().Interface()); err != nil {
+			return fmt.Errorf("decode field %s: %s", field.name, err.Error())
+		}
+		elems = rest
+	}
+	return nil
+}
+
+func splitPayload(payload []byte, mode DecodeMode) ([]byte, int, error) {
+	elems, rest, err := rlp.SplitList(payload)
+	if err != nil {
+		return nil, 0, err
+	}
+	if mode == StrictDecode && len(rest) != 0 {
+		return nil, 0, fmt.Errorf("%d trailing bytes after the payload", len(rest))
+	}
+	count, err := rlp.CountValues(elems)
+	if err != nil {
+		return nil, 0, err
+	}
+	return elems, count, nil
+}
+
+// EncodePayload encodes the payload struct, omitting its trailing zero optional fields
+func EncodePayload(val interface{}) ([]byte, error) {
+	ps, v, err := payloadStructOf(val)
+	if err != nil {
+		return nil, err
+	}
+	return ps.encode(v)
+}
+
+// DecodePayload decodes the payload into the struct pointed by val, the optional fields absent in the payload are zero
+func DecodePayload(payload []byte, val interface{}, mode DecodeMode) error {
+	v := reflect.ValueOf(val)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("decode payload into a non-pointer or a nil pointer")
+	}
+	ps, v, err := payloadStructOf(val)
+	if err != nil {
+		return err
+	}
+	elems, count, err := splitPayload(payload, mode)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.Zero(v.Type()))
+	return ps.decode(elems, count, v, mode)
+}
+
+// PayloadVersions are the versions of the payload struct of a channel, starting from version 1. Every version keeps the
+// fields of the previous version and appends new ones, so the version of a payload is told by the number of its elements.
+type PayloadVersions struct {
+	versions []*payloadStruct
+}
+
+// NewPayloadVersions creates the PayloadVersions with the prototypes of the versions in order
+func NewPayloadVersions(protos ...interface{}) (*PayloadVersions, error) {
+	if len(protos) == 0 {
+		return nil, errors.New("no payload version")
+	}
+	pv := &PayloadVersions{}
+	for i, proto := range protos {
+		typ := reflect.TypeOf(proto)
+		for typ != nil && typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ == nil {
+			return nil, fmt.Errorf("nil prototype of version %d", i+1)
+		}
+		ps, err := parsePayloadStruct(typ)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			prev := pv.versions[i-1]
+			if len(ps.fields) <= len(prev.fields) || ps.required < prev.required {
+				return nil, fmt.Errorf("version %d does not append fields to version %d", i+1, i)
+			}
+			for j, field := range prev.fields {
+				if ps.typ.Field(ps.fields[j].index).Type != prev.typ.Field(field.index).Type {
+					return nil, fmt.Errorf("field %d of version %d mismatches version %d", j, i+1, i)
+				}
+			}
+		}
+		pv.versions = append(pv.versions, ps)
+	}
+	return pv, nil
+}
+
+// Latest returns the latest version
+func (pv *PayloadVersions) Latest() int {
+	return len(pv.versions)
+}
+
+// Decode decodes the payload into a new value of the latest version whose required fields are all in the payload
+func (pv *PayloadVersions) Decode(payload []byte, mode DecodeMode) (int, interface{}, error) {
+	elems, count, err := splitPayload(payload, mode)
+	if err != nil {
+		return 0, nil, err
+	}
+	for version := len(pv.versions); version > 0; version-- {
+		ps := pv.versions[version-1]
+		if count < ps.required {
+			continue
+		}
+		value := reflect.New(ps.typ)
+		if err := ps.decode(elems, count, value.Elem(), mode); err != nil {
+			return 0, nil, err
+		}
+		return version, value.Interface(), nil
+	}
+	return 0, nil, fmt.Errorf("%d elements are less than the fields of any version", count)
+}
+
+// Decoder returns the PayloadDecoder of the payload versions for the PayloadSchema of a channel
+func (pv *PayloadVersions) Decoder(mode DecodeMode) PayloadDecoder {
+	return func(payload []byte) (interface{}, error) {
+		_, value, err := pv.Decode(payload, mode)
+		return value, err
+	}
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+)
+
+type testPayloadV1 struct {
+	Amount    *big.Int
+	Recipient []byte
+}
+
+type testPayloadV2 struct {
+	Amount    *big.Int
+	Recipient []byte
+	Memo      []byte `payload:"optional"`
+	Expire    uint64 `payload:"optional"`
+}
+
+type testPayloadV3 struct {
+	Amount    *big.Int
+	Recipient []byte
+	Memo      []byte
+	Expire    uint64
+	Fee       *big.Int `payload:"optional"`
+	internal  uint64
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestPayloadCodecGolden(t *testing.T) {
+	recipient := []byte{0xaa, 0xbb, 0xcc}
+	tests := []struct {
+		payload interface{}
+		encoded string
+	}{
+		{testPayloadV1{big.NewInt(1000), recipient}, "c78203e883aabbcc"},
+		{&testPayloadV1{big.NewInt(0), nil}, "c28080"},
+		{testPayloadV2{Amount: big.NewInt(1000), Recipient: recipient}, "c78203e883aabbcc"},
+		{testPayloadV2{Amount: big.NewInt(1000), Recipient: recipient, Memo: []byte("hi")}, "ca8203e883aabbcc826869"},
+		// the zero memo is kept before the non-zero expire
+		{testPayloadV2{Amount: big.NewInt(1000), Recipient: recipient, Expire: 7}, "c98203e883aabbcc8007"},
+		{testPayloadV3{Amount: big.NewInt(1000), Recipient: recipient}, "c98203e883aabbcc8080"},
+		{testPayloadV3{Amount: big.NewInt(1000), Recipient: recipient, Fee: big.NewInt(1), internal: 1}, "ca8203e883aabbcc808001"},
+	}
+	for i, test := range tests {
+		bz, err := EncodePayload(test.payload)
+		require.NoError(t, err, "test %d", i)
+		require.Equal(t, test.encoded, hex.EncodeToString(bz), "test %d", i)
+	}
+
+	// the payloads without the optional fields are the same as encoded by rlp
+	v1 := testPayloadV1{big.NewInt(1000), recipient}
+	bz, err := rlp.EncodeToBytes(v1)
+	require.NoError(t, err)
+	require.Equal(t, "c78203e883aabbcc", hex.EncodeToString(bz))
+}
+
+func TestDecodePayload(t *testing.T) {
+	var v2 testPayloadV2
+	require.NoError(t, DecodePayload(mustHex(t, "c78203e883aabbcc"), &v2, StrictDecode))
+	require.Equal(t, testPayloadV2{Amount: big.NewInt(1000), Recipient: []byte{0xaa, 0xbb, 0xcc}}, v2)
+
+	// the fields are reset before decoding
+	v2.Expire = 1
+	require.NoError(t, DecodePayload(mustHex(t, "ca8203e883aabbcc826869"), &v2, StrictDecode))
+	require.Equal(t, []byte("hi"), v2.Memo)
+	require.Zero(t, v2.Expire)
+
+	// the elements appended by a newer version
+	var v1 testPayloadV1
+	require.Error(t, DecodePayload(mustHex(t, "ca8203e883aabbcc826869"), &v1, StrictDecode))
+	require.NoError(t, DecodePayload(mustHex(t, "ca8203e883aabbcc826869"), &v1, LenientDecode))
+	require.Equal(t, testPayloadV1{big.NewInt(1000), []byte{0xaa, 0xbb, 0xcc}}, v1)
+
+	// trailing bytes
+	require.Error(t, DecodePayload(mustHex(t, "c78203e883aabbcc00"), &v1, StrictDecode))
+	require.NoError(t, DecodePayload(mustHex(t, "c78203e883aabbcc00"), &v1, LenientDecode))
+
+	// missing the required field
+	err := DecodePayload(mustHex(t, "c38203e8"), &v1, LenientDecode)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Recipient")
+	var v3 testPayloadV3
+	require.Error(t, DecodePayload(mustHex(t, "c78203e883aabbcc"), &v3, LenientDecode))
+
+	// invalid element
+	require.Error(t, DecodePayload(mustHex(t, "c4c28080aa"), &v1, LenientDecode))
+	require.Error(t, DecodePayload(mustHex(t, "c78203e883aabbcc"), v1, StrictDecode))
+}
+
+func TestPayloadTags(t *testing.T) {
+	_, err := EncodePayload(struct {
+		A uint64 `payload:"optional"`
+		B uint64
+	}{})
+	require.Error(t, err)
+
+	_, err = EncodePayload(struct {
+		A uint64
+		B []uint64 `rlp:"tail"`
+	}{})
+	require.Error(t, err)
+
+	_, err = EncodePayload(struct {
+		A uint64 `payload:"required"`
+	}{})
+	require.Error(t, err)
+
+	bz, err := EncodePayload(struct {
+		A uint64
+		B uint64 `rlp:"-"`
+	}{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, "c101", hex.EncodeToString(bz))
+
+	_, err = EncodePayload(uint64(1))
+	require.Error(t, err)
+}
+
+func TestPayloadVersions(t *testing.T) {
+	_, err := NewPayloadVersions(testPayloadV2{}, testPayloadV1{})
+	require.Error(t, err)
+	_, err = NewPayloadVersions(testPayloadV1{}, struct {
+		Amount    uint64
+		Recipient []byte
+		Memo      []byte
+	}{})
+	require.Error(t, err)
+
+	versions, err := NewPayloadVersions(testPayloadV1{}, &testPayloadV3{})
+	require.NoError(t, err)
+	require.Equal(t, 2, versions.Latest())
+
+	version, value, err := versions.Decode(mustHex(t, "c78203e883aabbcc"), StrictDecode)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	require.Equal(t, &testPayloadV1{big.NewInt(1000), []byte{0xaa, 0xbb, 0xcc}}, value)
+
+	version, value, err = versions.Decode(mustHex(t, "ca8203e883aabbcc808001"), StrictDecode)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+	require.Equal(t, big.NewInt(1), value.(*testPayloadV3).Fee)
+
+	// 3 elements are more than version 1 but less than version 2
+	_, _, err = versions.Decode(mustHex(t, "ca8203e883aabbcc826869"), StrictDecode)
+	require.Error(t, err)
+	version, _, err = versions.Decode(mustHex(t, "ca8203e883aabbcc826869"), LenientDecode)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+
+	_, _, err = versions.Decode(mustHex(t, "c38203e8"), LenientDecode)
+	require.Error(t, err)
+
+	// the version is decoded for the payload schema of a channel
+	schema := PayloadSchema{Syn: versions.Decoder(LenientDecode)}
+	decoded := DecodePackage(&schema, 1, "transfer", 0, append(make([]byte, PackageHeaderLength), mustHex(t, "c78203e883aabbcc")...))
+	require.Empty(t, decoded.DecodeError)
+	require.Equal(t, &testPayloadV1{big.NewInt(1000), []byte{0xaa, 0xbb, 0xcc}}, decoded.Payload)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxRateLimitValueWindow int64 = 7 * 24 * 60 * 60 // about 1 week of blocks
+
+	EventTypeChannelRateLimitTripped = "channelRateLimitTripped"
+
+	AttributeKeyDestChainId = "dest_chain_id"
+	AttributeKeyChannelId   = "channel_id"
+	AttributeKeyDirection   = "direction"
+	AttributeKeyReason      = "reason"
+)
+
+// TrafficDirection is the direction of the syn packages a channel rate limit is applied to
+type TrafficDirection byte
+
+const (
+	TrafficOutbound TrafficDirection = 0x01 // syn packages sent to the destination chain
+	TrafficInbound  TrafficDirection = 0x02 // syn packages received from the destination chain
+)
+
+func (d TrafficDirection) String() string {
+	switch d {
+	case TrafficOutbound:
+		return "outbound"
+	case TrafficInbound:
+		return "inbound"
+	default:
+		return ""
+	}
+}
+
+// ChanRateLimit limits the syn packages of a channel in each direction, the channel is forbidden once a limit is exceeded
+type ChanRateLimit struct {
+	MaxPackagesPerBlock int64 `json:"max_packages_per_block"` // 0 means no limit
+	MaxValuePerWindow   int64 `json:"max_value_per_window"`   // 0 means no limit, only works for the channels whose application reports the package value
+	ValueWindow         int64 `json:"value_window"`           // number of blocks of a value window
+}
+
+func (l ChanRateLimit) IsEmpty() bool {
+	return l.MaxPackagesPerBlock == 0 && l.MaxValuePerWindow == 0
+}
+
+func (l ChanRateLimit) Check() error {
+	if l.MaxPackagesPerBlock < 0 {
+		return fmt.Errorf("max_packages_per_block should not be negative")
+	}
+	if l.MaxValuePerWindow < 0 {
+		return fmt.Errorf("max_value_per_window should not be negative")
+	}
+	if l.MaxValuePerWindow > 0 && (l.ValueWindow <= 0 || l.ValueWindow > MaxRateLimitValueWindow) {
+		return fmt.Errorf("value_window should be in (0, %d]", MaxRateLimitValueWindow)
+	}
+	return nil
+}
+
+// ChanTraffic is the syn package traffic of a channel in one direction counted against the ChanRateLimit
+type ChanTraffic struct {
+	Height            int64 `json:"height"`              // height of the last counted package
+	Packages          int64 `json:"packages"`            // number of the packages at Height
+	WindowStartHeight int64 `json:"window_start_height"` // start height of the current value window
+	WindowValue       int64 `json:"window_value"`        // total value of the packages in the current value window
+}
+
+// ChanRateLimitSetting is the content of a ManageChanRateLimit proposal, an empty RateLimit removes the limit
+type ChanRateLimitSetting struct {
+	SideChainId string        `json:"side_chain_id"`
+	ChannelId   sdk.ChannelID `json:"channel_id"`
+	RateLimit   ChanRateLimit `json:"rate_limit"`
+}
+
+func (c *ChanRateLimitSetting) Check() error {
+	if len(c.SideChainId) == 0 || len(c.SideChainId) > MaxSideChainIdLength {
+		return fmt.Errorf("invalid side chain id")
+	}
+	if c.ChannelId == GovChannelId {
+		return fmt.Errorf("gov channel id is forbidden to set")
+	}
+	return c.RateLimit.Check()
+}
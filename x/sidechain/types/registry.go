@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxChannelNameLength = 32
+
+	// destination chain names are joined with this separator internally, so they must not contain it
+	DestChainNameSeparator = "::"
+)
+
+// RegistryStatus is the status of a channel or a destination chain in the on-chain registry
+type RegistryStatus string
+
+const (
+	StatusActive  RegistryStatus = "active"  // syn packages can be sent
+	StatusPaused  RegistryStatus = "paused"  // no syn package can be sent until it is resumed
+	StatusRetired RegistryStatus = "retired" // no syn package can be sent any more, the id and name can not be reused
+)
+
+// RegistryAction is the change a registry proposal applies to a channel or a destination chain
+type RegistryAction string
+
+const (
+	RegistryActionAdd    RegistryAction = "add"
+	RegistryActionPause  RegistryAction = "pause"
+	RegistryActionResume RegistryAction = "resume"
+	RegistryActionRetire RegistryAction = "retire"
+)
+
+func (action RegistryAction) IsValid() bool {
+	switch action {
+	case RegistryActionAdd, RegistryActionPause, RegistryActionResume, RegistryActionRetire:
+		return true
+	default:
+		return false
+	}
+}
+
+// Apply returns the status after the action is applied to a channel or a destination chain in status `from`
+func (action RegistryAction) Apply(from RegistryStatus) (RegistryStatus, error) {
+	switch action {
+	case RegistryActionPause:
+		if from != StatusActive {
+			return from, fmt.Errorf("only an active entry can be paused, current status is %s", from)
+		}
+		return StatusPaused, nil
+	case RegistryActionResume:
+		if from != StatusPaused {
+			return from, fmt.Errorf("only a paused entry can be resumed, current status is %s", from)
+		}
+		return StatusActive, nil
+	case RegistryActionRetire:
+		if from == StatusRetired {
+			return from, fmt.Errorf("the entry is already retired")
+		}
+		return StatusRetired, nil
+	default:
+		return from, fmt.Errorf("action %s can not be applied to a registered entry", action)
+	}
+}
+
+// ChannelInfo is a cross chain channel in the on-chain registry
+type ChannelInfo struct {
+	Name      string         `json:"name"`
+	ChannelId sdk.ChannelID  `json:"channel_id"`
+	Status    RegistryStatus `json:"status"`
+}
+
+// DestChainInfo is a destination chain in the on-chain registry
+type DestChainInfo struct {
+	Name    string         `json:"name"`
+	ChainId sdk.ChainID    `json:"chain_id"`
+	Status  RegistryStatus `json:"status"`
+}
+
+// CrossChainRegistry lists all the registered channels and destination chains
+type CrossChainRegistry struct {
+	Channels   []ChannelInfo   `json:"channels"`
+	DestChains []DestChainInfo `json:"dest_chains"`
+}
+
+// ChannelRegistrySetting is the content of a ManageChannel proposal, the ChannelName is only needed to add a channel
+type ChannelRegistrySetting struct {
+	Action      RegistryAction `json:"action"`
+	ChannelName string         `json:"channel_name"`
+	ChannelId   sdk.ChannelID  `json:"channel_id"`
+}
+
+func (c *ChannelRegistrySetting) Check() error {
+	if !c.Action.IsValid() {
+		return fmt.Errorf("action %s is invalid", c.Action)
+	}
+	if c.ChannelId == GovChannelId {
+		return fmt.Errorf("gov channel id is forbidden to manage")
+	}
+	if c.Action == RegistryActionAdd && (len(c.ChannelName) == 0 || len(c.ChannelName) > MaxChannelNameLength) {
+		return fmt.Errorf("invalid channel name")
+	}
+	return nil
+}
+
+// DestChainRegistrySetting is the content of a ManageDestChain proposal, the DestChainName is only needed to add a destination chain
+type DestChainRegistrySetting struct {
+	Action        RegistryAction `json:"action"`
+	DestChainName string         `json:"dest_chain_name"`
+	ChainId       sdk.ChainID    `json:"chain_id"`
+}
+
+func (c *DestChainRegistrySetting) Check() error {
+	if !c.Action.IsValid() {
+		return fmt.Errorf("action %s is invalid", c.Action)
+	}
+	if c.Action == RegistryActionAdd {
+		if len(c.DestChainName) == 0 || len(c.DestChainName) > MaxSideChainIdLength {
+			return fmt.Errorf("invalid destination chain name")
+		}
+		if strings.Contains(c.DestChainName, DestChainNameSeparator) {
+			return fmt.Errorf("destination chain name should not contains %s", DestChainNameSeparator)
+		}
+	}
+	return nil
+}
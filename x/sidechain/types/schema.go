@@ -0,0 +1,115 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PayloadDecoder decodes the payload of a package, without the package header, into a value which can be shown as JSON
+type PayloadDecoder func(payload []byte) (interface{}, error)
+
+// RLPPayloadDecoder returns a PayloadDecoder decoding the RLP encoded payload into a new value of the type of proto
+func RLPPayloadDecoder(proto interface{}) PayloadDecoder {
+	typ := reflect.TypeOf(proto)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return func(payload []byte) (interface{}, error) {
+		value := reflect.New(typ)
+		if err := rlp.DecodeBytes(payload, value.Interface()); err != nil {
+			return nil, err
+		}
+		return value.Interface(), nil
+	}
+}
+
+// PayloadSchema declares the payloads of the syn, ack and fail ack packages of a channel.
+// A nil decoder means the packages of the type are not expected on the channel.
+// The payload of a fail ack package is the payload of the original syn package, FailAck falls back to Syn if it is nil.
+type PayloadSchema struct {
+	Syn     PayloadDecoder
+	Ack     PayloadDecoder
+	FailAck PayloadDecoder
+}
+
+func (s PayloadSchema) decoder(packageType sdk.CrossChainPackageType) PayloadDecoder {
+	switch packageType {
+	case sdk.SynCrossChainPackageType:
+		return s.Syn
+	case sdk.AckCrossChainPackageType:
+		return s.Ack
+	case sdk.FailAckCrossChainPackageType:
+		if s.FailAck == nil {
+			return s.Syn
+		}
+		return s.FailAck
+	default:
+		return nil
+	}
+}
+
+// DecodedPackage is a cross chain package with its payload decoded by the PayloadSchema of the channel.
+// RawPayload is kept if the payload can not be decoded.
+type DecodedPackage struct {
+	ChannelId   sdk.ChannelID `json:"channel_id"`
+	ChannelName string        `json:"channel_name"`
+	Sequence    uint64        `json:"sequence"`
+	PackageType string        `json:"package_type"`
+	RelayerFee  string        `json:"relayer_fee"`
+	Payload     interface{}   `json:"payload,omitempty"`
+	RawPayload  string        `json:"raw_payload,omitempty"`
+	DecodeError string        `json:"decode_error,omitempty"`
+}
+
+func PackageTypeString(packageType sdk.CrossChainPackageType) string {
+	switch packageType {
+	case sdk.SynCrossChainPackageType:
+		return "syn"
+	case sdk.AckCrossChainPackageType:
+		return "ack"
+	case sdk.FailAckCrossChainPackageType:
+		return "fail_ack"
+	default:
+		return fmt.Sprintf("unknown(%d)", packageType)
+	}
+}
+
+// DecodePackage decodes a package with its header by the schema, the errors are recorded in the DecodedPackage
+func DecodePackage(schema *PayloadSchema, channelID sdk.ChannelID, channelName string, sequence uint64, pack []byte) DecodedPackage {
+	decoded := DecodedPackage{
+		ChannelId:   channelID,
+		ChannelName: channelName,
+		Sequence:    sequence,
+	}
+	packageType, relayerFee, err := DecodePackageHeader(pack)
+	if err != nil {
+		decoded.RawPayload = hex.EncodeToString(pack)
+		decoded.DecodeError = err.Error()
+		return decoded
+	}
+	decoded.PackageType = PackageTypeString(packageType)
+	decoded.RelayerFee = relayerFee.String()
+
+	payload := pack[PackageHeaderLength:]
+	var decoder PayloadDecoder
+	if schema != nil {
+		decoder = schema.decoder(packageType)
+	}
+	if decoder == nil {
+		decoded.RawPayload = hex.EncodeToString(payload)
+		decoded.DecodeError = fmt.Sprintf("no payload schema for %s package of channel %d", decoded.PackageType, channelID)
+		return decoded
+	}
+	value, err := decoder(payload)
+	if err != nil {
+		decoded.RawPayload = hex.EncodeToString(payload)
+		decoded.DecodeError = err.Error()
+		return decoded
+	}
+	decoded.Payload = value
+	return decoded
+}
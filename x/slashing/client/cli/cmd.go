@@ -18,6 +18,8 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 	slashingCmd.AddCommand(
 		client.PostCommands(
 			GetCmdBscSubmitEvidence(cdc),
+			GetCmdSideChainSubmitEvidence(cdc),
+			GetCmdSideChainSubmitDowntimeEvidence(cdc),
 			GetCmdSideChainUnjail(cdc),
 		)...)
 
@@ -27,6 +29,7 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 			GetCmdQuerySideChainSlashRecord(slashingStoreName, cdc),
 			GetCmdQuerySideChainSlashRecords(cdc),
 			GetCmdQueryAllSideSlashRecords(slashingStoreName, cdc),
+			GetCmdQuerySideChainDelegatorSlashRecords(cdc),
 		)...)
 
 	root.AddCommand(slashingCmd)
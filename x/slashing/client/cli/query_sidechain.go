@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/sidechain"
@@ -71,13 +73,41 @@ func GetCmdQuerySideChainSigningInfo(storeName string, cdc *codec.Codec) *cobra.
 func GetCmdQueryAllSideSlashRecords(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "side-all-slash-histories",
-		Short: "Query all slash histories on side chain",
+		Short: "Query all slash histories on side chain, or a page of them with the pagination flags",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			_, sideChainStorePrefix, err := getSideChainConfig(cliCtx)
+			sideChainId, sideChainStorePrefix, err := getSideChainConfig(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			page, paginated, err := utils.ReadPageRequest()
 			if err != nil {
 				return err
 			}
+			if paginated {
+				params := slashing.QuerySlashRecordsPageParams{
+					BaseParams: slashing.NewBaseParams(sideChainId),
+					Page:       page,
+				}
+				if infractionType := viper.GetString(FlagInfractionType); len(infractionType) != 0 {
+					resType, err := convertInfractionType(infractionType)
+					if err != nil {
+						return err
+					}
+					params.InfractionType = &resType
+				}
+				bz, err := json.Marshal(params)
+				if err != nil {
+					return err
+				}
+				response, err := cliCtx.QueryWithData("custom/slashing/"+slashing.QuerySlashRecordsPage, bz)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(response))
+				return nil
+			}
 
 			key := append(sideChainStorePrefix, slashing.SlashRecordKey...)
 			resKVs, err := cliCtx.QuerySubspace(key, storeName)
@@ -117,6 +147,8 @@ func GetCmdQueryAllSideSlashRecords(storeName string, cdc *codec.Codec) *cobra.C
 	}
 
 	cmd.Flags().String(FlagSideChainId, "", "chain-id of the side chain the validator belongs to")
+	cmd.Flags().String(FlagInfractionType, "", "only query the page of the infraction type, 'DoubleSign;Downtime;DowntimeEvidence'")
+	client.PaginationFlags(cmd)
 	return cmd
 }
 
@@ -176,7 +208,7 @@ func GetCmdQuerySideChainSlashRecord(storeName string, cdc *codec.Codec) *cobra.
 			return nil
 		},
 	}
-	cmd.Flags().String(FlagInfractionType, "", "infraction type, 'DoubleSign;Downtime'")
+	cmd.Flags().String(FlagInfractionType, "", "infraction type, 'DoubleSign;Downtime;DowntimeEvidence'")
 	cmd.Flags().Int64(FlagInfractionHeight, 0, "infraction height")
 	cmd.Flags().String(FlagSideChainId, "", "chain-id of the side chain the validator belongs to")
 	cmd.MarkFlagRequired(FlagInfractionType)
@@ -264,8 +296,71 @@ func GetCmdQuerySideChainSlashRecords(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String(FlagInfractionType, "", "infraction type, 'DoubleSign;Downtime'")
+	cmd.Flags().String(FlagInfractionType, "", "infraction type, 'DoubleSign;Downtime;DowntimeEvidence'")
+	cmd.Flags().String(FlagSideChainId, "", "chain-id of the side chain the validator belongs to")
+	return cmd
+}
+
+// GetCmdQuerySideChainDelegatorSlashRecords implements the command to query the slash histories of a delegator
+func GetCmdQuerySideChainDelegatorSlashRecords(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-delegator-slash-histories [delegator-addr]",
+		Short: "Query the stake a delegator lost in the slashes of the validators on side chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			sideChainId, _, err := getSideChainConfig(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			page, _, err := utils.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+			params := slashing.QueryDelegatorSlashRecordsParams{
+				BaseParams:    slashing.NewBaseParams(sideChainId),
+				DelegatorAddr: delAddr,
+				Page:          page,
+			}
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+			response, err := cliCtx.QueryWithData("custom/slashing/"+slashing.QueryDelegatorSlashRecords, bz)
+			if err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				var recordsPage slashing.DelegatorSlashRecordsPage
+				if err = cdc.UnmarshalJSON(response, &recordsPage); err != nil {
+					return err
+				}
+				if len(recordsPage.DelegatorSlashRecords) == 0 {
+					return fmt.Errorf("No slash histories found with delegator = %s\n", args[0])
+				}
+				for _, record := range recordsPage.DelegatorSlashRecords {
+					fmt.Println(record.HumanReadableString())
+				}
+				if len(recordsPage.NextKey) != 0 {
+					fmt.Printf("Next page key: %s\n", recordsPage.NextKey)
+				}
+			case "json":
+				fmt.Println(string(response))
+			}
+			return nil
+		},
+	}
+
 	cmd.Flags().String(FlagSideChainId, "", "chain-id of the side chain the validator belongs to")
+	client.PaginationFlags(cmd)
 	return cmd
 }
 
@@ -290,6 +385,8 @@ func convertInfractionType(infractionTypeS string) (byte, error) {
 		res = slashing.DoubleSign
 	} else if infractionTypeS == "Downtime" {
 		res = slashing.Downtime
+	} else if infractionTypeS == "DowntimeEvidence" {
+		res = slashing.DowntimeEvidence
 	} else {
 		return 0, errors.New("unknown infraction type")
 	}
@@ -80,6 +80,130 @@ func GetCmdBscSubmitEvidence(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdSideChainSubmitEvidence implements the submit evidence command handler for any side chain.
+func GetCmdSideChainSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-submit-evidence",
+		Short: "submit double sign evidence against the malicious validator on a side chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			sideChainId, err := getSideChainId()
+			if err != nil {
+				return err
+			}
+
+			evidenceBytes, err := getEvidenceBytes()
+			if err != nil {
+				return err
+			}
+
+			// each header is kept in the format of the side chain and decoded by its evidence verifier
+			rawHeaders := make([]json.RawMessage, 0)
+			err = json.Unmarshal(evidenceBytes, &rawHeaders)
+			if err != nil {
+				return err
+			}
+			if len(rawHeaders) != 2 {
+				return errors.New(fmt.Sprintf("must have 2 headers exactly"))
+			}
+			headers := make([][]byte, 0, len(rawHeaders))
+			for _, header := range rawHeaders {
+				headers = append(headers, header)
+			}
+
+			msg := slashing.NewMsgSideChainSubmitEvidence(from, sideChainId, headers)
+
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagEvidence, "", "Evidence details, including two headers with the json format of the side chain, e.g. [{\"difficulty\":\"0x2\",\"extraData\":\"0xd98301...},{\"difficulty\":\"0x3\",\"extraData\":\"0xd64372...}]")
+	cmd.Flags().String(flagEvidenceFile, "", "File of evidence details, if evidence-file is not empty, --evidence will be ignored")
+	cmd.Flags().String(flagSideChainId, "", "chain-id of the side chain the evidence is from")
+	return cmd
+}
+
+// GetCmdSideChainSubmitDowntimeEvidence implements the submit downtime evidence command handler for any side chain.
+func GetCmdSideChainSubmitDowntimeEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-submit-downtime-evidence [validator-sideConsAddr]",
+		Short: "submit consecutive headers which prove the validator missed its turn to sign a block on a side chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			sideConsAddr, err := sdk.HexDecode(args[0])
+			if err != nil {
+				return err
+			}
+
+			sideChainId, err := getSideChainId()
+			if err != nil {
+				return err
+			}
+
+			evidenceBytes, err := getEvidenceBytes()
+			if err != nil {
+				return err
+			}
+
+			rawHeaders := make([]json.RawMessage, 0)
+			err = json.Unmarshal(evidenceBytes, &rawHeaders)
+			if err != nil {
+				return err
+			}
+			headers := make([][]byte, 0, len(rawHeaders))
+			for _, header := range rawHeaders {
+				headers = append(headers, header)
+			}
+
+			msg := slashing.NewMsgSideChainSubmitDowntimeEvidence(from, sideChainId, sideConsAddr, headers)
+
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagEvidence, "", "Evidence details, including consecutive headers with the json format of the side chain")
+	cmd.Flags().String(flagEvidenceFile, "", "File of evidence details, if evidence-file is not empty, --evidence will be ignored")
+	cmd.Flags().String(flagSideChainId, "", "chain-id of the side chain the evidence is from")
+	return cmd
+}
+
+func getEvidenceBytes() ([]byte, error) {
+	filePath := viper.GetString(flagEvidenceFile)
+	if filePath != "" {
+		return os.ReadFile(filePath)
+	}
+	txStr := viper.GetString(flagEvidence)
+	if txStr == "" {
+		return nil, errors.New(fmt.Sprintf("either %s or %s is required", flagEvidenceFile, flagEvidence))
+	}
+	return []byte(txStr), nil
+}
+
 // GetCmdSideChainUnjail implements the create unjail validator command.
 func GetCmdSideChainUnjail(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -16,6 +18,103 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		"/slashing/validators/{validatorPubKey}/signing_info",
 		signingInfoHandlerFn(cliCtx, "slashing", cdc),
 	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/slash_records",
+		slashRecordsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/delegators/{delegatorAddr}/slash_records",
+		delegatorSlashRecordsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+}
+
+// http request handler to query a page of the stake a delegator lost in the slashes on the side chain
+func delegatorSlashRecordsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, _, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		params := slashing.QueryDelegatorSlashRecordsParams{
+			BaseParams:    slashing.NewBaseParams(r.URL.Query().Get("side_chain_id")),
+			DelegatorAddr: delAddr,
+			Page:          page,
+		}
+		bz, err := json.Marshal(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData("custom/slashing/"+slashing.QueryDelegatorSlashRecords, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// http request handler to query a page of the slash records, which can be filtered by the hex encoded consensus
+// address and the infraction type
+func slashRecordsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		query := r.URL.Query()
+		params := slashing.QuerySlashRecordsPageParams{
+			BaseParams: slashing.NewBaseParams(query.Get("side_chain_id")),
+			Page:       page,
+		}
+		if consAddr := query.Get("cons_addr"); len(consAddr) != 0 {
+			bz, err := sdk.HexDecode(consAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.ConsAddr = bz
+		}
+		if infractionType := query.Get("infraction_type"); len(infractionType) != 0 {
+			var t byte
+			switch infractionType {
+			case "DoubleSign":
+				t = slashing.DoubleSign
+			case "Downtime":
+				t = slashing.Downtime
+			case "DowntimeEvidence":
+				t = slashing.DowntimeEvidence
+			default:
+				utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("'%s' is not a valid infraction type", infractionType))
+				return
+			}
+			params.InfractionType = &t
+		}
+
+		bz, err := json.Marshal(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData("custom/slashing/"+slashing.QuerySlashRecordsPage, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
 }
 
 // http request handler to query signing info
@@ -9,6 +9,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnjail{}, "cosmos-sdk/MsgUnjail", nil)
 	cdc.RegisterConcrete(MsgSideChainUnjail{}, "cosmos-sdk/MsgSideChainUnjail", nil)
 	cdc.RegisterConcrete(MsgBscSubmitEvidence{}, "cosmos-sdk/MsgBscSubmitEvidence", nil)
+	cdc.RegisterConcrete(MsgSideChainSubmitEvidence{}, "cosmos-sdk/MsgSideChainSubmitEvidence", nil)
+	cdc.RegisterConcrete(MsgSideChainSubmitDowntimeEvidence{}, "cosmos-sdk/MsgSideChainSubmitDowntimeEvidence", nil)
 	cdc.RegisterConcrete(&Params{}, "params/SlashParamSet", nil)
 }
 
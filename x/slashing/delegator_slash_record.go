@@ -0,0 +1,86 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegatorSlashRecord is the stake a delegator lost in a side chain slash, it refers to the slash record of the
+// validator by the consensus address, the infraction type and the infraction height
+type DelegatorSlashRecord struct {
+	DelegatorAddr    sdk.AccAddress
+	ValidatorAddr    sdk.ValAddress
+	ConsAddr         []byte
+	InfractionType   byte
+	InfractionHeight uint64
+	SlashHeight      int64
+	DelegationAmt    int64 // tokens unbonded from the delegation
+	UnbondingAmt     int64 // tokens taken from the unbonding delegation
+	SideChainId      string
+}
+
+// total tokens the delegator lost
+func (r DelegatorSlashRecord) LossAmt() int64 {
+	return r.DelegationAmt + r.UnbondingAmt
+}
+
+func (r DelegatorSlashRecord) HumanReadableString() string {
+	var infraType string
+	if r.InfractionType == DoubleSign {
+		infraType = "DoubleSign"
+	} else if r.InfractionType == Downtime {
+		infraType = "Downtime"
+	} else if r.InfractionType == DowntimeEvidence {
+		infraType = "DowntimeEvidence"
+	}
+
+	resp := "DelegatorSlashRecord \n"
+	resp += fmt.Sprintf("Delegator: %s\n", r.DelegatorAddr)
+	resp += fmt.Sprintf("Validator: %s\n", r.ValidatorAddr)
+	resp += fmt.Sprintf("Consensus Address: %s\n", sdk.HexEncode(r.ConsAddr))
+	resp += fmt.Sprintf("Infraction Type : %s\n", infraType)
+	resp += fmt.Sprintf("Infraction Height: %d\n", r.InfractionHeight)
+	resp += fmt.Sprintf("Slash Height: %d\n", r.SlashHeight)
+	resp += fmt.Sprintf("Delegation Loss: %d\n", r.DelegationAmt)
+	resp += fmt.Sprintf("Unbonding Delegation Loss: %d\n", r.UnbondingAmt)
+	resp += fmt.Sprintf("Side Chain id: %s\n", r.SideChainId)
+	return resp
+}
+
+// setDelegatorSlashRecords records the losses of the delegators in the slash
+func (k Keeper) setDelegatorSlashRecords(ctx sdk.Context, sr SlashRecord, validator sdk.Validator, losses []sdk.DelegatorLoss) {
+	for _, loss := range losses {
+		k.setDelegatorSlashRecord(ctx, DelegatorSlashRecord{
+			DelegatorAddr:    loss.DelegatorAddr,
+			ValidatorAddr:    validator.GetOperator(),
+			ConsAddr:         sr.ConsAddr,
+			InfractionType:   sr.InfractionType,
+			InfractionHeight: sr.InfractionHeight,
+			SlashHeight:      sr.SlashHeight,
+			DelegationAmt:    loss.DelegationAmt,
+			UnbondingAmt:     loss.UnbondingAmt,
+			SideChainId:      sr.SideChainId,
+		})
+	}
+}
+
+func (k Keeper) setDelegatorSlashRecord(ctx sdk.Context, record DelegatorSlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(GetDelegatorSlashRecordKey(record.DelegatorAddr, record.ConsAddr, record.InfractionType, record.InfractionHeight), bz)
+}
+
+// getDelegatorSlashRecordsPage returns a page of the slash records of the delegator in the order of the consensus
+// addresses, the infraction types and the infraction heights
+func (k Keeper) getDelegatorSlashRecordsPage(ctx sdk.Context, delAddr sdk.AccAddress, page sdk.PageRequest) (records []DelegatorSlashRecord, nextKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+	records = make([]DelegatorSlashRecord, 0)
+	nextKey = sdk.Paginate(store, GetDelegatorSlashRecordsKey(delAddr), page, func(_, value []byte) bool {
+		var record DelegatorSlashRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &record)
+		records = append(records, record)
+		return true
+	})
+	return
+}
@@ -0,0 +1,271 @@
+package slashing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/bsc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SideChainHeader is a block header of a side chain decoded by the EvidenceVerifier of the side chain
+type SideChainHeader interface {
+	GetHeight() uint64
+	// GetTime returns the block time in unix seconds
+	GetTime() uint64
+	// ExtractSigner returns the consensus address of the validator signed the block on the side chain
+	ExtractSigner() ([]byte, error)
+	GetHash() []byte
+	GetParentHash() []byte
+}
+
+// LightClient tracks the canonical headers of the side chains, the evidence of the side chains it tracks
+// is checked against the headers it trusts besides the signatures
+type LightClient interface {
+	// IsRegistered returns whether the side chain is registered to be tracked, the evidence of a registered
+	// side chain is rejected while it is not tracked
+	IsRegistered(ctx sdk.Context, sideChainId string) bool
+	IsTracking(ctx sdk.Context, sideChainId string) bool
+	TrustedHeaderHash(ctx sdk.Context, sideChainId string, height uint64) ([]byte, bool)
+	// ValidatorSetAt returns the sorted consensus addresses of the validator set in effect at the height
+	// and the height it takes effect from
+	ValidatorSetAt(ctx sdk.Context, sideChainId string, height uint64) (from uint64, validators [][]byte, found bool)
+}
+
+// SetLightClient sets the light client consulted by the evidence handlers after the BscLightClient upgrade
+func (k *Keeper) SetLightClient(lightClient LightClient) {
+	k.lightClient = lightClient
+}
+
+func (k Keeper) isTrackedByLightClient(ctx sdk.Context, sideChainId string) bool {
+	return sdk.IsUpgrade(sdk.BscLightClient) && k.lightClient != nil && k.lightClient.IsTracking(ctx, sideChainId)
+}
+
+// checkTrustedHeaders checks the headers are the canonical headers trusted by the light client,
+// or built on the canonical headers if onlyParent is true. It fails closed for a side chain registered
+// to the light client but not tracked yet.
+func (k Keeper) checkTrustedHeaders(ctx sdk.Context, sideChainId string, headers []SideChainHeader, onlyParent bool) error {
+	if !k.isTrackedByLightClient(ctx, sideChainId) {
+		if sdk.IsUpgrade(sdk.BscLightClient) && k.lightClient != nil && k.lightClient.IsRegistered(ctx, sideChainId) {
+			return fmt.Errorf("side chain %s is registered but not tracked by the light client", sideChainId)
+		}
+		return nil
+	}
+	for _, header := range headers {
+		height, hash := header.GetHeight(), header.GetHash()
+		if onlyParent {
+			if height == 0 {
+				return errors.New("the genesis block has no parent")
+			}
+			height, hash = height-1, header.GetParentHash()
+		}
+		trusted, found := k.lightClient.TrustedHeaderHash(ctx, sideChainId, height)
+		if !found {
+			return fmt.Errorf("block %d is not trusted by the light client yet", height)
+		}
+		if !bytes.Equal(trusted, hash) {
+			return fmt.Errorf("block %d is not the canonical block trusted by the light client", height)
+		}
+	}
+	return nil
+}
+
+// EvidenceVerifier decodes and checks the double sign evidence of a side chain in its own header format,
+// it is registered for each side chain by Keeper.RegisterEvidenceVerifier
+type EvidenceVerifier interface {
+	DecodeHeader(bz []byte) (SideChainHeader, error)
+	// VerifyDoubleSign checks the two headers are two different blocks at the same height,
+	// the signers of them are checked by the handler
+	VerifyDoubleSign(header1, header2 SideChainHeader) error
+}
+
+// DowntimeEvidenceVerifier is implemented by the EvidenceVerifier of the side chains which support downtime evidence
+type DowntimeEvidenceVerifier interface {
+	// VerifyDowntime checks the consecutive headers prove the validator of sideConsAddr missed its turn to sign a block,
+	// validators are the side chain consensus addresses of the recorded side validator set.
+	// It returns the height of the block the validator should have signed.
+	VerifyDowntime(headers []SideChainHeader, validators [][]byte, sideConsAddr []byte) (uint64, error)
+}
+
+// RegisterEvidenceVerifier registers the EvidenceVerifier of a side chain, the BSC one is used for
+// the BscSideChainId by default
+func (k *Keeper) RegisterEvidenceVerifier(sideChainId string, verifier EvidenceVerifier) {
+	if _, ok := k.evidenceVerifiers[sideChainId]; ok {
+		panic(fmt.Sprintf("duplicated evidence verifier for side chain %s", sideChainId))
+	}
+	k.evidenceVerifiers[sideChainId] = verifier
+}
+
+func (k *Keeper) getEvidenceVerifier(sideChainId string, bscSideChainId string) EvidenceVerifier {
+	if verifier, ok := k.evidenceVerifiers[sideChainId]; ok {
+		return verifier
+	}
+	if sideChainId == bscSideChainId {
+		return BscEvidenceVerifier{}
+	}
+	return nil
+}
+
+//__________________________________________________________________
+
+type bscHeader struct {
+	*bsc.Header
+}
+
+func (h bscHeader) GetHeight() uint64 {
+	return uint64(h.Number)
+}
+
+func (h bscHeader) GetTime() uint64 {
+	return h.Time
+}
+
+func (h bscHeader) GetHash() []byte {
+	return h.Hash().Bytes()
+}
+
+func (h bscHeader) GetParentHash() []byte {
+	return h.ParentHash.Bytes()
+}
+
+func (h bscHeader) ExtractSigner() ([]byte, error) {
+	signer, err := h.ExtractSignerFromHeader()
+	if err != nil {
+		return nil, err
+	}
+	return signer.Bytes(), nil
+}
+
+// BscEvidenceVerifier verifies the double sign evidence of BSC, the headers are in the JSON format of the BSC RPC
+type BscEvidenceVerifier struct{}
+
+var _ EvidenceVerifier = BscEvidenceVerifier{}
+var _ DowntimeEvidenceVerifier = BscEvidenceVerifier{}
+
+func (BscEvidenceVerifier) DecodeHeader(bz []byte) (SideChainHeader, error) {
+	var header bsc.Header
+	if err := json.Unmarshal(bz, &header); err != nil {
+		return nil, err
+	}
+	return bscHeader{&header}, nil
+}
+
+func (BscEvidenceVerifier) VerifyDoubleSign(header1, header2 SideChainHeader) error {
+	h1, ok1 := header1.(bscHeader)
+	h2, ok2 := header2.(bscHeader)
+	if !ok1 || !ok2 {
+		return errors.New("not bsc headers")
+	}
+	return verifyBscDoubleSign(h1.Header, h2.Header)
+}
+
+// VerifyDowntime follows the in-turn rule of Parlia: the validators sorted by address take turns to sign the blocks,
+// and a block signed out of turn has the difficulty of diffNoTurn.
+func (BscEvidenceVerifier) VerifyDowntime(headers []SideChainHeader, validators [][]byte, sideConsAddr []byte) (uint64, error) {
+	if len(validators) == 0 {
+		return 0, errors.New("the side validator set is empty")
+	}
+	sorted := make([][]byte, len(validators))
+	copy(sorted, validators)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+	inSet := func(addr []byte) bool {
+		for _, val := range sorted {
+			if bytes.Equal(val, addr) {
+				return true
+			}
+		}
+		return false
+	}
+	if !inSet(sideConsAddr) {
+		return 0, errors.New("the validator is not in the recorded side validator set")
+	}
+
+	var missedHeight uint64
+	var missed bool
+	var parent *bsc.Header
+	for _, header := range headers {
+		h, ok := header.(bscHeader)
+		if !ok {
+			return 0, errors.New("not bsc headers")
+		}
+		if err := headerEmptyCheck(h.Header); err != nil {
+			return 0, err
+		}
+		if parent != nil {
+			if h.Number != parent.Number+1 || h.ParentHash.Cmp(parent.Hash()) != 0 {
+				return 0, fmt.Errorf("block %d is not the child of block %d", h.Number, parent.Number)
+			}
+		}
+		parent = h.Header
+
+		signer, err := h.ExtractSigner()
+		if err != nil {
+			return 0, fmt.Errorf("Failed to extract signer from block header, %s", err.Error())
+		}
+		if !inSet(signer) {
+			return 0, fmt.Errorf("block %d is not signed by the recorded side validator set", h.Number)
+		}
+		if bytes.Equal(signer, sideConsAddr) {
+			return 0, fmt.Errorf("block %d is signed by the validator", h.Number)
+		}
+		if !missed && bytes.Equal(sorted[uint64(h.Number)%uint64(len(sorted))], sideConsAddr) {
+			if h.Difficulty != diffNoTurn {
+				return 0, fmt.Errorf("block %d is signed out of turn but its difficulty is %d", h.Number, h.Difficulty)
+			}
+			missedHeight = uint64(h.Number)
+			missed = true
+		}
+	}
+	if !missed {
+		return 0, errors.New("the headers do not include the turn of the validator")
+	}
+	return missedHeight, nil
+}
+
+// diffNoTurn is the block difficulty of Parlia for the blocks signed out of turn
+const diffNoTurn = 1
+
+func verifyBscDoubleSign(header1, header2 *bsc.Header) error {
+	if err := headerEmptyCheck(header1); err != nil {
+		return err
+	}
+	if err := headerEmptyCheck(header2); err != nil {
+		return err
+	}
+	if header1.Number != header2.Number {
+		return errors.New("The numbers of two block headers are not the same")
+	}
+	if header1.ParentHash.Cmp(header2.ParentHash) != 0 {
+		return errors.New("The parent hash of two block headers are not the same")
+	}
+	signature1, err := header1.GetSignature()
+	if err != nil {
+		return fmt.Errorf("Failed to get signature from block header, %s", err.Error())
+	}
+	signature2, err := header2.GetSignature()
+	if err != nil {
+		return fmt.Errorf("Failed to get signature from block header, %s", err.Error())
+	}
+	if bytes.Compare(signature1, signature2) == 0 {
+		return errors.New("The two blocks are the same")
+	}
+	return nil
+}
+
+func headerEmptyCheck(header *bsc.Header) error {
+	if header.Number == 0 {
+		return errors.New("header number can not be zero ")
+	}
+	if header.Difficulty == 0 {
+		return errors.New("header difficulty can not be zero")
+	}
+	if header.Extra == nil {
+		return errors.New("header extra can not be empty")
+	}
+	return nil
+}
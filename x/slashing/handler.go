@@ -12,6 +12,10 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSideChainUnjail(ctx, msg, k)
 		case MsgBscSubmitEvidence:
 			return handleMsgBscSubmitEvidence(ctx, msg, k)
+		case MsgSideChainSubmitEvidence:
+			return handleMsgSideChainSubmitEvidence(ctx, msg, k)
+		case MsgSideChainSubmitDowntimeEvidence:
+			return handleMsgSideChainSubmitDowntimeEvidence(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
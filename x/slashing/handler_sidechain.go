@@ -11,52 +11,149 @@ import (
 
 func handleMsgBscSubmitEvidence(ctx sdk.Context, msg MsgBscSubmitEvidence, k Keeper) sdk.Result {
 	sideChainId := k.ScKeeper.BscSideChainId(ctx)
+	return handleDoubleSignEvidence(ctx, k, msg.Submitter, sideChainId, bscHeader{&msg.Headers[0]}, bscHeader{&msg.Headers[1]})
+}
+
+func handleMsgSideChainSubmitEvidence(ctx sdk.Context, msg MsgSideChainSubmitEvidence, k Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.SideChainEvidence) {
+		return sdk.ErrMsgNotSupported("MsgSideChainSubmitEvidence is not supported before the SideChainEvidence upgrade").Result()
+	}
+
+	verifier := k.getEvidenceVerifier(msg.SideChainId, k.ScKeeper.BscSideChainId(ctx))
+	if verifier == nil {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("no evidence verifier for side chain %s", msg.SideChainId)).Result()
+	}
+	header1, err := verifier.DecodeHeader(msg.Headers[0])
+	if err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("Failed to decode block header, %s", err.Error())).Result()
+	}
+	header2, err := verifier.DecodeHeader(msg.Headers[1])
+	if err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("Failed to decode block header, %s", err.Error())).Result()
+	}
+	if err := verifier.VerifyDoubleSign(header1, header2); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error()).Result()
+	}
+	return handleDoubleSignEvidence(ctx, k, msg.Submitter, msg.SideChainId, header1, header2)
+}
+
+// handleDoubleSignEvidence slashes the validator signed the two headers, which have been checked by the EvidenceVerifier
+func handleDoubleSignEvidence(ctx sdk.Context, k Keeper, submitter sdk.AccAddress, sideChainId string, header1, header2 SideChainHeader) sdk.Result {
 	sideCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, sideChainId)
 	if err != nil {
 		return ErrInvalidSideChainId(DefaultCodespace).Result()
 	}
 
-	header := ctx.BlockHeader()
-	sideConsAddr, err := msg.Headers[0].ExtractSignerFromHeader()
+	sideConsAddr, err := header1.ExtractSigner()
 	if err != nil {
 		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("Failed to extract signer from block header, %s", err.Error())).Result()
 	}
-	sideConsAddr2, err := msg.Headers[1].ExtractSignerFromHeader()
+	sideConsAddr2, err := header2.ExtractSigner()
 	if err != nil {
 		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("Failed to extract signer from block header, %s", err.Error())).Result()
 	}
-	if bytes.Compare(sideConsAddr.Bytes(), sideConsAddr2.Bytes()) != 0 {
+	if bytes.Compare(sideConsAddr, sideConsAddr2) != 0 {
 		return ErrInvalidEvidence(DefaultCodespace, "The signers of two block headers are not the same").Result()
 	}
+	// the two blocks should be built on the canonical chain
+	if err := k.checkTrustedHeaders(ctx, sideChainId, []SideChainHeader{header1, header2}, true); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error()).Result()
+	}
 
-	if k.hasSlashRecord(sideCtx, sideConsAddr.Bytes(), DoubleSign, uint64(msg.Headers[0].Number)) {
+	infractionHeight := header1.GetHeight()
+	if k.hasSlashRecord(sideCtx, sideConsAddr, DoubleSign, infractionHeight) {
 		return ErrEvidenceHasBeenHandled(k.Codespace).Result()
 	}
 
 	//verify evidence age
-	evidenceTime := msg.Headers[0].Time
-	if msg.Headers[0].Time < msg.Headers[1].Time {
-		evidenceTime = msg.Headers[1].Time
+	evidenceTime := header1.GetTime()
+	if header1.GetTime() < header2.GetTime() {
+		evidenceTime = header2.GetTime()
 	}
 	age := sideCtx.BlockHeader().Time.Sub(time.Unix(int64(evidenceTime), 0))
 	if age > k.MaxEvidenceAge(sideCtx) {
 		return ErrExpiredEvidence(k.Codespace).Result()
 	}
 
-	slashAmount := k.DoubleSignSlashAmount(sideCtx)
-	validator, slashedAmount, slashErr := k.validatorSet.SlashSideChain(ctx, sideChainId, sideConsAddr.Bytes(), sdk.NewDec(slashAmount))
+	return slashSideValidatorByEvidence(ctx, sideCtx, k, submitter, sideChainId, sideConsAddr, DoubleSign, infractionHeight,
+		k.DoubleSignSlashAmount(sideCtx), k.SubmitterReward(sideCtx), k.DoubleSignUnbondDuration(sideCtx), "side_double_sign_slash")
+}
+
+func handleMsgSideChainSubmitDowntimeEvidence(ctx sdk.Context, msg MsgSideChainSubmitDowntimeEvidence, k Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.SideChainDowntime) {
+		return sdk.ErrMsgNotSupported("MsgSideChainSubmitDowntimeEvidence is not supported before the SideChainDowntime upgrade").Result()
+	}
+
+	verifier := k.getEvidenceVerifier(msg.SideChainId, k.ScKeeper.BscSideChainId(ctx))
+	downtimeVerifier, ok := verifier.(DowntimeEvidenceVerifier)
+	if !ok {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("no downtime evidence verifier for side chain %s", msg.SideChainId)).Result()
+	}
+	sideCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, msg.SideChainId)
+	if err != nil {
+		return ErrInvalidSideChainId(DefaultCodespace).Result()
+	}
+
+	headers := make([]SideChainHeader, 0, len(msg.Headers))
+	for _, bz := range msg.Headers {
+		header, err := verifier.DecodeHeader(bz)
+		if err != nil {
+			return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("Failed to decode block header, %s", err.Error())).Result()
+		}
+		headers = append(headers, header)
+	}
+
+	// a header signed out of turn is valid on its own, so only the canonical headers trusted by the light client,
+	// whose signers have been verified against the validator set of their epoch, can prove a missed turn
+	if !k.isTrackedByLightClient(ctx, msg.SideChainId) {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("side chain %s is not tracked by the light client", msg.SideChainId)).Result()
+	}
+	if err := k.checkTrustedHeaders(ctx, msg.SideChainId, headers, false); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error()).Result()
+	}
+	from, validators, found := k.lightClient.ValidatorSetAt(ctx, msg.SideChainId, headers[0].GetHeight())
+	if !found {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("no validator set of block %d", headers[0].GetHeight())).Result()
+	}
+	if lastFrom, _, _ := k.lightClient.ValidatorSetAt(ctx, msg.SideChainId, headers[len(headers)-1].GetHeight()); lastFrom != from {
+		return ErrInvalidEvidence(DefaultCodespace, "the headers cross a validator set change").Result()
+	}
+	infractionHeight, err := downtimeVerifier.VerifyDowntime(headers, validators, msg.SideConsAddr)
+	if err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error()).Result()
+	}
+
+	if k.hasSlashRecord(sideCtx, msg.SideConsAddr, DowntimeEvidence, infractionHeight) {
+		return ErrEvidenceHasBeenHandled(k.Codespace).Result()
+	}
+
+	//verify evidence age by the latest header
+	evidenceTime := headers[len(headers)-1].GetTime()
+	age := sideCtx.BlockHeader().Time.Sub(time.Unix(int64(evidenceTime), 0))
+	if age > k.MaxEvidenceAge(sideCtx) {
+		return ErrExpiredEvidence(k.Codespace).Result()
+	}
+
+	return slashSideValidatorByEvidence(ctx, sideCtx, k, msg.Submitter, msg.SideChainId, msg.SideConsAddr, DowntimeEvidence, infractionHeight,
+		k.DowntimeSlashAmount(sideCtx), k.DowntimeSlashFee(sideCtx), k.DowntimeUnbondDuration(sideCtx), "side_downtime_evidence_slash")
+}
+
+// slashSideValidatorByEvidence slashes and jails the side chain validator, rewards the submitter of the evidence and records the SlashRecord
+func slashSideValidatorByEvidence(ctx, sideCtx sdk.Context, k Keeper, submitter sdk.AccAddress, sideChainId string, sideConsAddr []byte,
+	infractionType byte, infractionHeight uint64, slashAmount, submitterReward int64, jailDuration time.Duration, feeName string) sdk.Result {
+	header := ctx.BlockHeader()
+	validator, slashedAmount, losses, slashErr := k.validatorSet.SlashSideChain(ctx, sideChainId, sideConsAddr, sdk.NewDec(slashAmount))
 	if slashErr != nil {
 		return ErrFailedToSlash(k.Codespace, slashErr.Error()).Result()
 	}
 
 	bondDenom := k.validatorSet.BondDenom(sideCtx)
-	submitterReward := k.SubmitterReward(sideCtx)
 	submitterRewardReal := sdk.MinInt64(slashedAmount.RawInt(), submitterReward)
 	submitterRewardCoin := sdk.NewCoin(bondDenom, submitterRewardReal)
 
 	if submitterRewardReal > 0 {
-		submitterBalance := k.BankKeeper.GetCoins(ctx, msg.Submitter)
-		if err := k.BankKeeper.SetCoins(ctx, msg.Submitter, submitterBalance.Plus(sdk.Coins{submitterRewardCoin})); err != nil {
+		submitterBalance := k.BankKeeper.GetCoins(ctx, submitter)
+		if err := k.BankKeeper.SetCoins(ctx, submitter, submitterBalance.Plus(sdk.Coins{submitterRewardCoin})); err != nil {
 			return ErrFailedToSlash(k.Codespace, err.Error()).Result()
 		}
 	}
@@ -65,49 +162,53 @@ func handleMsgBscSubmitEvidence(ctx sdk.Context, msg MsgBscSubmitEvidence, k Kee
 	var toFeePool int64
 	var validatorsCompensation map[string]int64
 	var found bool
+	var err error
 	if remainingReward > 0 {
-		found, validatorsCompensation, err = k.validatorSet.AllocateSlashAmtToValidators(sideCtx, sideConsAddr.Bytes(), sdk.NewDec(remainingReward))
+		found, validatorsCompensation, err = k.validatorSet.AllocateSlashAmtToValidators(sideCtx, sideConsAddr, sdk.NewDec(remainingReward))
 		if err != nil {
 			return ErrFailedToSlash(k.Codespace, err.Error()).Result()
 		}
 		if !found && ctx.IsDeliverTx() { // if the related validators are not found, the amount will be added to fee pool
 			toFeePool = remainingReward
 			remainingCoin := sdk.NewCoin(bondDenom, remainingReward)
-			fees.Pool.AddAndCommitFee("side_double_sign_slash", sdk.NewFee(sdk.Coins{remainingCoin}, sdk.FeeForAll))
+			fees.Pool.AddAndCommitFee(feeName, sdk.NewFee(sdk.Coins{remainingCoin}, sdk.FeeForAll))
 		}
 	}
 
-	jailUntil := header.Time.Add(k.DoubleSignUnbondDuration(sideCtx))
+	jailUntil := header.Time.Add(jailDuration)
 	sr := SlashRecord{
-		ConsAddr:         sideConsAddr.Bytes(),
-		InfractionType:   DoubleSign,
-		InfractionHeight: uint64(msg.Headers[0].Number),
+		ConsAddr:         sideConsAddr,
+		InfractionType:   infractionType,
+		InfractionHeight: infractionHeight,
 		SlashHeight:      header.Height,
 		JailUntil:        jailUntil,
 		SlashAmt:         slashedAmount.RawInt(),
 		SideChainId:      sideChainId,
 	}
 	k.setSlashRecord(sideCtx, sr)
+	if sdk.IsUpgrade(sdk.DelegatorSlashRecord) {
+		k.setDelegatorSlashRecords(sideCtx, sr, validator, losses)
+	}
 
 	// Set or updated validator jail duration
-	signInfo, found := k.getValidatorSigningInfo(sideCtx, sideConsAddr.Bytes())
+	signInfo, found := k.getValidatorSigningInfo(sideCtx, sideConsAddr)
 	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", sideConsAddr.Hex()))
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", sdk.HexEncode(sideConsAddr)))
 	}
 	signInfo.JailedUntil = jailUntil
-	k.setValidatorSigningInfo(sideCtx, sideConsAddr.Bytes(), signInfo)
+	k.setValidatorSigningInfo(sideCtx, sideConsAddr, signInfo)
 
 	if ctx.IsDeliverTx() && k.PbsbServer != nil {
 		event := SideSlashEvent{
 			Validator:              validator.GetOperator(),
-			InfractionType:         DoubleSign,
-			InfractionHeight:       msg.Headers[0].Number,
+			InfractionType:         infractionType,
+			InfractionHeight:       int64(infractionHeight),
 			SlashHeight:            header.Height,
 			JailUtil:               jailUntil,
 			SlashAmt:               slashedAmount.RawInt(),
 			SideChainId:            sideChainId,
 			ToFeePool:              toFeePool,
-			Submitter:              msg.Submitter,
+			Submitter:              submitter,
 			SubmitterReward:        submitterRewardReal,
 			ValidatorsCompensation: validatorsCompensation,
 		}
@@ -1,11 +1,16 @@
 package slashing
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"math"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
+
 	"github.com/cosmos/cosmos-sdk/bsc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/fees"
@@ -15,6 +20,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// two bsc headers at height 1 signed by 0x625448c3f21AB4636bBCef84Baaf8D6cCdE13c3F
+const testBscEvidenceJson = `[{"parentHash":"0x6116de25352c93149542e950162c7305f207bbc17b0eb725136b78c80aed79cc","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","miner":"0x0000000000000000000000000000000000000000","stateRoot":"0xe7cb9d2fd449f7bd11126bff55266e7b74936f2f230e21d44d75c04b7780dfeb","transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","difficulty":"0x20000","number":"0x1","gasLimit":"0x47e7c4","gasUsed":"0x0","timestamp":"0x5ea6a002","extraData":"0x0000000000000000000000000000000000000000000000000000000000000000bb4a77b57c2a82de97b557442883ee19d481a415fc76d3833de83ba37f2d8674375f85fd96affd603244e3448a2b101c40511aa18ce8c1edf4e940dec648ac1300","mixHash":"0x0000000000000000000000000000000000000000000000000000000000000000","nonce":"0x0000000000000000","hash":"0x1532065752393ff2f6e7ef9b64f80d6e10efe42a4d9bdd8149fcbac6f86b365b"},{"parentHash":"0x6116de25352c93149542e950162c7305f207bbc17b0eb725136b78c80aed79cc","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","miner":"0x0000000000000000000000000000000000000000","stateRoot":"0xe7cb9d2fd449f7bd11126bff55266e7b74936f2f230e21d44d75c04b7780dfeb","transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","difficulty":"0x20000","number":"0x1","gasLimit":"0x47e7c4","gasUsed":"0x64","timestamp":"0x5ea6a002","extraData":"0x000000000000000000000000000000000000000000000000000000000000000055a9a47820e18c025d0b98a722c3fb83d28e4547e0090cbe5cc17683b7f25d5e18c6e359631ec10d9c08ceaafc9e9847de3de18694d073af9515638eee73c58e00","mixHash":"0x0000000000000000000000000000000000000000000000000000000000000000","nonce":"0x0000000000000000","hash":"0x811a42453f826f05e9d85998551636f59eb740d5b03fe2416700058a4f31ca1e"}]`
+
 func TestSideChainSlashDoubleSign(t *testing.T) {
 	slashParams := DefaultParams()
 	slashParams.DoubleSignUnbondDuration = 5 * time.Second
@@ -50,7 +58,7 @@ func TestSideChainSlashDoubleSign(t *testing.T) {
 
 	ctx = ctx.WithBlockHeight(300)
 	headers := make([]bsc.Header, 0)
-	headersJson := `[{"parentHash":"0x6116de25352c93149542e950162c7305f207bbc17b0eb725136b78c80aed79cc","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","miner":"0x0000000000000000000000000000000000000000","stateRoot":"0xe7cb9d2fd449f7bd11126bff55266e7b74936f2f230e21d44d75c04b7780dfeb","transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","difficulty":"0x20000","number":"0x1","gasLimit":"0x47e7c4","gasUsed":"0x0","timestamp":"0x5ea6a002","extraData":"0x0000000000000000000000000000000000000000000000000000000000000000bb4a77b57c2a82de97b557442883ee19d481a415fc76d3833de83ba37f2d8674375f85fd96affd603244e3448a2b101c40511aa18ce8c1edf4e940dec648ac1300","mixHash":"0x0000000000000000000000000000000000000000000000000000000000000000","nonce":"0x0000000000000000","hash":"0x1532065752393ff2f6e7ef9b64f80d6e10efe42a4d9bdd8149fcbac6f86b365b"},{"parentHash":"0x6116de25352c93149542e950162c7305f207bbc17b0eb725136b78c80aed79cc","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","miner":"0x0000000000000000000000000000000000000000","stateRoot":"0xe7cb9d2fd449f7bd11126bff55266e7b74936f2f230e21d44d75c04b7780dfeb","transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","difficulty":"0x20000","number":"0x1","gasLimit":"0x47e7c4","gasUsed":"0x64","timestamp":"0x5ea6a002","extraData":"0x000000000000000000000000000000000000000000000000000000000000000055a9a47820e18c025d0b98a722c3fb83d28e4547e0090cbe5cc17683b7f25d5e18c6e359631ec10d9c08ceaafc9e9847de3de18694d073af9515638eee73c58e00","mixHash":"0x0000000000000000000000000000000000000000000000000000000000000000","nonce":"0x0000000000000000","hash":"0x811a42453f826f05e9d85998551636f59eb740d5b03fe2416700058a4f31ca1e"}]`
+	headersJson := testBscEvidenceJson
 	err = json.Unmarshal([]byte(headersJson), &headers)
 	require.Nil(t, err)
 
@@ -156,10 +164,13 @@ func TestSideChainSlashDoubleSignUBD(t *testing.T) {
 
 	ctx = ctx.WithBlockHeight(201)
 	headers := make([]bsc.Header, 0)
-	headersJson := `[{"parentHash":"0x6116de25352c93149542e950162c7305f207bbc17b0eb725136b78c80aed79cc","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","miner":"0x0000000000000000000000000000000000000000","stateRoot":"0xe7cb9d2fd449f7bd11126bff55266e7b74936f2f230e21d44d75c04b7780dfeb","transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","difficulty":"0x20000","number":"0x1","gasLimit":"0x47e7c4","gasUsed":"0x0","timestamp":"0x5ea6a002","extraData":"0x0000000000000000000000000000000000000000000000000000000000000000bb4a77b57c2a82de97b557442883ee19d481a415fc76d3833de83ba37f2d8674375f85fd96affd603244e3448a2b101c40511aa18ce8c1edf4e940dec648ac1300","mixHash":"0x0000000000000000000000000000000000000000000000000000000000000000","nonce":"0x0000000000000000","hash":"0x1532065752393ff2f6e7ef9b64f80d6e10efe42a4d9bdd8149fcbac6f86b365b"},{"parentHash":"0x6116de25352c93149542e950162c7305f207bbc17b0eb725136b78c80aed79cc","sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347","miner":"0x0000000000000000000000000000000000000000","stateRoot":"0xe7cb9d2fd449f7bd11126bff55266e7b74936f2f230e21d44d75c04b7780dfeb","transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","receiptsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","difficulty":"0x20000","number":"0x1","gasLimit":"0x47e7c4","gasUsed":"0x64","timestamp":"0x5ea6a002","extraData":"0x000000000000000000000000000000000000000000000000000000000000000055a9a47820e18c025d0b98a722c3fb83d28e4547e0090cbe5cc17683b7f25d5e18c6e359631ec10d9c08ceaafc9e9847de3de18694d073af9515638eee73c58e00","mixHash":"0x0000000000000000000000000000000000000000000000000000000000000000","nonce":"0x0000000000000000","hash":"0x811a42453f826f05e9d85998551636f59eb740d5b03fe2416700058a4f31ca1e"}]`
+	headersJson := testBscEvidenceJson
 	err = json.Unmarshal([]byte(headersJson), &headers)
 	require.Nil(t, err)
 
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.DelegatorSlashRecord, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.DelegatorSlashRecord, 0)
+
 	feesInPoolBefore := fees.Pool.BlockFees().Tokens.AmountOf("steak")
 	msgSubmitEvidence := NewMsgBscSubmitEvidence(submitter, headers)
 	got = NewHandler(keeper)(ctx, msgSubmitEvidence)
@@ -175,6 +186,17 @@ func TestSideChainSlashDoubleSignUBD(t *testing.T) {
 	require.True(t, found)
 	require.EqualValues(t, 4000e8, ubd.Balance.Amount)
 
+	// the self delegator lost the whole delegation and a part of the unbonding delegation
+	delegatorSlashRecords, nextKey := keeper.getDelegatorSlashRecordsPage(sideCtx, sdk.AccAddress(mValAddr), sdk.PageRequest{})
+	require.Nil(t, nextKey)
+	require.Len(t, delegatorSlashRecords, 1)
+	require.EqualValues(t, mValAddr, delegatorSlashRecords[0].ValidatorAddr)
+	require.EqualValues(t, mSideConsAddr, delegatorSlashRecords[0].ConsAddr)
+	require.EqualValues(t, DoubleSign, delegatorSlashRecords[0].InfractionType)
+	require.EqualValues(t, 5000e8, delegatorSlashRecords[0].DelegationAmt)
+	require.EqualValues(t, 1000e8, delegatorSlashRecords[0].UnbondingAmt)
+	require.EqualValues(t, slashParams.DoubleSignSlashAmount, delegatorSlashRecords[0].LossAmt())
+
 	submitterBalance := bankKeeper.GetCoins(ctx, submitter).AmountOf("steak")
 	require.EqualValues(t, initCoins+slashParams.SubmitterReward, submitterBalance)
 
@@ -184,3 +206,375 @@ func TestSideChainSlashDoubleSignUBD(t *testing.T) {
 	require.EqualValues(t, 4000e8, stakingPoolBalance)
 
 }
+
+type testSideHeader struct {
+	Height uint64 `json:"height"`
+	Time   uint64 `json:"time"`
+	Signer string `json:"signer"`
+	Block  string `json:"block"`
+}
+
+func (h testSideHeader) GetHeight() uint64     { return h.Height }
+func (h testSideHeader) GetTime() uint64       { return h.Time }
+func (h testSideHeader) GetHash() []byte       { return []byte(h.Block) }
+func (h testSideHeader) GetParentHash() []byte { return nil }
+func (h testSideHeader) ExtractSigner() ([]byte, error) {
+	return sdk.HexDecode(h.Signer)
+}
+
+// testEvidenceVerifier trusts the signer in the header, it is only to test the verifiers are pluggable
+type testEvidenceVerifier struct{}
+
+func (testEvidenceVerifier) DecodeHeader(bz []byte) (SideChainHeader, error) {
+	var header testSideHeader
+	err := json.Unmarshal(bz, &header)
+	return header, err
+}
+
+func (testEvidenceVerifier) VerifyDoubleSign(header1, header2 SideChainHeader) error {
+	if header1.GetHeight() != header2.GetHeight() {
+		return errors.New("different heights")
+	}
+	if header1.(testSideHeader).Block == header2.(testSideHeader).Block {
+		return errors.New("same blocks")
+	}
+	return nil
+}
+
+func TestSideChainSubmitEvidence(t *testing.T) {
+	slashParams := DefaultParams()
+	slashParams.MaxEvidenceAge = math.MaxInt64
+	slashParams.DoubleSignSlashAmount = 1000e8
+	slashParams.SubmitterReward = 100e8
+	submitter := sdk.AccAddress(addrs[2])
+	ctx, sideCtx, _, stakeKeeper, _, keeper := createSideTestInput(t, slashParams)
+
+	ctx = ctx.WithBlockHeight(100)
+	mValAddr := addrs[0]
+	mSideConsAddr, err := sdk.HexDecode("0x625448c3f21AB4636bBCef84Baaf8D6cCdE13c3F")
+	require.Nil(t, err)
+	msgCreateVal := newTestMsgCreateSideValidator(mValAddr, mSideConsAddr, createSideAddr(20), 10000e8)
+	got := stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, msgCreateVal)
+	require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	stake.EndBreatheBlock(ctx, stakeKeeper)
+	ctx = ctx.WithBlockHeight(200)
+
+	var rawHeaders []json.RawMessage
+	require.Nil(t, json.Unmarshal([]byte(testBscEvidenceJson), &rawHeaders))
+	msg := NewMsgSideChainSubmitEvidence(submitter, "bsc", [][]byte{rawHeaders[0], rawHeaders[1]})
+	require.Nil(t, msg.ValidateBasic())
+
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeMsgNotSupported), got.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainEvidence, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainEvidence, 0)
+
+	// the bsc evidence verifier is used for the bsc side chain by default
+	got = NewHandler(keeper)(ctx, msg)
+	require.True(t, got.IsOK(), "expected submit evidence msg to be ok, got: %v", got)
+	_, found := keeper.getSlashRecord(sideCtx, mSideConsAddr, DoubleSign, 1)
+	require.True(t, found)
+
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeHandledEvidence), got.Code)
+
+	// no evidence verifier for the side chain
+	msg.SideChainId = "tmc"
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	// the registered verifier replaces the default one
+	keeper.RegisterEvidenceVerifier("bsc", testEvidenceVerifier{})
+	require.Panics(t, func() { keeper.RegisterEvidenceVerifier("bsc", testEvidenceVerifier{}) })
+	header1 := `{"height":5,"time":1588000000,"signer":"0x625448c3f21AB4636bBCef84Baaf8D6cCdE13c3F","block":"a"}`
+	header2 := `{"height":5,"time":1588000000,"signer":"0x625448c3f21AB4636bBCef84Baaf8D6cCdE13c3F","block":"b"}`
+	msg = NewMsgSideChainSubmitEvidence(submitter, "bsc", [][]byte{[]byte(header1), []byte(header1)})
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = NewMsgSideChainSubmitEvidence(submitter, "bsc", [][]byte{[]byte(header1), []byte(`{"height":6,"block":"b"}`)})
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	msg = NewMsgSideChainSubmitEvidence(submitter, "bsc", [][]byte{[]byte(header1), []byte(header2)})
+	got = NewHandler(keeper)(ctx, msg)
+	require.True(t, got.IsOK(), "expected submit evidence msg to be ok, got: %v", got)
+	slashRecord, found := keeper.getSlashRecord(sideCtx, mSideConsAddr, DoubleSign, 5)
+	require.True(t, found)
+	require.Equal(t, "bsc", slashRecord.SideChainId)
+}
+
+func testBscAddress(key *btcec.PrivateKey) []byte {
+	return bsc.Keccak256(key.PubKey().SerializeUncompressed()[1:])[12:]
+}
+
+// genTestBscHeaders generates the consecutive bsc headers from the height, the blocks are signed in turn by the
+// validators sorted by address, except that the turns of the missed validator are taken by the next validator
+func genTestBscHeaders(t *testing.T, keys []*btcec.PrivateKey, missed []byte, height int64, count int) [][]byte {
+	sorted := make([]*btcec.PrivateKey, len(keys))
+	copy(sorted, keys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(testBscAddress(sorted[i]), testBscAddress(sorted[j])) < 0
+	})
+
+	res := make([][]byte, 0, count)
+	var parent *bsc.Header
+	for i := 0; i < count; i++ {
+		header := &bsc.Header{
+			Number:     height + int64(i),
+			Difficulty: 2,
+			Time:       uint64(time.Now().Unix()),
+			Extra:      make([]byte, 32+65),
+		}
+		if parent != nil {
+			header.ParentHash = parent.Hash()
+		}
+		signer := sorted[header.Number%int64(len(sorted))]
+		if bytes.Equal(testBscAddress(signer), missed) {
+			signer = sorted[(header.Number+1)%int64(len(sorted))]
+			header.Difficulty = 1
+		}
+		sig, err := btcec.SignCompact(btcec.S256(), signer, bsc.SealHash(header).Bytes(), false)
+		require.Nil(t, err)
+		// convert the [v || r || s] of btcec to the [r || s || v] of bsc
+		copy(header.Extra[32:], append(sig[1:], sig[0]-27))
+
+		bz, err := json.Marshal(header)
+		require.Nil(t, err)
+		res = append(res, bz)
+		parent = header
+	}
+	return res
+}
+
+func TestSideChainSubmitDowntimeEvidence(t *testing.T) {
+	slashParams := DefaultParams()
+	slashParams.MaxEvidenceAge = math.MaxInt64
+	slashParams.DowntimeSlashAmount = 1000e8
+	slashParams.DowntimeSlashFee = 100e8
+	submitter := sdk.AccAddress(addrs[2])
+	ctx, sideCtx, bankKeeper, stakeKeeper, _, keeper := createSideTestInput(t, slashParams)
+
+	ctx = ctx.WithBlockHeight(100)
+	keys := make([]*btcec.PrivateKey, 0, 3)
+	for i := 0; i < 3; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.Nil(t, err)
+		keys = append(keys, key)
+		msgCreateVal := newTestMsgCreateSideValidator(addrs[i], testBscAddress(key), createSideAddr(20), 10000e8)
+		got := stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, msgCreateVal)
+		require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	}
+	stake.EndBreatheBlock(ctx, stakeKeeper)
+	ctx = ctx.WithBlockHeight(200)
+
+	missed := testBscAddress(keys[1])
+	headers := genTestBscHeaders(t, keys, missed, 10, 3)
+	msg := NewMsgSideChainSubmitDowntimeEvidence(submitter, "bsc", missed, headers)
+	require.Nil(t, msg.ValidateBasic())
+
+	got := NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeMsgNotSupported), got.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainDowntime, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainDowntime, 0)
+
+	// the headers are only accepted when the side chain is tracked by the light client
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 0)
+	lightClient := newTestLightClient(map[uint64][][]byte{0: sortedTestBscAddresses(keys)})
+	keeper.SetLightClient(lightClient)
+	lightClient.trust(t, headers)
+
+	// the headers are not linked
+	msg.Headers = [][]byte{headers[0], headers[2]}
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	// the validator signed its block in the headers
+	msg = NewMsgSideChainSubmitDowntimeEvidence(submitter, "bsc", missed, genTestBscHeaders(t, keys, nil, 10, 3))
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	// the headers are signed by a validator out of the recorded side validator set
+	outsider, err := btcec.NewPrivateKey(btcec.S256())
+	require.Nil(t, err)
+	msg = NewMsgSideChainSubmitDowntimeEvidence(submitter, "bsc", missed, genTestBscHeaders(t, append(keys, outsider), missed, 10, 4))
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	// the headers are checked against the validator set of their own epoch
+	msg = NewMsgSideChainSubmitDowntimeEvidence(submitter, "bsc", missed, headers)
+	lightClient.validators[11] = sortedTestBscAddresses(append(keys, outsider))
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+	delete(lightClient.validators, 11)
+	lightClient.validators[5] = sortedTestBscAddresses(keys[:2])
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+	delete(lightClient.validators, 5)
+
+	submitterBalance := bankKeeper.GetCoins(ctx, submitter).AmountOf("steak")
+	got = NewHandler(keeper)(ctx, msg)
+	require.True(t, got.IsOK(), "expected submit downtime evidence msg to be ok, got: %v", got)
+
+	var missedHeight uint64
+	for _, bz := range headers {
+		var header bsc.Header
+		require.Nil(t, json.Unmarshal(bz, &header))
+		if header.Difficulty == 1 {
+			missedHeight = uint64(header.Number)
+		}
+	}
+	slashRecord, found := keeper.getSlashRecord(sideCtx, missed, DowntimeEvidence, missedHeight)
+	require.True(t, found)
+	require.EqualValues(t, slashParams.DowntimeSlashAmount, slashRecord.SlashAmt)
+	_, found = keeper.getSlashRecord(sideCtx, missed, Downtime, missedHeight)
+	require.False(t, found)
+
+	require.EqualValues(t, submitterBalance+slashParams.DowntimeSlashFee, bankKeeper.GetCoins(ctx, submitter).AmountOf("steak"))
+
+	validator, found := stakeKeeper.GetValidatorBySideConsAddr(sideCtx, missed)
+	require.True(t, found)
+	require.True(t, validator.Jailed)
+
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeHandledEvidence), got.Code)
+
+	// no side chain supports downtime evidence other than bsc
+	msg.SideChainId = "tmc"
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+}
+
+func sortedTestBscAddresses(keys []*btcec.PrivateKey) [][]byte {
+	res := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, testBscAddress(key))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i], res[j]) < 0
+	})
+	return res
+}
+
+type testLightClient struct {
+	hashes     map[uint64][]byte
+	validators map[uint64][][]byte
+}
+
+func newTestLightClient(validators map[uint64][][]byte) testLightClient {
+	return testLightClient{hashes: make(map[uint64][]byte), validators: validators}
+}
+
+func (lc testLightClient) trust(t *testing.T, headers [][]byte) {
+	for _, bz := range headers {
+		var header bsc.Header
+		require.Nil(t, json.Unmarshal(bz, &header))
+		lc.hashes[uint64(header.Number)] = header.Hash().Bytes()
+	}
+}
+
+func (lc testLightClient) IsRegistered(ctx sdk.Context, sideChainId string) bool {
+	return sideChainId == "bsc" || sideChainId == "registered"
+}
+
+func (lc testLightClient) IsTracking(ctx sdk.Context, sideChainId string) bool {
+	return sideChainId == "bsc"
+}
+
+func (lc testLightClient) TrustedHeaderHash(ctx sdk.Context, sideChainId string, height uint64) ([]byte, bool) {
+	hash, found := lc.hashes[height]
+	return hash, found
+}
+
+func (lc testLightClient) ValidatorSetAt(ctx sdk.Context, sideChainId string, height uint64) (uint64, [][]byte, bool) {
+	var from uint64
+	var validators [][]byte
+	for h, vals := range lc.validators {
+		if h <= height && (validators == nil || h > from) {
+			from, validators = h, vals
+		}
+	}
+	return from, validators, validators != nil
+}
+
+func TestSideChainEvidenceWithLightClient(t *testing.T) {
+	slashParams := DefaultParams()
+	slashParams.MaxEvidenceAge = math.MaxInt64
+	submitter := sdk.AccAddress(addrs[2])
+	ctx, _, _, stakeKeeper, _, keeper := createSideTestInput(t, slashParams)
+
+	ctx = ctx.WithBlockHeight(100)
+	keys := make([]*btcec.PrivateKey, 0, 2)
+	for i := 0; i < 2; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.Nil(t, err)
+		keys = append(keys, key)
+		msgCreateVal := newTestMsgCreateSideValidator(addrs[i], testBscAddress(key), createSideAddr(20), 10000e8)
+		got := stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, msgCreateVal)
+		require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	}
+	stake.EndBreatheBlock(ctx, stakeKeeper)
+	ctx = ctx.WithBlockHeight(200)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainEvidence, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainEvidence, 0)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainDowntime, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainDowntime, 0)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.BscLightClient, 0)
+
+	lightClient := newTestLightClient(map[uint64][][]byte{0: sortedTestBscAddresses(keys)})
+	keeper.SetLightClient(lightClient)
+
+	// the downtime evidence is only accepted for the canonical headers
+	missed := testBscAddress(keys[1])
+	headers := genTestBscHeaders(t, keys, missed, 10, 2)
+	msg := NewMsgSideChainSubmitDowntimeEvidence(submitter, "bsc", missed, headers)
+	got := NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	lightClient.trust(t, headers)
+	lightClient.hashes[11] = make([]byte, 32)
+	got = NewHandler(keeper)(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	var header bsc.Header
+	require.Nil(t, json.Unmarshal(headers[1], &header))
+	lightClient.hashes[11] = header.Hash().Bytes()
+	got = NewHandler(keeper)(ctx, msg)
+	require.True(t, got.IsOK(), "expected submit downtime evidence msg to be ok, got: %v", got)
+
+	// the double signed blocks should be built on the canonical chain
+	ctx, sideCtx, _, stakeKeeper, _, keeper := createSideTestInput(t, slashParams)
+	ctx = ctx.WithBlockHeight(100)
+	mSideConsAddr, err := sdk.HexDecode("0x625448c3f21AB4636bBCef84Baaf8D6cCdE13c3F")
+	require.Nil(t, err)
+	got = stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, newTestMsgCreateSideValidator(addrs[0], mSideConsAddr, createSideAddr(20), 10000e8))
+	require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	stake.EndBreatheBlock(ctx, stakeKeeper)
+	ctx = ctx.WithBlockHeight(200)
+	lightClient = newTestLightClient(nil)
+	keeper.SetLightClient(lightClient)
+
+	var rawHeaders []json.RawMessage
+	require.Nil(t, json.Unmarshal([]byte(testBscEvidenceJson), &rawHeaders))
+	doubleSignMsg := NewMsgSideChainSubmitEvidence(submitter, "bsc", [][]byte{rawHeaders[0], rawHeaders[1]})
+	got = NewHandler(keeper)(ctx, doubleSignMsg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidEvidence), got.Code)
+
+	require.Nil(t, json.Unmarshal(rawHeaders[0], &header))
+	lightClient.hashes[0] = header.ParentHash.Bytes()
+	got = NewHandler(keeper)(ctx, doubleSignMsg)
+	require.True(t, got.IsOK(), "expected submit evidence msg to be ok, got: %v", got)
+	_, found := keeper.getSlashRecord(sideCtx, mSideConsAddr, DoubleSign, 1)
+	require.True(t, found)
+
+	// the evidence of a side chain registered to the light client is rejected until it is tracked
+	require.NotNil(t, keeper.checkTrustedHeaders(ctx, "registered", nil, false))
+	require.Nil(t, keeper.checkTrustedHeaders(ctx, "tmc", nil, false))
+}
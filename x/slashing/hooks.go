@@ -1,9 +1,12 @@
 package slashing
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	oTypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
 func (k Keeper) onValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _ sdk.ValAddress) {
@@ -79,6 +82,38 @@ func (k Keeper) onSelfDelDropBelowMin(ctx sdk.Context, valAddress sdk.ValAddress
 	}
 }
 
+// Slash and/or jail the validator which did not take part in enough oracle prophecies
+func (k Keeper) onValidatorAbsent(ctx sdk.Context, valAddress sdk.ValAddress, penalty oTypes.AbsencePenalty) {
+	logger := ctx.Logger().With("module", "x/slashing")
+	validator := k.validatorSet.Validator(ctx, valAddress)
+	if validator == nil || validator.GetJailed() || validator.IsSideChainValidator() {
+		logger.Info(fmt.Sprintf("Validator %s would have been punished for oracle absence, but was either not found in store, already jailed or a side chain validator",
+			valAddress))
+		return
+	}
+	consAddr := validator.GetConsAddr()
+
+	if penalty.SlashFraction.GT(sdk.ZeroDec()) {
+		distributionHeight := ctx.BlockHeight() - stake.ValidatorUpdateDelay
+		k.validatorSet.Slash(ctx, consAddr, distributionHeight, validator.GetPower().RawInt(), penalty.SlashFraction)
+	}
+
+	if penalty.JailDuration > 0 {
+		k.validatorSet.Jail(ctx, consAddr)
+		header := ctx.BlockHeader()
+		signingInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
+		if !found {
+			signingInfo = ValidatorSigningInfo{
+				StartHeight:         header.Height,
+				IndexOffset:         0,
+				MissedBlocksCounter: 0,
+			}
+		}
+		signingInfo.JailedUntil = header.Time.Add(penalty.JailDuration)
+		k.setValidatorSigningInfo(ctx, consAddr, signingInfo)
+	}
+}
+
 //_________________________________________________________________________________________
 
 // Wrapper struct
@@ -122,3 +157,22 @@ func (h Hooks) OnDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk
 func (h Hooks) OnDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) OnSideChainValidatorBeginUnbonding(ctx sdk.Context, sideConsAddr []byte, operator sdk.ValAddress) {
 }
+
+//_________________________________________________________________________________________
+
+// Wrapper struct
+type OracleHooks struct {
+	k Keeper
+}
+
+var _ oTypes.OracleHooks = OracleHooks{}
+
+// Return the oracle hooks wrapper struct
+func (k Keeper) OracleHooks() OracleHooks {
+	return OracleHooks{k}
+}
+
+// Implements oracle types.OracleHooks
+func (h OracleHooks) OnValidatorAbsent(ctx sdk.Context, operator sdk.ValAddress, penalty oTypes.AbsencePenalty) {
+	h.k.onValidatorAbsent(ctx, operator, penalty)
+}
@@ -2,10 +2,13 @@ package slashing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	oTypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
 )
 
 func TestHookOnValidatorBonded(t *testing.T) {
@@ -24,3 +27,33 @@ func TestHookOnValidatorBeginUnbonding(t *testing.T) {
 	period := keeper.getValidatorSlashingPeriodForHeight(ctx, addr, ctx.BlockHeight())
 	require.Equal(t, ValidatorSlashingPeriod{addr, ctx.BlockHeight(), ctx.BlockHeight(), sdk.ZeroDec()}, period)
 }
+
+func TestHookOnValidatorAbsent(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	amtInt := sdk.NewDecWithoutFra(100).RawInt()
+	addr, val := addrs[0], pks[0]
+	got := stake.NewStakeHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amtInt))
+	require.True(t, got.IsOK())
+	validatorUpdates, _ := stake.EndBlocker(ctx, sk)
+	keeper.AddValidators(ctx, validatorUpdates)
+
+	ctx = ctx.WithBlockHeight(10)
+	penalty := oTypes.AbsencePenalty{SlashFraction: sdk.NewDecWithPrec(1, 3), JailDuration: time.Hour}
+	keeper.OracleHooks().OnValidatorAbsent(ctx, addr, penalty)
+	stake.EndBlocker(ctx, sk)
+
+	// validator should have been slashed and jailed
+	validator, _ := sk.GetValidator(ctx, addr)
+	require.True(t, validator.GetJailed())
+	require.Equal(t, sdk.Unbonding, validator.GetStatus())
+	slashAmt := sdk.NewDec(amtInt).Mul(penalty.SlashFraction).RawInt()
+	require.Equal(t, amtInt-slashAmt, validator.GetTokens().RawInt())
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	require.True(t, found)
+	require.True(t, ctx.BlockHeader().Time.Add(time.Hour).Equal(info.JailedUntil))
+
+	// validator should not be punished again since it is already jailed
+	keeper.OracleHooks().OnValidatorAbsent(ctx, addr, penalty)
+	validator, _ = sk.GetValidator(ctx, addr)
+	require.Equal(t, amtInt-slashAmt, validator.GetTokens().RawInt())
+}
@@ -36,6 +36,9 @@ type Keeper struct {
 	ScKeeper   *sidechain.Keeper
 
 	PbsbServer *pubsub.Server
+
+	evidenceVerifiers map[string]EvidenceVerifier
+	lightClient       LightClient
 }
 
 // NewKeeper creates a slashing keeper
@@ -47,6 +50,8 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, vs sdk.ValidatorSet, paramspa
 		paramspace:   paramspace.WithTypeTable(ParamTypeTable()),
 		Codespace:    codespace,
 		BankKeeper:   bk,
+
+		evidenceVerifiers: make(map[string]EvidenceVerifier),
 	}
 	return keeper
 }
@@ -61,6 +66,13 @@ func (k *Keeper) initIbc() {
 	if err != nil {
 		panic(fmt.Sprintf("register ibc channel failed, channel=%s, err=%s", ChannelName, err.Error()))
 	}
+	err = k.ScKeeper.RegisterPayloadSchema(ChannelId, sTypes.PayloadSchema{
+		Syn: sTypes.RLPPayloadDecoder(SideDowntimeSlashPackage{}),
+		Ack: sTypes.RLPPayloadDecoder(sTypes.CommonAckPackage{}),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("register payload schema failed, channel=%s, err=%s", ChannelName, err.Error()))
+	}
 }
 
 func (k *Keeper) SetPbsbServer(server *pubsub.Server) {
@@ -288,7 +300,7 @@ func (k *Keeper) checkSideDowntimeSlashPackage(payload []byte) (*SideDowntimeSla
 }
 
 func (k *Keeper) slashingSideDowntime(ctx sdk.Context, pack *SideDowntimeSlashPackage) sdk.Error {
-	sideChainName, err := k.ScKeeper.GetDestChainName(pack.SideChainId)
+	sideChainName, err := k.ScKeeper.GetDestChainName(ctx, pack.SideChainId)
 	if err != nil {
 		return ErrInvalidSideChainId(DefaultCodespace)
 	}
@@ -308,7 +320,7 @@ func (k *Keeper) slashingSideDowntime(ctx sdk.Context, pack *SideDowntimeSlashPa
 	}
 
 	slashAmt := k.DowntimeSlashAmount(sideCtx)
-	validator, slashedAmt, err := k.validatorSet.SlashSideChain(ctx, sideChainName, pack.SideConsAddr, sdk.NewDec(slashAmt))
+	validator, slashedAmt, losses, err := k.validatorSet.SlashSideChain(ctx, sideChainName, pack.SideConsAddr, sdk.NewDec(slashAmt))
 	if err != nil {
 		return ErrFailedToSlash(k.Codespace, err.Error())
 	}
@@ -349,6 +361,9 @@ func (k *Keeper) slashingSideDowntime(ctx sdk.Context, pack *SideDowntimeSlashPa
 		SideChainId:      sideChainName,
 	}
 	k.setSlashRecord(sideCtx, sr)
+	if sdk.IsUpgrade(sdk.DelegatorSlashRecord) {
+		k.setDelegatorSlashRecords(sideCtx, sr, validator, losses)
+	}
 
 	// Set or updated validator jail duration
 	signInfo, found := k.getValidatorSigningInfo(sideCtx, pack.SideConsAddr)
@@ -14,6 +14,7 @@ var (
 	ValidatorSlashingPeriodKey      = []byte{0x03} // Prefix for slashing period
 	AddrPubkeyRelationKey           = []byte{0x04} // Prefix for address-pubkey relation
 	SlashRecordKey                  = []byte{0x05} // Prefix for slash record
+	DelegatorSlashRecordKey         = []byte{0x06} // Prefix for delegator slash record
 )
 
 // stored by *Tendermint* address (not operator address)
@@ -63,3 +64,12 @@ func GetSlashRecordsByAddrAndTypeIndexKey(sideConsAddr []byte, infractionType by
 func GetSlashRecordsByAddrIndexKey(sideConsAddr []byte) []byte {
 	return append(SlashRecordKey, sideConsAddr...)
 }
+
+// stored by delegator address followed by the key of the slash record
+func GetDelegatorSlashRecordKey(delAddr sdk.AccAddress, consAddr []byte, infractionType byte, infractionHeight uint64) []byte {
+	return append(GetDelegatorSlashRecordsKey(delAddr), GetSlashRecordKey(consAddr, infractionType, infractionHeight)[1:]...)
+}
+
+func GetDelegatorSlashRecordsKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorSlashRecordKey, delAddr.Bytes()...)
+}
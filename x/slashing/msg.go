@@ -18,6 +18,12 @@ const (
 	TypeMsgUnjail            = "unjail"
 	TypeMsgSideChainUnjail   = "side_chain_unjail"
 	TypeMsgBscSubmitEvidence = "bsc_submit_evidence"
+
+	TypeMsgSideChainSubmitEvidence         = "side_chain_submit_evidence"
+	TypeMsgSideChainSubmitDowntimeEvidence = "side_chain_submit_downtime_evidence"
+
+	MaxEvidenceHeaderLength    = 16 * 1024
+	MaxDowntimeEvidenceHeaders = 128
 )
 
 // verify interface at compile time
@@ -140,56 +146,147 @@ func (msg MsgBscSubmitEvidence) ValidateBasic() sdk.Error {
 	if len(msg.Headers) != 2 {
 		return ErrInvalidEvidence(DefaultCodespace, "Must have 2 headers exactly")
 	}
-	if err := headerEmptyCheck(msg.Headers[0]); err != nil {
-		return err
+	if err := verifyBscDoubleSign(&msg.Headers[0], &msg.Headers[1]); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error())
 	}
-	if err := headerEmptyCheck(msg.Headers[1]); err != nil {
-		return err
+	return nil
+}
+
+func (msg MsgBscSubmitEvidence) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgBscSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func (msg MsgBscSubmitEvidence) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+//__________________________________________________________________
+
+// MsgSideChainSubmitEvidence - struct for submitting double sign evidence for any side chain,
+// the headers are decoded and checked by the EvidenceVerifier registered for the side chain
+var _ sdk.Msg = &MsgSideChainSubmitEvidence{}
+
+type MsgSideChainSubmitEvidence struct {
+	Submitter   sdk.AccAddress `json:"submitter"`
+	SideChainId string         `json:"side_chain_id"`
+	Headers     [][]byte       `json:"headers"`
+}
+
+func NewMsgSideChainSubmitEvidence(submitter sdk.AccAddress, sideChainId string, headers [][]byte) MsgSideChainSubmitEvidence {
+	return MsgSideChainSubmitEvidence{
+		Submitter:   submitter,
+		SideChainId: sideChainId,
+		Headers:     headers,
 	}
-	if msg.Headers[0].Number != msg.Headers[1].Number {
-		return ErrInvalidEvidence(DefaultCodespace, "The numbers of two block headers are not the same")
+}
+
+func (MsgSideChainSubmitEvidence) Route() string {
+	return MsgRoute
+}
+
+func (MsgSideChainSubmitEvidence) Type() string {
+	return TypeMsgSideChainSubmitEvidence
+}
+
+func (msg MsgSideChainSubmitEvidence) ValidateBasic() sdk.Error {
+	if len(msg.Submitter) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.Submitter)))
 	}
-	if msg.Headers[0].ParentHash.Cmp(msg.Headers[1].ParentHash) != 0 {
-		return ErrInvalidEvidence(DefaultCodespace, "The parent hash of two block headers are not the same")
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return ErrInvalidInput(DefaultCodespace, fmt.Sprintf("side chain id must be included and max length is %d bytes", types.MaxSideChainIdLength))
 	}
-	signature1, err := msg.Headers[0].GetSignature()
-	if err != nil {
-		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("Failed to get signature from block header, %s", err.Error()))
+	if len(msg.Headers) != 2 {
+		return ErrInvalidEvidence(DefaultCodespace, "Must have 2 headers exactly")
 	}
-	signature2, err := msg.Headers[1].GetSignature()
-	if err != nil {
-		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("Failed to get signature from block header, %s", err.Error()))
+	for _, header := range msg.Headers {
+		if len(header) == 0 || len(header) > MaxEvidenceHeaderLength {
+			return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("header can not be empty and max length is %d bytes", MaxEvidenceHeaderLength))
+		}
 	}
-	if bytes.Compare(signature1, signature2) == 0 {
+	if bytes.Equal(msg.Headers[0], msg.Headers[1]) {
 		return ErrInvalidEvidence(DefaultCodespace, "The two blocks are the same")
 	}
 	return nil
 }
 
-func headerEmptyCheck(header bsc.Header) sdk.Error {
+func (msg MsgSideChainSubmitEvidence) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSideChainSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+func (msg MsgSideChainSubmitEvidence) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+//__________________________________________________________________
 
-	if header.Number == 0 {
-		return ErrInvalidEvidence(DefaultCodespace, "header number can not be zero ")
+// MsgSideChainSubmitDowntimeEvidence - struct for submitting consecutive headers of a side chain which prove
+// the validator missed its turn to sign a block, the headers are checked by the EvidenceVerifier registered for the side chain
+var _ sdk.Msg = &MsgSideChainSubmitDowntimeEvidence{}
+
+type MsgSideChainSubmitDowntimeEvidence struct {
+	Submitter    sdk.AccAddress `json:"submitter"`
+	SideChainId  string         `json:"side_chain_id"`
+	SideConsAddr []byte         `json:"side_cons_addr"`
+	Headers      [][]byte       `json:"headers"`
+}
+
+func NewMsgSideChainSubmitDowntimeEvidence(submitter sdk.AccAddress, sideChainId string, sideConsAddr []byte, headers [][]byte) MsgSideChainSubmitDowntimeEvidence {
+	return MsgSideChainSubmitDowntimeEvidence{
+		Submitter:    submitter,
+		SideChainId:  sideChainId,
+		SideConsAddr: sideConsAddr,
+		Headers:      headers,
 	}
-	if header.Difficulty == 0 {
-		return ErrInvalidEvidence(DefaultCodespace, "header difficulty can not be zero")
+}
+
+func (MsgSideChainSubmitDowntimeEvidence) Route() string {
+	return MsgRoute
+}
+
+func (MsgSideChainSubmitDowntimeEvidence) Type() string {
+	return TypeMsgSideChainSubmitDowntimeEvidence
+}
+
+func (msg MsgSideChainSubmitDowntimeEvidence) ValidateBasic() sdk.Error {
+	if len(msg.Submitter) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.Submitter)))
 	}
-	if header.Extra == nil {
-		return ErrInvalidEvidence(DefaultCodespace, "header extra can not be empty")
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return ErrInvalidInput(DefaultCodespace, fmt.Sprintf("side chain id must be included and max length is %d bytes", types.MaxSideChainIdLength))
+	}
+	if len(msg.SideConsAddr) != sdk.AddrLen {
+		return ErrInvalidInput(DefaultCodespace, fmt.Sprintf("Expected side consensus address length is %d, actual length is %d", sdk.AddrLen, len(msg.SideConsAddr)))
+	}
+	if len(msg.Headers) == 0 || len(msg.Headers) > MaxDowntimeEvidenceHeaders {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("headers must be included and max count is %d", MaxDowntimeEvidenceHeaders))
+	}
+	for _, header := range msg.Headers {
+		if len(header) == 0 || len(header) > MaxEvidenceHeaderLength {
+			return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("header can not be empty and max length is %d bytes", MaxEvidenceHeaderLength))
+		}
 	}
-
 	return nil
 }
 
-func (msg MsgBscSubmitEvidence) GetSignBytes() []byte {
+func (msg MsgSideChainSubmitDowntimeEvidence) GetSignBytes() []byte {
 	bz := MsgCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
 
-func (msg MsgBscSubmitEvidence) GetSigners() []sdk.AccAddress {
+func (msg MsgSideChainSubmitDowntimeEvidence) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Submitter}
 }
 
-func (msg MsgBscSubmitEvidence) GetInvolvedAddresses() []sdk.AccAddress {
+func (msg MsgSideChainSubmitDowntimeEvidence) GetInvolvedAddresses() []sdk.AccAddress {
 	return msg.GetSigners()
 }
@@ -7,11 +7,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 )
 
 const (
 	QueryConsAddrSlashRecords     = "consAddrSlashHistories"
 	QueryConsAddrTypeSlashRecords = "consAddrTypeSlashHistories"
+	QuerySlashRecordsPage         = "slashRecordsPage"
+	QueryDelegatorSlashRecords    = "delegatorSlashHistories"
 )
 
 // creates a querier for staking REST endpoints
@@ -32,6 +35,20 @@ func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
 				return res, err
 			}
 			return queryConsAddrTypeSlashRecords(ctx, k, param)
+		case QuerySlashRecordsPage:
+			param := new(QuerySlashRecordsPageParams)
+			ctx, err = RequestPrepare(ctx, k, req, param)
+			if err != nil {
+				return res, err
+			}
+			return querySlashRecordsPage(ctx, k, param)
+		case QueryDelegatorSlashRecords:
+			param := new(QueryDelegatorSlashRecordsParams)
+			ctx, err = RequestPrepare(ctx, k, req, param)
+			if err != nil {
+				return res, err
+			}
+			return queryDelegatorSlashRecords(ctx, k, param)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
 		}
@@ -64,6 +81,36 @@ type QueryConsAddrTypeParams struct {
 	InfractionType byte
 }
 
+// QuerySlashRecordsPageParams defines the params for 'custom/slashing/slashRecordsPage', the slash records can be
+// filtered by the consensus address and the infraction type
+type QuerySlashRecordsPageParams struct {
+	BaseParams
+	ConsAddr       []byte
+	InfractionType *byte
+	Page           sdk.PageRequest
+}
+
+// SlashRecordsPage is a page of the slash records, the next page starts from the NextKey, which is empty if there are
+// no more records
+type SlashRecordsPage struct {
+	SlashRecords []SlashRecord `json:"slash_records"`
+	NextKey      cmn.HexBytes  `json:"next_key"`
+}
+
+// QueryDelegatorSlashRecordsParams defines the params for 'custom/slashing/delegatorSlashHistories'
+type QueryDelegatorSlashRecordsParams struct {
+	BaseParams
+	DelegatorAddr sdk.AccAddress
+	Page          sdk.PageRequest
+}
+
+// DelegatorSlashRecordsPage is a page of the slash records of a delegator, the next page starts from the NextKey,
+// which is empty if there are no more records
+type DelegatorSlashRecordsPage struct {
+	DelegatorSlashRecords []DelegatorSlashRecord `json:"delegator_slash_records"`
+	NextKey               cmn.HexBytes           `json:"next_key"`
+}
+
 func RequestPrepare(ctx sdk.Context, k Keeper, req abci.RequestQuery, p types.SideChainIder) (newCtx sdk.Context, err sdk.Error) {
 	if req.Data == nil || len(req.Data) == 0 {
 		return ctx, nil
@@ -118,3 +165,25 @@ func queryConsAddrTypeSlashRecords(ctx sdk.Context, k Keeper, params *QueryConsA
 
 	return res, nil
 }
+
+func querySlashRecordsPage(ctx sdk.Context, k Keeper, params *QuerySlashRecordsPageParams) (res []byte, err sdk.Error) {
+	slashRecords, nextKey := k.getSlashRecordsPage(ctx, params.ConsAddr, params.InfractionType, params.Page)
+
+	res, resErr := codec.MarshalJSONIndent(k.cdc, SlashRecordsPage{SlashRecords: slashRecords, NextKey: nextKey})
+	if resErr != nil {
+		return res, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", resErr.Error()))
+	}
+
+	return res, nil
+}
+
+func queryDelegatorSlashRecords(ctx sdk.Context, k Keeper, params *QueryDelegatorSlashRecordsParams) (res []byte, err sdk.Error) {
+	records, nextKey := k.getDelegatorSlashRecordsPage(ctx, params.DelegatorAddr, params.Page)
+
+	res, resErr := codec.MarshalJSONIndent(k.cdc, DelegatorSlashRecordsPage{DelegatorSlashRecords: records, NextKey: nextKey})
+	if resErr != nil {
+		return res, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", resErr.Error()))
+	}
+
+	return res, nil
+}
@@ -13,6 +13,8 @@ import (
 const (
 	DoubleSign byte = iota
 	Downtime
+	// DowntimeEvidence is the downtime proved by the side chain headers submitted in MsgSideChainSubmitDowntimeEvidence
+	DowntimeEvidence
 )
 
 type SlashRecord struct {
@@ -31,6 +33,8 @@ func (r SlashRecord) HumanReadableString() (string, error) {
 		infraType = "DoubleSign"
 	} else if r.InfractionType == 1 {
 		infraType = "Downtime"
+	} else if r.InfractionType == 2 {
+		infraType = "DowntimeEvidence"
 	}
 
 	var consAddr string
@@ -162,3 +166,27 @@ func (k Keeper) getSlashRecordsByConsAddrAndType(ctx sdk.Context, consAddr []byt
 	}
 	return
 }
+
+// getSlashRecordsPage returns a page of the slash records in the order of the consensus addresses, the infraction
+// types and the infraction heights. The records can be filtered by the consensus address and the infraction type.
+func (k Keeper) getSlashRecordsPage(ctx sdk.Context, consAddr []byte, infractionType *byte, page sdk.PageRequest) (slashRecords []SlashRecord, nextKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := SlashRecordKey
+	if len(consAddr) != 0 {
+		prefix = GetSlashRecordsByAddrIndexKey(consAddr)
+		if infractionType != nil {
+			prefix = GetSlashRecordsByAddrAndTypeIndexKey(consAddr, *infractionType)
+		}
+	}
+
+	slashRecords = make([]SlashRecord, 0)
+	nextKey = sdk.Paginate(store, prefix, page, func(key, value []byte) bool {
+		slashRecord := MustUnmarshalSlashRecord(k.cdc, key, value)
+		if infractionType != nil && slashRecord.InfractionType != *infractionType {
+			return false
+		}
+		slashRecords = append(slashRecords, slashRecord)
+		return true
+	})
+	return
+}
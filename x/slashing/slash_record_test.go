@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestSetGetSlashRecord(t *testing.T) {
@@ -36,6 +38,43 @@ func TestSetGetSlashRecord(t *testing.T) {
 	require.True(t, keeper.hasSlashRecord(ctx, sideConsAddr, DoubleSign, iHeight))
 }
 
+func TestSlashRecordsPage(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	consAddrs := [][]byte{randomSideConsAddr(), randomSideConsAddr()}
+	for _, consAddr := range consAddrs {
+		for i, infractionType := range []byte{DoubleSign, Downtime, Downtime} {
+			keeper.setSlashRecord(ctx, SlashRecord{
+				ConsAddr:         consAddr,
+				InfractionType:   infractionType,
+				InfractionHeight: uint64(100 + i),
+				SlashHeight:      int64(150 + i),
+				JailUntil:        time.Now(),
+				SlashAmt:         100e8,
+			})
+		}
+	}
+
+	records, nextKey := keeper.getSlashRecordsPage(ctx, nil, nil, sdk.PageRequest{Limit: 4})
+	require.Len(t, records, 4)
+	require.NotNil(t, nextKey)
+	records, nextKey = keeper.getSlashRecordsPage(ctx, nil, nil, sdk.PageRequest{Key: nextKey, Limit: 4})
+	require.Len(t, records, 2)
+	require.Nil(t, nextKey)
+
+	downtime := Downtime
+	records, _ = keeper.getSlashRecordsPage(ctx, nil, &downtime, sdk.PageRequest{})
+	require.Len(t, records, 4)
+	records, _ = keeper.getSlashRecordsPage(ctx, consAddrs[0], &downtime, sdk.PageRequest{Limit: 1, Reverse: true})
+	require.Len(t, records, 1)
+	require.EqualValues(t, consAddrs[0], records[0].ConsAddr)
+	require.EqualValues(t, 102, records[0].InfractionHeight)
+
+	doubleSign := DoubleSign
+	records, _ = keeper.getSlashRecordsPage(ctx, consAddrs[1], &doubleSign, sdk.PageRequest{})
+	require.Len(t, records, 1)
+	require.EqualValues(t, consAddrs[1], records[0].ConsAddr)
+}
+
 func randomSideConsAddr() []byte {
 	bz := make([]byte, 20)
 	rand.Read(bz)
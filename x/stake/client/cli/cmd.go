@@ -28,6 +28,9 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 			GetCmdQueryValidator(storeKey, cdc),
 			GetCmdQueryValidators(storeKey, cdc),
 			GetCmdQueryUnbondingDelegations(storeKey, cdc),
+			GetCmdQueryValidatorSetHistory(cdc),
+			GetCmdExportValidatorSetHistory(cdc),
+			GetCmdQuerySelfBondDeficiencies(cdc),
 		)...,
 	)
 	stakingCmd.AddCommand(client.LineBreak)
@@ -39,6 +42,10 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 			GetCmdSideChainDelegate(cdc),
 			GetCmdSideChainRedelegate(cdc),
 			GetCmdSideChainUnbond(cdc),
+			GetCmdSideChainCancelUnbond(cdc),
+			GetCmdSideChainSetAutoCompound(cdc),
+			GetCmdSideChainLiquidDelegate(cdc),
+			GetCmdSideChainLiquidUnbond(cdc),
 		)...,
 	)
 	stakingCmd.AddCommand(client.LineBreak)
@@ -52,10 +59,14 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 			GetCmdQuerySideChainUnbondingDelegation(storeKey, cdc),
 			GetCmdQuerySideChainUnbondingDelegations(storeKey, cdc),
 			GetCmdQuerySideChainPool(storeKey, cdc),
+			GetCmdQuerySideChainDelegationsByValidator(cdc),
 			GetCmdQuerySideChainUnbondingDelegationsByValidator(cdc),
 			GetCmdQuerySideChainReDelegationsByValidator(cdc),
 			GetCmdQuerySideChainTopValidators(cdc),
 			GetCmdQuerySideAllValidatorsCount(cdc),
+			GetCmdQuerySideChainRewardProjection(cdc),
+			GetCmdQuerySideChainCommissionChanges(cdc),
+			GetCmdQuerySideChainStakingReceipt(cdc),
 		)...,
 	)
 
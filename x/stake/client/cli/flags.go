@@ -41,6 +41,16 @@ const (
 	FlagSideChainId  = "side-chain-id"
 	FlagSideConsAddr = "side-cons-addr"
 	FlagSideFeeAddr  = "side-fee-addr"
+
+	FlagAutoCompound = "auto-compound"
+
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+	FlagLimit       = "limit"
+
+	FlagStatus       = "status"
+	FlagJailed       = "jailed"
+	FlagOrderByPower = "order-by-power"
 )
 
 // common flagsets to add to various functions
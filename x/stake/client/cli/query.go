@@ -1,13 +1,19 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/cli"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -71,11 +77,20 @@ func GetCmdQueryValidator(storeName string, cdc *codec.Codec) *cobra.Command {
 func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validators",
-		Short: "Query for all validators",
+		Short: "Query for all validators, or a page of the validators with the pagination flags or the filters",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			key := stake.ValidatorsKey
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			page, paginated, err := utils.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+			if paginated || len(viper.GetString(FlagStatus)) != 0 || len(viper.GetString(FlagJailed)) != 0 ||
+				viper.GetBool(FlagOrderByPower) || len(viper.GetString(FlagSideChainId)) != 0 {
+				return queryValidatorsPage(cliCtx, cdc, page)
+			}
+
 			resKVs, err := cliCtx.QuerySubspace(key, storeName)
 			if err != nil {
 				return err
@@ -116,6 +131,11 @@ func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "only query the validators of the status: Bonded, Unbonding or Unbonded")
+	cmd.Flags().String(FlagJailed, "", "only query the jailed validators if true, or the unjailed validators if false")
+	cmd.Flags().Bool(FlagOrderByPower, false, "order the validators from the highest power to the lowest, the jailed validators are excluded")
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	client.PaginationFlags(cmd)
 	return cmd
 }
 
@@ -193,6 +213,19 @@ func GetCmdQueryDelegations(storeName string, cdc *codec.Codec) *cobra.Command {
 			key := stake.GetDelegationsKey(delegatorAddr)
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			page, paginated, err := utils.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+			if paginated {
+				params := stake.QueryDelegatorPageParams{DelegatorAddr: delegatorAddr, Page: page}
+				var response stake.DelegationsPageResponse
+				if err := queryPage(cliCtx, cdc, stake.QueryDelegatorDelegationsPage, params, &response); err != nil {
+					return err
+				}
+				return printPage(cdc, response)
+			}
+
 			resKVs, err := cliCtx.QuerySubspace(key, storeName)
 			if err != nil {
 				return err
@@ -217,6 +250,7 @@ func GetCmdQueryDelegations(storeName string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	client.PaginationFlags(cmd)
 	return cmd
 }
 
@@ -502,3 +536,223 @@ func GetCmdQueryParams(storeName string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryValidatorSetHistory implements the validator set history query command.
+func GetCmdQueryValidatorSetHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-history",
+		Short: "Query a page of the validator set changes by height, the next page starts from the next_height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := getValidatorSetHistoryParams(cliCtx)
+			if err != nil {
+				return err
+			}
+			response, err := queryValidatorSetHistory(cliCtx, cdc, params)
+			if err != nil {
+				return err
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, response)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	cmd.Flags().Int64(FlagStartHeight, 0, "the height to query the changes from")
+	cmd.Flags().Int64(FlagEndHeight, 0, "the height to query the changes to, 0 for the latest height")
+	cmd.Flags().Int(FlagLimit, 100, "the number of changes to query at most, the changes of a height are not split")
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	return cmd
+}
+
+// GetCmdExportValidatorSetHistory implements the command to export all the validator set changes in a height range.
+func GetCmdExportValidatorSetHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-validator-set-history",
+		Short: "Export the validator set changes in the height range to a JSON document",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := getValidatorSetHistoryParams(cliCtx)
+			if err != nil {
+				return err
+			}
+			// query the pages at the same height, so that they are consistent
+			if cliCtx.Height == 0 {
+				if cliCtx.Height, err = rpc.GetChainHeight(cliCtx); err != nil {
+					return err
+				}
+			}
+
+			changes := make([]stake.ValidatorSetChange, 0)
+			for {
+				response, err := queryValidatorSetHistory(cliCtx, cdc, params)
+				if err != nil {
+					return err
+				}
+				changes = append(changes, response.Changes...)
+				if response.NextHeight == 0 {
+					break
+				}
+				params.StartHeight = response.NextHeight
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, changes)
+			if err != nil {
+				return err
+			}
+			if outputDocument := viper.GetString(FlagOutputDocument); outputDocument != "" {
+				return ioutil.WriteFile(outputDocument, output, 0644)
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	cmd.Flags().Int64(FlagStartHeight, 0, "the height to export the changes from")
+	cmd.Flags().Int64(FlagEndHeight, 0, "the height to export the changes to, 0 for the latest height")
+	cmd.Flags().Int(FlagLimit, 1000, "the number of changes to query in each request")
+	cmd.Flags().String(FlagOutputDocument, "", "write the changes to the given file instead of STDOUT")
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	return cmd
+}
+
+// GetCmdQuerySelfBondDeficiencies implements the command to query the validators whose self delegation is below the
+// minimum, they are jailed once their grace period runs out.
+func GetCmdQuerySelfBondDeficiencies(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-bond-deficiencies",
+		Short: "Query the validators in the grace period of a self delegation below the minimum",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var params stake.QueryValidatorParams
+			var err error
+			if len(viper.GetString(FlagSideChainId)) != 0 {
+				if params.SideChainId, _, err = getSideChainConfig(cliCtx); err != nil {
+					return err
+				}
+			}
+			if valAddr := viper.GetString(FlagAddressValidator); len(valAddr) != 0 {
+				if params.ValidatorAddr, err = sdk.ValAddressFromBech32(valAddr); err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+			response, err := cliCtx.QueryWithData("custom/stake/selfBondDeficiencies", bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(response))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	return cmd
+}
+
+func getValidatorSetHistoryParams(cliCtx context.CLIContext) (params stake.QueryValidatorSetHistoryParams, err error) {
+	if len(viper.GetString(FlagSideChainId)) != 0 {
+		if params.SideChainId, _, err = getSideChainConfig(cliCtx); err != nil {
+			return
+		}
+	}
+	if valAddr := viper.GetString(FlagAddressValidator); len(valAddr) != 0 {
+		if params.ValidatorAddr, err = sdk.ValAddressFromBech32(valAddr); err != nil {
+			return
+		}
+	}
+	params.StartHeight = viper.GetInt64(FlagStartHeight)
+	params.EndHeight = viper.GetInt64(FlagEndHeight)
+	params.Limit = viper.GetInt(FlagLimit)
+	return
+}
+
+func queryValidatorSetHistory(cliCtx context.CLIContext, cdc *codec.Codec,
+	params stake.QueryValidatorSetHistoryParams) (response stake.ValidatorSetHistoryResponse, err error) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	res, err := cliCtx.QueryWithData("custom/stake/validatorSetHistory", bz)
+	if err != nil {
+		return
+	}
+	err = cdc.UnmarshalJSON(res, &response)
+	return
+}
+
+func queryValidatorsPage(cliCtx context.CLIContext, cdc *codec.Codec, page sdk.PageRequest) (err error) {
+	params := stake.QueryValidatorsPageParams{
+		Page:         page,
+		Status:       viper.GetString(FlagStatus),
+		OrderByPower: viper.GetBool(FlagOrderByPower),
+	}
+	if jailed := viper.GetString(FlagJailed); len(jailed) != 0 {
+		isJailed, err := strconv.ParseBool(jailed)
+		if err != nil {
+			return fmt.Errorf("invalid %s %s: %v", FlagJailed, jailed, err)
+		}
+		params.Jailed = &isJailed
+	}
+	if len(viper.GetString(FlagSideChainId)) != 0 {
+		if params.SideChainId, _, err = getSideChainConfig(cliCtx); err != nil {
+			return err
+		}
+	}
+
+	var response stake.ValidatorsPageResponse
+	if err := queryPage(cliCtx, cdc, stake.QueryValidatorsPage, params, &response); err != nil {
+		return err
+	}
+
+	if viper.Get(cli.OutputFlag) == "text" {
+		for _, validator := range response.Validators {
+			resp, err := validator.HumanReadableString()
+			if err != nil {
+				return err
+			}
+			fmt.Println(resp)
+		}
+		if len(response.NextKey) != 0 {
+			fmt.Printf("Next Key: %s\n", response.NextKey)
+		}
+		return nil
+	}
+	return printPage(cdc, response)
+}
+
+// queryPage queries a page of the paginated stake query endpoint
+func queryPage(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string, params interface{}, response interface{}) error {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	res, err := cliCtx.QueryWithData("custom/stake/"+endpoint, bz)
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(res, response)
+}
+
+func printPage(cdc *codec.Codec, response interface{}) error {
+	output, err := codec.MarshalJSONIndent(cdc, response)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
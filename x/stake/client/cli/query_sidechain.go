@@ -10,7 +10,9 @@ import (
 
 	"github.com/tendermint/tendermint/libs/cli"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/sidechain"
@@ -468,6 +470,44 @@ func GetCmdQuerySideChainRedelegations(storeName string, cdc *codec.Codec) *cobr
 	return cmd
 }
 
+func GetCmdQuerySideChainDelegationsByValidator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-val-delegations [operator-addr]",
+		Short: "Query a page of the delegations to one validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sideChainId, _, err := getSideChainConfig(cliCtx)
+			if err != nil {
+				return err
+			}
+			page, _, err := utils.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+
+			params := stake.QueryValidatorPageParams{
+				BaseParams:    stake.NewBaseParams(sideChainId),
+				ValidatorAddr: valAddr,
+				Page:          page,
+			}
+			var response stake.DelegationsPageResponse
+			if err := queryPage(cliCtx, cdc, stake.QueryValidatorDelegationsPage, params, &response); err != nil {
+				return err
+			}
+			return printPage(cdc, response)
+		},
+	}
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	client.PaginationFlags(cmd)
+	return cmd
+}
+
 func GetCmdQuerySideChainUnbondingDelegationsByValidator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "side-val-unbonding-delegations [operator-addr]",
@@ -485,6 +525,23 @@ func GetCmdQuerySideChainUnbondingDelegationsByValidator(cdc *codec.Codec) *cobr
 				return err
 			}
 
+			page, paginated, err := utils.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+			if paginated {
+				params := stake.QueryValidatorPageParams{
+					BaseParams:    stake.NewBaseParams(sideChainId),
+					ValidatorAddr: valAddr,
+					Page:          page,
+				}
+				var response stake.UnbondingDelegationsPageResponse
+				if err := queryPage(cliCtx, cdc, stake.QueryValidatorUnbondingDelegationsPage, params, &response); err != nil {
+					return err
+				}
+				return printPage(cdc, response)
+			}
+
 			params := stake.QueryValidatorParams{
 				ValidatorAddr: valAddr,
 				BaseParams:    stake.NewBaseParams(sideChainId),
@@ -523,6 +580,7 @@ func GetCmdQuerySideChainUnbondingDelegationsByValidator(cdc *codec.Codec) *cobr
 			return nil
 		},
 	}
+	client.PaginationFlags(cmd)
 	cmd.Flags().AddFlagSet(fsSideChainId)
 	return cmd
 }
@@ -752,3 +810,124 @@ func getSideChainConfig(cliCtx context.CLIContext) (sideChainId string, prefix [
 	}
 	return sideChainId, prefix, error
 }
+
+func GetCmdQuerySideChainRewardProjection(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-reward-projection",
+		Short: "Query the projected rewards of the next distribution with the fees collected so far",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sideChainId, _, err := getSideChainConfig(cliCtx)
+			if err != nil {
+				return err
+			}
+			params := stake.QueryRewardProjectionParams{
+				BaseParams: stake.NewBaseParams(sideChainId),
+			}
+			if valAddr := viper.GetString(FlagAddressValidator); len(valAddr) != 0 {
+				if params.ValidatorAddr, err = sdk.ValAddressFromBech32(valAddr); err != nil {
+					return err
+				}
+			}
+			if delAddr := viper.GetString(FlagAddressDelegator); len(delAddr) != 0 {
+				if params.DelegatorAddr, err = sdk.AccAddressFromBech32(delAddr); err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+
+			response, err := cliCtx.QueryWithData("custom/stake/sideChainRewardProjection", bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(response))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsDelegator)
+	return cmd
+}
+
+func GetCmdQuerySideChainCommissionChanges(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-commission-changes",
+		Short: "Query the scheduled commission changes of the side chain validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sideChainId, _, err := getSideChainConfig(cliCtx)
+			if err != nil {
+				return err
+			}
+			params := stake.QueryValidatorParams{
+				BaseParams: stake.NewBaseParams(sideChainId),
+			}
+			if valAddr := viper.GetString(FlagAddressValidator); len(valAddr) != 0 {
+				if params.ValidatorAddr, err = sdk.ValAddressFromBech32(valAddr); err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+
+			response, err := cliCtx.QueryWithData("custom/stake/sideChainCommissionChanges", bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(response))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	cmd.Flags().AddFlagSet(fsValidator)
+	return cmd
+}
+
+func GetCmdQuerySideChainStakingReceipt(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-staking-receipt [denom]",
+		Short: "Query the staking receipt of a side chain validator by the denomination or the validator",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sideChainId, _, err := getSideChainConfig(cliCtx)
+			if err != nil {
+				return err
+			}
+			params := stake.QueryStakingReceiptParams{
+				BaseParams: stake.NewBaseParams(sideChainId),
+			}
+			if len(args) == 1 {
+				params.Denom = args[0]
+			} else if params.ValidatorAddr, err = getValidatorAddr(FlagAddressValidator); err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+
+			response, err := cliCtx.QueryWithData("custom/stake/sideChainStakingReceipt", bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(response))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	cmd.Flags().AddFlagSet(fsValidator)
+	return cmd
+}
@@ -400,6 +400,42 @@ func GetCmdUnbond(storeName string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdCancelUnbond implements the cancel unbonding command.
+func GetCmdCancelUnbond(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unbond",
+		Short: "move some or all of a pending unbonding back to the delegation with the validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			amount, err := getAmount()
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgCancelUnbonding(delAddr, valAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsAmount)
+	cmd.Flags().AddFlagSet(fsValidator)
+	return cmd
+}
+
 func getAmount() (sdk.Coin, error) {
 	amountStr := viper.GetString(FlagAmount)
 	if amountStr == "" {
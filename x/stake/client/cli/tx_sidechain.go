@@ -268,6 +268,152 @@ func GetCmdSideChainUnbond(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdSideChainCancelUnbond(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bsc-cancel-unbond",
+		Short: "move some or all of a pending unbonding back to the delegation with the side chain validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			valAddr, err := getValidatorAddr(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			amount, err := getAmount()
+			if err != nil {
+				return err
+			}
+
+			sideChainId, err := getSideChainId()
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgSideChainCancelUnbonding(sideChainId, delAddr, valAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsAmount)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	return cmd
+}
+
+func GetCmdSideChainSetAutoCompound(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bsc-set-auto-compound",
+		Short: "turn on or off the auto-compounding of the side chain staking rewards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			sideChainId, err := getSideChainId()
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgSideChainSetAutoCompound(sideChainId, delAddr, viper.GetBool(FlagAutoCompound))
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagAutoCompound, true, "whether to delegate the rewards to the same validators automatically")
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	return cmd
+}
+
+func GetCmdSideChainLiquidDelegate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bsc-liquid-delegate",
+		Short: "delegate liquid tokens to a side chain validator, and receive the staking receipts of the validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			amount, err := getAmount()
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := getValidatorAddr(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			sideChainId, err := getSideChainId()
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgSideChainLiquidDelegate(sideChainId, delAddr, valAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsAmount)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	return cmd
+}
+
+func GetCmdSideChainLiquidUnbond(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bsc-liquid-unbond",
+		Short: "burn the staking receipts, and unbond the tokens they are worth from the side chain validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			amount, err := getAmount()
+			if err != nil {
+				return err
+			}
+
+			sideChainId, err := getSideChainId()
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgSideChainLiquidUndelegate(sideChainId, delAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsAmount)
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	return cmd
+}
+
 func getSideChainId() (sideChainId string, err error) {
 	sideChainId = viper.GetString(FlagSideChainId)
 	if len(sideChainId) == 0 {
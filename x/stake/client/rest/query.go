@@ -1,7 +1,9 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -9,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 
 	"github.com/gorilla/mux"
@@ -16,6 +19,14 @@ import (
 
 const storeName = "stake"
 
+// the query parameters of the paginated queries
+const (
+	RestSideChainId  = "side_chain_id"
+	RestStatus       = "status"
+	RestJailed       = "jailed"
+	RestOrderByPower = "order_by_power"
+)
+
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 
 	// Get all delegations from a delegator
@@ -79,6 +90,11 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	).Methods("GET")
 
 	// Get all unbonding delegations from a validator
+	r.HandleFunc(
+		"/stake/validators/{validatorAddr}/delegations",
+		validatorDelegationsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/stake/validators/{validatorAddr}/unbonding_delegations",
 		validatorUnbondingDelegationsHandlerFn(cliCtx, cdc),
@@ -104,9 +120,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 
 }
 
-// HTTP request handler to query a delegator delegations
+// HTTP request handler to query a delegator delegations, or a page of them with the pagination parameters
 func delegatorDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/stake/delegatorDelegations")
+	all := queryDelegator(cliCtx, cdc, "custom/stake/delegatorDelegations")
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, paginated, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		if !paginated {
+			all(w, r)
+			return
+		}
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		queryPage(w, cliCtx, cdc, "custom/stake/delegatorDelegationsPage", stake.QueryDelegatorPageParams{
+			BaseParams:    stake.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			DelegatorAddr: delegatorAddr,
+			Page:          page,
+		})
+	}
 }
 
 // HTTP request handler to query a delegator unbonding delegations
@@ -207,9 +244,34 @@ func delegatorValidatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) ht
 	return queryBonds(cliCtx, cdc, "custom/stake/delegatorValidator")
 }
 
-// HTTP request handler to query list of validators
+// HTTP request handler to query list of validators, or a page of the validators with the pagination or the filter
+// parameters
 func validatorsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		page, paginated, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		query := r.URL.Query()
+		params := stake.QueryValidatorsPageParams{
+			BaseParams:   stake.NewBaseParams(query.Get(RestSideChainId)),
+			Page:         page,
+			Status:       query.Get(RestStatus),
+			OrderByPower: query.Get(RestOrderByPower) == "true",
+		}
+		if jailed := query.Get(RestJailed); len(jailed) != 0 {
+			isJailed, err := strconv.ParseBool(jailed)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("'%s' is not a valid jailed filter", jailed))
+				return
+			}
+			params.Jailed = &isJailed
+		}
+		if paginated || len(params.SideChainId) != 0 || len(params.Status) != 0 || params.Jailed != nil || params.OrderByPower {
+			queryPage(w, cliCtx, cdc, "custom/stake/validatorsPage", params)
+			return
+		}
+
 		res, err := cliCtx.QueryWithData("custom/stake/validators", nil)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -224,9 +286,25 @@ func validatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 	return queryValidator(cliCtx, cdc, "custom/stake/validator")
 }
 
-// HTTP request handler to query all unbonding delegations from a validator
+// HTTP request handler to query a page of the delegations to a validator on a side chain
+func validatorDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryValidatorPage(cliCtx, cdc, "custom/stake/validatorDelegationsPage")
+}
+
+// HTTP request handler to query all unbonding delegations from a validator, or a page of them with the pagination
+// parameters
 func validatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryValidator(cliCtx, cdc, "custom/stake/validatorUnbondingDelegations")
+	all := queryValidator(cliCtx, cdc, "custom/stake/validatorUnbondingDelegations")
+	pageHandler := queryValidatorPage(cliCtx, cdc, "custom/stake/validatorUnbondingDelegationsPage")
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, paginated, ok := utils.ParsePageRequestOrReturnBadRequest(w, r); !ok {
+			return
+		} else if paginated {
+			pageHandler(w, r)
+			return
+		}
+		all(w, r)
+	}
 }
 
 // HTTP request handler to query all redelegations from a source validator
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -141,3 +142,41 @@ func queryValidator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func queryValidatorPage(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _, ok := utils.ParsePageRequestOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryPage(w, cliCtx, cdc, endpoint, stake.QueryValidatorPageParams{
+			BaseParams:    stake.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			ValidatorAddr: validatorAddr,
+			Page:          page,
+		})
+	}
+}
+
+// queryPage queries a page of the paginated endpoint, the params are encoded by the standard JSON encoding as the
+// querier decodes them
+func queryPage(w http.ResponseWriter, cliCtx context.CLIContext, cdc *codec.Codec, endpoint string, params interface{}) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := cliCtx.QueryWithData(endpoint, bz)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+}
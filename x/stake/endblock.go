@@ -28,14 +28,30 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) (validatorUpdates []abci.Valid
 }
 
 func EndBreatheBlock(ctx sdk.Context, k keeper.Keeper) (validatorUpdates []abci.ValidatorUpdate, completedUbds []types.UnbondingDelegation) {
-	var events sdk.Events
+	var events, selfBondEvents sdk.Events
+	// the validators below the min self delegation are jailed before the validator set updates are applied
+	if sdk.IsUpgrade(sdk.SelfBondEnforcement) {
+		selfBondEvents = enforceSelfBond(ctx, k)
+	}
 	_, validatorUpdates, completedUbds, _, events = handleValidatorAndDelegations(ctx, k)
+	events = selfBondEvents.AppendEvents(events)
+	k.PruneValidatorSetHistory(ctx)
 
 	if sdk.IsUpgrade(sdk.LaunchBscUpgrade) && k.ScKeeper != nil {
 		sideChainIds, storePrefixes := k.ScKeeper.GetAllSideChainPrefixes(ctx)
 		for i := range storePrefixes {
 			sideChainCtx := ctx.WithSideChainKeyPrefix(storePrefixes[i])
+			// the commission changes are applied before the validators are stored for the distribution
+			var commissionEvents sdk.Events
+			if sdk.IsUpgrade(sdk.SideChainCommissionSchedule) {
+				commissionEvents = applyCommissionChanges(sideChainCtx, k)
+			}
+			var scSelfBondEvents sdk.Events
+			if sdk.IsUpgrade(sdk.SelfBondEnforcement) {
+				scSelfBondEvents = enforceSelfBond(sideChainCtx, k)
+			}
 			newVals, _, completedUbds, completedREDs, scEvents := handleValidatorAndDelegations(sideChainCtx, k)
+			scEvents = commissionEvents.AppendEvents(scSelfBondEvents).AppendEvents(scEvents)
 			if k.ExistHeightValidators(sideChainCtx) { // will not send ibc package if no snapshot of validators stored ever
 				saveSideChainValidatorsToIBC(ctx, sideChainIds[i], newVals, k)
 			}
@@ -46,6 +62,7 @@ func EndBreatheBlock(ctx sdk.Context, k keeper.Keeper) (validatorUpdates []abci.
 			// TODO: need to add UBDs for side chains to the return value
 
 			storeValidatorsWithHeight(sideChainCtx, newVals, k)
+			k.PruneValidatorSetHistory(sideChainCtx)
 
 			if !sdk.IsUpgrade(sdk.BEP128) {
 				k.Distribute(sideChainCtx, sideChainIds[i])
@@ -108,6 +125,42 @@ func saveSideChainValidatorsToIBC(ctx sdk.Context, sideChainId string, newVals [
 	}
 }
 
+func applyCommissionChanges(ctx sdk.Context, k keeper.Keeper) sdk.Events {
+	applied := k.ApplyCommissionChanges(ctx)
+	events := make(sdk.Events, 0, len(applied))
+	for _, change := range applied {
+		events = events.AppendEvent(sdk.NewEvent(
+			types.EventTypeApplyCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+		))
+	}
+	return events
+}
+
+func enforceSelfBond(ctx sdk.Context, k keeper.Keeper) sdk.Events {
+	warned, jailed := k.EnforceSelfBond(ctx)
+	events := make(sdk.Events, 0, len(warned)+len(jailed))
+	for _, deficiency := range warned {
+		events = events.AppendEvent(sdk.NewEvent(
+			types.EventTypeSelfBondWarning,
+			sdk.NewAttribute(types.AttributeKeyValidator, deficiency.ValidatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySelfDelegation, fmt.Sprintf("%d", deficiency.SelfDelegation)),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, fmt.Sprintf("%d", deficiency.MinSelfDelegation)),
+			sdk.NewAttribute(types.AttributeKeyRemainingBlocks, fmt.Sprintf("%d", deficiency.RemainingBreatheBlocks)),
+		))
+	}
+	for _, deficiency := range jailed {
+		events = events.AppendEvent(sdk.NewEvent(
+			types.EventTypeSelfBondJail,
+			sdk.NewAttribute(types.AttributeKeyValidator, deficiency.ValidatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySelfDelegation, fmt.Sprintf("%d", deficiency.SelfDelegation)),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, fmt.Sprintf("%d", deficiency.MinSelfDelegation)),
+		))
+	}
+	return events
+}
+
 func storeValidatorsWithHeight(ctx sdk.Context, validators []types.Validator, k keeper.Keeper) {
 	blockHeight := ctx.BlockHeight()
 	for _, validator := range validators {
@@ -40,6 +40,14 @@ func NewHandler(k keeper.Keeper, govKeeper gov.Keeper) sdk.Handler {
 			return handleMsgSideChainRedelegate(ctx, msg, k)
 		case types.MsgSideChainUndelegate:
 			return handleMsgSideChainUndelegate(ctx, msg, k)
+		case types.MsgSideChainCancelUnbonding:
+			return handleMsgSideChainCancelUnbonding(ctx, msg, k)
+		case types.MsgSideChainSetAutoCompound:
+			return handleMsgSideChainSetAutoCompound(ctx, msg, k)
+		case types.MsgSideChainLiquidDelegate:
+			return handleMsgSideChainLiquidDelegate(ctx, msg, k)
+		case types.MsgSideChainLiquidUndelegate:
+			return handleMsgSideChainLiquidUndelegate(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -60,6 +68,8 @@ func NewStakeHandler(k Keeper) sdk.Handler {
 			return handleMsgBeginRedelegate(ctx, msg, k)
 		case types.MsgBeginUnbonding:
 			return handleMsgBeginUnbonding(ctx, msg, k)
+		case types.MsgCancelUnbonding:
+			return handleMsgCancelUnbonding(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -333,6 +343,22 @@ func handleMsgBeginUnbonding(ctx sdk.Context, msg types.MsgBeginUnbonding, k kee
 	return sdk.Result{Data: finishTime, Tags: tags}
 }
 
+func handleMsgCancelUnbonding(ctx sdk.Context, msg types.MsgCancelUnbonding, k keeper.Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.CancelUnbonding) {
+		return sdk.ErrMsgNotSupported("MsgCancelUnbonding is not supported before the CancelUnbonding upgrade").Result()
+	}
+
+	if _, err := k.CancelUnbonding(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
+
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) sdk.Result {
 	red, err := k.BeginRedelegation(ctx, msg.DelegatorAddr, msg.ValidatorSrcAddr,
 		msg.ValidatorDstAddr, msg.SharesAmount)
@@ -92,13 +92,29 @@ func handleMsgEditSideChainValidator(ctx sdk.Context, msg MsgEditSideChainValida
 		validator.Description = description
 	}
 
+	var events sdk.Events
 	if msg.CommissionRate != nil {
-		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
-		if err != nil {
-			return err.Result()
+		if sdk.IsUpgrade(sdk.SideChainCommissionSchedule) {
+			// the commission change is announced ahead and applied in a later breathe block
+			change, err := k.ScheduleCommissionChange(ctx, validator, *msg.CommissionRate)
+			if err != nil {
+				return err.Result()
+			}
+			events = events.AppendEvent(sdk.NewEvent(
+				types.EventTypeScheduleCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+				sdk.NewAttribute(types.AttributeKeyRemainingBlocks, fmt.Sprintf("%d", change.RemainingBreatheBlocks)),
+				sdk.NewAttribute(types.AttributeKeySideChainId, msg.SideChainId),
+			))
+		} else {
+			commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
+			if err != nil {
+				return err.Result()
+			}
+			validator.Commission = commission
+			k.OnValidatorModified(ctx, msg.ValidatorAddr)
 		}
-		validator.Commission = commission
-		k.OnValidatorModified(ctx, msg.ValidatorAddr)
 	}
 
 	if len(msg.SideFeeAddr) != 0 {
@@ -112,6 +128,7 @@ func handleMsgEditSideChainValidator(ctx sdk.Context, msg MsgEditSideChainValida
 			tags.Moniker, []byte(validator.Description.Moniker),
 			tags.Identity, []byte(validator.Description.Identity),
 		),
+		Events: events,
 	}
 }
 
@@ -290,14 +307,179 @@ func handleMsgSideChainUndelegate(ctx sdk.Context, msg MsgSideChainUndelegate, k
 	return sdk.Result{Data: finishTime, Tags: tags}
 }
 
+func handleMsgSideChainLiquidDelegate(ctx sdk.Context, msg MsgSideChainLiquidDelegate, k keeper.Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.LiquidStaking) {
+		return sdk.ErrMsgNotSupported("MsgSideChainLiquidDelegate is not supported before the LiquidStaking upgrade").Result()
+	}
+
+	if scCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, msg.SideChainId); err != nil {
+		return ErrInvalidSideChainId(k.Codespace()).Result()
+	} else {
+		ctx = scCtx
+	}
+
+	minDelegationChange := k.MinDelegationChange(ctx)
+	if msg.Delegation.Amount < minDelegationChange {
+		return ErrBadDelegationAmount(DefaultCodespace, fmt.Sprintf("delegation must not be less than %d", minDelegationChange)).Result()
+	}
+
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddr)
+	if !found {
+		return ErrNoValidatorFound(k.Codespace()).Result()
+	}
+
+	if msg.Delegation.Denom != k.BondDenom(ctx) {
+		return ErrBadDenom(k.Codespace()).Result()
+	}
+
+	// the liquid staking account is never the self-delegator, so jailed validators can not be delegated to
+	if validator.Jailed {
+		return ErrValidatorJailed(k.Codespace()).Result()
+	}
+
+	minted, err := k.LiquidDelegate(ctx, msg.DelegatorAddr, msg.Delegation, validator)
+	if err != nil {
+		return err.Result()
+	}
+
+	// publish delegate event of the pooled delegation
+	if k.PbsbServer != nil && ctx.IsDeliverTx() {
+		event := types.SideDelegateEvent{
+			DelegateEvent: types.DelegateEvent{
+				StakeEvent: types.StakeEvent{
+					IsFromTx: true,
+				},
+				Delegator: keeper.LiquidStakingAccAddr,
+				Validator: msg.ValidatorAddr,
+				Amount:    msg.Delegation.Amount,
+				Denom:     msg.Delegation.Denom,
+				TxHash:    ctx.Value(baseapp.TxHashKey).(string),
+			},
+			SideChainId: msg.SideChainId,
+		}
+		k.PbsbServer.Publish(event)
+	}
+
+	return sdk.Result{
+		Data: types.MsgCdc.MustMarshalBinaryLengthPrefixed(minted),
+		Tags: sdk.NewTags(
+			tags.Delegator, []byte(msg.DelegatorAddr.String()),
+			tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+		),
+	}
+}
+
+func handleMsgSideChainLiquidUndelegate(ctx sdk.Context, msg MsgSideChainLiquidUndelegate, k keeper.Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.LiquidStaking) {
+		return sdk.ErrMsgNotSupported("MsgSideChainLiquidUndelegate is not supported before the LiquidStaking upgrade").Result()
+	}
+
+	if scCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, msg.SideChainId); err != nil {
+		return ErrInvalidSideChainId(k.Codespace()).Result()
+	} else {
+		ctx = scCtx
+	}
+
+	ubd, err := k.LiquidUndelegate(ctx, msg.DelegatorAddr, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	finishTime := types.MsgCdc.MustMarshalBinaryLengthPrefixed(ubd.MinTime)
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.SrcValidator, []byte(ubd.ValidatorAddr.String()),
+		tags.EndTime, finishTime,
+	)
+
+	// publish undelegate event of the pooled delegation
+	if k.PbsbServer != nil && ctx.IsDeliverTx() {
+		event := types.SideUnDelegateEvent{
+			UndelegateEvent: types.UndelegateEvent{
+				StakeEvent: types.StakeEvent{
+					IsFromTx: true,
+				},
+				Delegator: keeper.LiquidStakingAccAddr,
+				Validator: ubd.ValidatorAddr,
+				Amount:    ubd.Balance.Amount,
+				Denom:     ubd.Balance.Denom,
+				TxHash:    ctx.Value(baseapp.TxHashKey).(string),
+			},
+			SideChainId: msg.SideChainId,
+		}
+		k.PbsbServer.Publish(event)
+	}
+
+	return sdk.Result{Data: finishTime, Tags: tags}
+}
+
+func handleMsgSideChainCancelUnbonding(ctx sdk.Context, msg MsgSideChainCancelUnbonding, k keeper.Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.CancelUnbonding) {
+		return sdk.ErrMsgNotSupported("MsgSideChainCancelUnbonding is not supported before the CancelUnbonding upgrade").Result()
+	}
+
+	if scCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, msg.SideChainId); err != nil {
+		return ErrInvalidSideChainId(k.Codespace()).Result()
+	} else {
+		ctx = scCtx
+	}
+
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return ErrBadDenom(k.Codespace()).Result()
+	}
+
+	if _, err := k.CancelUnbonding(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	// publish delegate event, the tokens are delegated back from the unbonding delegation
+	if k.PbsbServer != nil && ctx.IsDeliverTx() {
+		event := types.SideDelegateEvent{
+			DelegateEvent: types.DelegateEvent{
+				StakeEvent: types.StakeEvent{
+					IsFromTx: true,
+				},
+				Delegator: msg.DelegatorAddr,
+				Validator: msg.ValidatorAddr,
+				Amount:    msg.Amount.Amount,
+				Denom:     msg.Amount.Denom,
+				TxHash:    ctx.Value(baseapp.TxHashKey).(string),
+			},
+			SideChainId: msg.SideChainId,
+		}
+		k.PbsbServer.Publish(event)
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Delegator, []byte(msg.DelegatorAddr.String()),
+			tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+		),
+	}
+}
+
+func handleMsgSideChainSetAutoCompound(ctx sdk.Context, msg MsgSideChainSetAutoCompound, k keeper.Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.SideChainAutoCompound) {
+		return sdk.ErrMsgNotSupported("MsgSideChainSetAutoCompound is not supported before the SideChainAutoCompound upgrade").Result()
+	}
+
+	if scCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, msg.SideChainId); err != nil {
+		return ErrInvalidSideChainId(k.Codespace()).Result()
+	} else {
+		ctx = scCtx
+	}
+
+	k.SetAutoCompound(ctx, msg.DelegatorAddr, msg.Enabled)
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		),
+	}
+}
+
 // we allow the self-delegator delegating/redelegating to its validator.
 // but the operator is not allowed if it is not a self-delegator
 func checkOperatorAsDelegator(k Keeper, delegator sdk.AccAddress, validator Validator) sdk.Error {
-	delegatorIsOperator := bytes.Equal(delegator.Bytes(), validator.OperatorAddr.Bytes())
-	operatorIsSelfDelegator := validator.IsSelfDelegator(sdk.AccAddress(validator.OperatorAddr))
-
-	if delegatorIsOperator && !operatorIsSelfDelegator {
-		return ErrInvalidDelegator(k.Codespace())
-	}
-	return nil
+	return k.CheckOperatorAsDelegator(delegator, validator)
 }
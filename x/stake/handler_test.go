@@ -1178,3 +1178,35 @@ func TestRemoveValidatorAfterProposal(t *testing.T) {
 	result = handleMsgRemoveValidatorAfterProposal(ctx, msgRemoveValidator, keeper, govKeeper)
 	require.False(t, result.IsOK())
 }
+
+func TestMsgCancelUnbonding(t *testing.T) {
+	initBond := int64(1000)
+	ctx, _, keeper := keep.CreateTestInput(t, false, initBond)
+	denom := keeper.GetParams(ctx).BondDenom
+
+	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond), keeper)
+	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	got = handleMsgBeginUnbonding(ctx, NewMsgBeginUnbonding(delegatorAddr, validatorAddr, sdk.NewDecWithoutFra(10)), keeper)
+	require.True(t, got.IsOK(), "expected begin unbonding to be ok, got %v", got)
+
+	msgCancelUnbonding := NewMsgCancelUnbonding(delegatorAddr, validatorAddr, sdk.NewCoin(denom, sdk.NewDecWithoutFra(10).RawInt()))
+	got = handleMsgCancelUnbonding(ctx, msgCancelUnbonding, keeper)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeMsgNotSupported), got.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.CancelUnbonding, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.CancelUnbonding, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	got = handleMsgCancelUnbonding(ctx, msgCancelUnbonding, keeper)
+	require.True(t, got.IsOK(), "expected cancel unbonding to be ok, got %v", got)
+	delegation, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithoutFra(initBond), delegation.Shares)
+	_, found = keeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+}
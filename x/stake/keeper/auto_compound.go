@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// SetAutoCompound turns on or off the auto-compounding of the delegator's rewards on the side chain of the context
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(GetAutoCompoundKey(delAddr), []byte{})
+	} else {
+		store.Delete(GetAutoCompoundKey(delAddr))
+	}
+}
+
+// IsAutoCompound returns whether the delegator's rewards on the side chain of the context are auto-compounded
+func (k Keeper) IsAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetAutoCompoundKey(delAddr))
+}
+
+// compoundReward delegates the reward paid to the delegator back to the validator. The reward is kept in the delegator's
+// account if it is too small or cannot be delegated to the validator, e.g. the validator is removed or jailed.
+func (k Keeper) compoundReward(ctx sdk.Context, sideChainId string, reward types.Reward) {
+	// no one can withdraw from the liquid staking account, so its rewards are kept with the staking receipts
+	if bytes.Equal(reward.AccAddr, LiquidStakingAccAddr) {
+		k.compoundPooledReward(ctx, sideChainId, reward)
+		return
+	}
+	k.delegateReward(ctx, sideChainId, reward)
+}
+
+// delegateReward delegates the reward to the validator, and returns whether the reward is delegated
+func (k Keeper) delegateReward(ctx sdk.Context, sideChainId string, reward types.Reward) bool {
+	if reward.Amount < k.MinDelegationChange(ctx) {
+		return false
+	}
+	validator, found := k.GetValidator(ctx, reward.ValAddr)
+	if !found {
+		return false
+	}
+	// if the validator is jailed, only the self-delegator can delegate to itself
+	if validator.Jailed && !bytes.Equal(validator.FeeAddr, reward.AccAddr) {
+		return false
+	}
+	if err := k.CheckOperatorAsDelegator(reward.AccAddr, validator); err != nil {
+		return false
+	}
+
+	coin := sdk.NewCoin(k.BondDenom(ctx), reward.Amount)
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.Delegate(cacheCtx, reward.AccAddr, coin, validator, true); err != nil {
+		k.Logger(ctx).Error("failed to compound the reward", "delegator", reward.AccAddr.String(),
+			"validator", reward.ValAddr.String(), "amount", reward.Amount, "err", err.Error())
+		return false
+	}
+	write()
+
+	// the delegation is made in the end block, so there is no tx hash
+	if k.PbsbServer != nil && ctx.IsDeliverTx() {
+		event := types.SideDelegateEvent{
+			DelegateEvent: types.DelegateEvent{
+				StakeEvent: types.StakeEvent{
+					IsFromTx: false,
+				},
+				Delegator: reward.AccAddr,
+				Validator: reward.ValAddr,
+				Amount:    coin.Amount,
+				Denom:     coin.Denom,
+			},
+			SideChainId: sideChainId,
+		}
+		k.PbsbServer.Publish(event)
+	}
+	return true
+}
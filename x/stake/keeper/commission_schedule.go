@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// GetCommissionChange returns the scheduled commission change of the validator
+func (k Keeper) GetCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) (change types.CommissionChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCommissionChangeKey(valAddr))
+	if bz == nil {
+		return change, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &change)
+	return change, true
+}
+
+// GetAllCommissionChanges returns all the scheduled commission changes, ordered by the validator address
+func (k Keeper) GetAllCommissionChanges(ctx sdk.Context) (changes []types.CommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, CommissionChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.CommissionChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func (k Keeper) setCommissionChange(ctx sdk.Context, change types.CommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCommissionChangeKey(change.ValidatorAddr), k.cdc.MustMarshalBinaryLengthPrefixed(change))
+}
+
+func (k Keeper) removeCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetCommissionChangeKey(valAddr))
+}
+
+// ScheduleCommissionChange announces a new commission rate of the validator, which is applied after the
+// CommissionChangeDelay breathe blocks. The new rate is checked against the current commission as an immediate change
+// would be, and it replaces the change scheduled before if there is any.
+func (k Keeper) ScheduleCommissionChange(ctx sdk.Context, validator types.Validator, newRate sdk.Dec) (types.CommissionChange, sdk.Error) {
+	if err := validator.Commission.ValidateNewRate(newRate, ctx.BlockHeader().Time); err != nil {
+		return types.CommissionChange{}, err
+	}
+
+	change := types.CommissionChange{
+		ValidatorAddr:          validator.OperatorAddr,
+		Rate:                   newRate,
+		AnnounceHeight:         ctx.BlockHeight(),
+		RemainingBreatheBlocks: k.CommissionChangeDelay(ctx),
+	}
+	k.setCommissionChange(ctx, change)
+	return change, nil
+}
+
+// ApplyCommissionChanges counts down the scheduled commission changes in a breathe block, and applies the changes
+// which are due. The changes of the removed validators are dropped.
+func (k Keeper) ApplyCommissionChanges(ctx sdk.Context) (applied []types.CommissionChange) {
+	for _, change := range k.GetAllCommissionChanges(ctx) {
+		validator, found := k.GetValidator(ctx, change.ValidatorAddr)
+		if !found {
+			k.removeCommissionChange(ctx, change.ValidatorAddr)
+			continue
+		}
+
+		change.RemainingBreatheBlocks--
+		if change.RemainingBreatheBlocks > 0 {
+			k.setCommissionChange(ctx, change)
+			continue
+		}
+
+		validator.Commission.Rate = change.Rate
+		validator.Commission.UpdateTime = ctx.BlockHeader().Time
+		k.SetValidator(ctx, validator)
+		k.OnValidatorModified(ctx, validator.OperatorAddr)
+		k.removeCommissionChange(ctx, change.ValidatorAddr)
+		power := k.GetLastValidatorPower(ctx, validator.OperatorAddr)
+		k.recordValidatorSetChange(ctx, validator.OperatorAddr, types.ValidatorCommissionChanged, power, power, change.Rate)
+		applied = append(applied, change)
+	}
+	return applied
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestScheduleCommissionChange(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	ctx = ctx.WithBlockTime(time.Unix(1e9, 0).UTC())
+
+	commission := types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+	validators := make([]types.Validator, 2)
+	for i := range validators {
+		validators[i] = types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validators[i], _ = validators[i].SetInitialCommission(commission)
+		keeper.SetValidator(ctx, validators[i])
+	}
+
+	// the same checks as changing the rate immediately
+	_, err := keeper.ScheduleCommissionChange(ctx, validators[0], sdk.NewDecWithPrec(4, 1))
+	require.Equal(t, types.ErrCommissionGTMaxRate(types.DefaultCodespace).Code(), err.Code())
+	_, err = keeper.ScheduleCommissionChange(ctx, validators[0], sdk.NewDecWithPrec(25, 2))
+	require.Equal(t, types.ErrCommissionGTMaxChangeRate(types.DefaultCodespace).Code(), err.Code())
+	require.Empty(t, keeper.GetAllCommissionChanges(ctx))
+
+	change, err := keeper.ScheduleCommissionChange(ctx, validators[0], sdk.NewDecWithPrec(2, 1))
+	require.Nil(t, err)
+	require.Equal(t, types.DefaultCommissionChangeDelay, change.RemainingBreatheBlocks)
+	_, err = keeper.ScheduleCommissionChange(ctx, validators[1], sdk.NewDecWithPrec(15, 2))
+	require.Nil(t, err)
+
+	// the later announcement replaces the former one
+	ctx = ctx.WithBlockHeight(10)
+	_, err = keeper.ScheduleCommissionChange(ctx, validators[1], sdk.NewDecWithPrec(5, 2))
+	require.Nil(t, err)
+	require.Len(t, keeper.GetAllCommissionChanges(ctx), 2)
+	change, found := keeper.GetCommissionChange(ctx, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), change.Rate)
+	require.EqualValues(t, 10, change.AnnounceHeight)
+
+	// the first breathe block only counts down
+	require.Empty(t, keeper.ApplyCommissionChanges(ctx))
+	validator, _ := keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, commission.Rate, validator.Commission.Rate)
+	change, _ = keeper.GetCommissionChange(ctx, addrVals[0])
+	require.EqualValues(t, 1, change.RemainingBreatheBlocks)
+
+	// the changes of the removed validators are dropped
+	keeper.RemoveValidator(ctx, addrVals[1])
+
+	applyTime := time.Unix(1e9+86400, 0).UTC()
+	ctx = ctx.WithBlockTime(applyTime)
+	applied := keeper.ApplyCommissionChanges(ctx)
+	require.Len(t, applied, 1)
+	require.Equal(t, addrVals[0], applied[0].ValidatorAddr)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.Equal(t, applyTime, validator.Commission.UpdateTime)
+	require.Empty(t, keeper.GetAllCommissionChanges(ctx))
+
+	// the rate cannot be changed again within 24h after applied
+	_, err = keeper.ScheduleCommissionChange(ctx, validator, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, types.ErrCommissionUpdateTime(types.DefaultCodespace).Code(), err.Code())
+}
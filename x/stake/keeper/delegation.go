@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -301,6 +302,22 @@ func (k Keeper) InsertUnbondingQueue(ctx sdk.Context, ubd types.UnbondingDelegat
 	}
 }
 
+// Remove an unbonding delegation from its timeslice in the unbonding queue
+func (k Keeper) RemoveFromUnbondingQueue(ctx sdk.Context, ubd types.UnbondingDelegation) {
+	timeSlice := k.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime)
+	remaining := make([]types.DVPair, 0, len(timeSlice))
+	for _, dvPair := range timeSlice {
+		if !dvPair.DelegatorAddr.Equals(ubd.DelegatorAddr) || !dvPair.ValidatorAddr.Equals(ubd.ValidatorAddr) {
+			remaining = append(remaining, dvPair)
+		}
+	}
+	if len(remaining) == 0 {
+		ctx.KVStore(k.storeKey).Delete(GetUnbondingDelegationTimeKey(ubd.MinTime))
+	} else {
+		k.SetUnbondingQueueTimeSlice(ctx, ubd.MinTime, remaining)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UnbondingQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -486,6 +503,18 @@ func (k Keeper) SyncDelegationByValDel(ctx sdk.Context, valAddr sdk.ValAddress,
 	k.SetDelegationByVal(ctx, delegation)
 }
 
+// CheckOperatorAsDelegator allows the self-delegator delegating/redelegating to its validator,
+// but the operator is not allowed if it is not a self-delegator
+func (k Keeper) CheckOperatorAsDelegator(delegator sdk.AccAddress, validator types.Validator) sdk.Error {
+	delegatorIsOperator := bytes.Equal(delegator.Bytes(), validator.OperatorAddr.Bytes())
+	operatorIsSelfDelegator := validator.IsSelfDelegator(sdk.AccAddress(validator.OperatorAddr))
+
+	if delegatorIsOperator && !operatorIsSelfDelegator {
+		return types.ErrInvalidDelegator(k.Codespace())
+	}
+	return nil
+}
+
 // Perform a delegation, set/update everything necessary within the store.
 func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Coin,
 	validator types.Validator, subtractAccount bool) (newShares sdk.Dec, err sdk.Error) {
@@ -645,6 +674,12 @@ func (k Keeper) BeginUnbonding(ctx sdk.Context,
 		return types.UnbondingDelegation{}, err
 	}
 
+	return k.insertUnbondingDelegation(ctx, delAddr, valAddr, returnAmount), nil
+}
+
+// create the unbonding delegation of the tokens unbonded from the validator and queue it
+func (k Keeper) insertUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	returnAmount sdk.Dec) types.UnbondingDelegation {
 	balance := sdk.NewCoin(k.BondDenom(ctx), returnAmount.RawInt())
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
@@ -659,7 +694,7 @@ func (k Keeper) BeginUnbonding(ctx sdk.Context,
 	k.SetUnbondingDelegation(ctx, ubd)
 	k.InsertUnbondingQueue(ctx, ubd)
 
-	return ubd, nil
+	return ubd
 }
 
 // complete unbonding an unbonding record
@@ -681,6 +716,55 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return ubd, nil
 }
 
+// cancel some or all of a pending unbonding record, and delegate the tokens back to the validator it was unbonding
+// from. The tokens of the unbonding record are still held by the delegation account, so nothing is transferred.
+func (k Keeper) CancelUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	amount sdk.Coin) (types.UnbondingDelegation, sdk.Error) {
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return ubd, types.ErrNoUnbondingDelegation(k.Codespace())
+	}
+	if !ubd.MinTime.After(ctx.BlockHeader().Time) {
+		return ubd, types.ErrUnbondingDelegationMature(k.Codespace())
+	}
+	if amount.Denom != ubd.Balance.Denom {
+		return ubd, types.ErrBadDenom(k.Codespace())
+	}
+	if amount.Amount <= 0 || amount.Amount > ubd.Balance.Amount {
+		return ubd, types.ErrBadDelegationAmount(k.Codespace(), fmt.Sprintf("the amount must be positive and no more than the unbonding balance %d", ubd.Balance.Amount))
+	}
+	// the same lower limit as delegating, unless the whole balance is cancelled
+	minDelegationChange := k.MinDelegationChange(ctx)
+	if amount.Amount < minDelegationChange && amount.Amount != ubd.Balance.Amount {
+		return ubd, types.ErrBadDelegationAmount(k.Codespace(), fmt.Sprintf("the amount must not be less than %d, or the amount is all the unbonding balance", minDelegationChange))
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return ubd, types.ErrNoValidatorFound(k.Codespace())
+	}
+	// if the validator is jailed, only the self-delegator can delegate to itself
+	if validator.Jailed && !validator.FeeAddr.Equals(delAddr) {
+		return ubd, types.ErrValidatorJailed(k.Codespace())
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, amount, validator, false); err != nil {
+		return ubd, err
+	}
+
+	ubd.Balance.Amount -= amount.Amount
+	// the cancelled tokens are slashed as the delegation from now on
+	ubd.InitialBalance.Amount -= sdk.MinInt64(amount.Amount, ubd.InitialBalance.Amount)
+	if ubd.Balance.Amount == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+		k.RemoveFromUnbondingQueue(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+	return ubd, nil
+}
+
 // complete unbonding an unbonding record
 func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (types.Redelegation, sdk.Error) {
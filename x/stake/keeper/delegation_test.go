@@ -248,6 +248,65 @@ func TestUnbondDelegation(t *testing.T) {
 
 // test removing all self delegation from a validator which should
 // shift it from the bonded to unbonded state
+func TestCancelUnbonding(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100)
+	bondDenom := keeper.BondDenom(ctx)
+
+	pool := keeper.GetPool(ctx)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, _ = validator.AddTokensFromDel(pool, 10e8)
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+
+	delegators := []sdk.AccAddress{Addrs[20], Addrs[21]}
+	for _, delAddr := range delegators {
+		validator, _ = keeper.GetValidator(ctx, addrVals[0])
+		_, err := keeper.Delegate(ctx, delAddr, sdk.NewCoin(bondDenom, 10e8), validator, true)
+		require.Nil(t, err)
+		_, err = keeper.BeginUnbonding(ctx, delAddr, addrVals[0], sdk.NewDec(6e8))
+		require.Nil(t, err)
+	}
+	ubd, _ := keeper.GetUnbondingDelegation(ctx, delegators[0], addrVals[0])
+	require.Len(t, keeper.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime), 2)
+	escrowed := keeper.bankKeeper.GetCoins(ctx, DelegationAccAddr).AmountOf(bondDenom)
+
+	// cancel a part of the unbonding
+	ubd, err := keeper.CancelUnbonding(ctx, delegators[0], addrVals[0], sdk.NewCoin(bondDenom, 2e8))
+	require.Nil(t, err)
+	require.EqualValues(t, 4e8, ubd.Balance.Amount)
+	require.EqualValues(t, 4e8, ubd.InitialBalance.Amount)
+	delegation, _ := keeper.GetDelegation(ctx, delegators[0], addrVals[0])
+	require.Equal(t, sdk.NewDec(6e8), delegation.Shares)
+	require.Len(t, keeper.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime), 2)
+
+	_, err = keeper.CancelUnbonding(ctx, delegators[0], addrVals[0], sdk.NewCoin(bondDenom, 5e8))
+	require.Equal(t, types.CodeInvalidDelegation, err.Code())
+	_, err = keeper.CancelUnbonding(ctx, delegators[0], addrVals[0], sdk.NewCoin(bondDenom, 5e7))
+	require.Equal(t, types.CodeInvalidDelegation, err.Code())
+	_, err = keeper.CancelUnbonding(ctx, delegators[0], addrVals[0], sdk.NewCoin("foo", 1e8))
+	require.Equal(t, types.ErrBadDenom(types.DefaultCodespace).Code(), err.Code())
+	_, err = keeper.CancelUnbonding(ctx.WithBlockTime(ubd.MinTime), delegators[0], addrVals[0], sdk.NewCoin(bondDenom, 1e8))
+	require.Equal(t, types.ErrUnbondingDelegationMature(types.DefaultCodespace).Code(), err.Code())
+
+	// cancel the rest of the unbonding, only its entry is removed from the queue
+	_, err = keeper.CancelUnbonding(ctx, delegators[0], addrVals[0], sdk.NewCoin(bondDenom, 4e8))
+	require.Nil(t, err)
+	_, found := keeper.GetUnbondingDelegation(ctx, delegators[0], addrVals[0])
+	require.False(t, found)
+	timeSlice := keeper.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime)
+	require.Len(t, timeSlice, 1)
+	require.Equal(t, delegators[1], timeSlice[0].DelegatorAddr)
+	delegation, _ = keeper.GetDelegation(ctx, delegators[0], addrVals[0])
+	require.Equal(t, sdk.NewDec(10e8), delegation.Shares)
+	_, err = keeper.CancelUnbonding(ctx, delegators[0], addrVals[0], sdk.NewCoin(bondDenom, 4e8))
+	require.Equal(t, types.ErrNoUnbondingDelegation(types.DefaultCodespace).Code(), err.Code())
+
+	// the unbonding tokens are delegated back without any transfer
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, sdk.NewDec(24e8), validator.Tokens)
+	require.Equal(t, escrowed, keeper.bankKeeper.GetCoins(ctx, DelegationAccAddr).AmountOf(bondDenom))
+}
+
 func TestUndelegateSelfDelegation(t *testing.T) {
 
 	ctx, _, keeper := CreateTestInput(t, false, 0)
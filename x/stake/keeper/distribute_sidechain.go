@@ -132,7 +132,6 @@ func (k Keeper) DistributeInBreathBlock(ctx sdk.Context, sideChainId string) {
 		totalReward := distAccCoins.AmountOf(bondDenom)
 		totalRewardDec := sdk.ZeroDec()
 		commission := sdk.ZeroDec()
-		rewards := make([]types.PreReward, 0)
 		if totalReward > 0 {
 			delegations, found := k.GetSimplifiedDelegations(ctx, height, validator.OperatorAddr)
 			if !found {
@@ -140,8 +139,10 @@ func (k Keeper) DistributeInBreathBlock(ctx sdk.Context, sideChainId string) {
 			}
 			totalRewardDec = sdk.NewDec(totalReward)
 
+			var rewards []types.Reward
+			commission, rewards = calcSideChainRewards(validator, delegations, totalReward)
+
 			//distribute commission
-			commission = totalRewardDec.Mul(validator.Commission.Rate)
 			if commission.RawInt() > 0 {
 				if _, _, err := k.bankKeeper.AddCoins(ctx, validator.GetFeeAddr(), sdk.Coins{sdk.NewCoin(bondDenom, commission.RawInt())}); err != nil {
 					panic(err)
@@ -151,23 +152,7 @@ func (k Keeper) DistributeInBreathBlock(ctx sdk.Context, sideChainId string) {
 				}
 			}
 
-			//calculate rewards for delegators
-			remainReward := totalRewardDec.Sub(commission)
-			rewards = allocate(simDelsToSharers(delegations), remainReward)
-			for i := range rewards {
-				// previous tokens calculation is in `node` repo, move it to here
-				tokens, err := sdk.MulQuoDec(validator.GetTokens(), rewards[i].Shares, validator.GetDelegatorShares())
-				if err != nil {
-					panic(err)
-				}
-				toSaveReward := types.Reward{
-					ValAddr: validator.GetOperator(),
-					AccAddr: rewards[i].AccAddr,
-					Tokens:  tokens,
-					Amount:  rewards[i].Amount,
-				}
-				toSaveRewards = append(toSaveRewards, toSaveReward)
-			}
+			toSaveRewards = append(toSaveRewards, rewards...)
 
 			//track validator and distribution address mapping
 			toSaveValDistAddrs = append(toSaveValDistAddrs, types.StoredValDistAddr{
@@ -268,6 +253,10 @@ func (k Keeper) distributeSingleBatch(ctx sdk.Context, sideChainId string) {
 			panic(err)
 		}
 
+		if sdk.IsUpgrade(sdk.SideChainAutoCompound) && k.IsAutoCompound(ctx, reward.AccAddr) {
+			k.compoundReward(ctx, sideChainId, reward)
+		}
+
 		toPublishRewards = append(toPublishRewards, reward)
 		changedAddrs = append(changedAddrs, reward.AccAddr)
 	}
@@ -325,6 +314,79 @@ func getDistributionBatchSize(batchSize, totalRewardLen int64) int64 {
 	return batchSize
 }
 
+// calcSideChainRewards computes the commission of the validator and the rewards of its delegators from the total reward
+func calcSideChainRewards(validator types.Validator, delegations []types.SimplifiedDelegation, totalReward int64) (commission sdk.Dec, rewards []types.Reward) {
+	totalRewardDec := sdk.NewDec(totalReward)
+	commission = totalRewardDec.Mul(validator.Commission.Rate)
+	remainReward := totalRewardDec.Sub(commission)
+	preRewards := allocate(simDelsToSharers(delegations), remainReward)
+	rewards = make([]types.Reward, 0, len(preRewards))
+	for i := range preRewards {
+		// previous tokens calculation is in `node` repo, move it to here
+		tokens, err := sdk.MulQuoDec(validator.GetTokens(), preRewards[i].Shares, validator.GetDelegatorShares())
+		if err != nil {
+			panic(err)
+		}
+		rewards = append(rewards, types.Reward{
+			ValAddr: validator.GetOperator(),
+			AccAddr: preRewards[i].AccAddr,
+			Tokens:  tokens,
+			Amount:  preRewards[i].Amount,
+		})
+	}
+	return commission, rewards
+}
+
+// ProjectSideChainRewards computes read-only the rewards of the next distribution in the breathe block as
+// DistributeInBreathBlock does, with the rewards collected in the distribution addresses so far. The rewards of the
+// previous distribution not paid yet are excluded. It returns the height of the validator snapshot rewarded,
+// or an error if the snapshot or the delegations of it are not found.
+func (k Keeper) ProjectSideChainRewards(ctx sdk.Context) (int64, []types.RewardProjection, sdk.Error) {
+	// a new snapshot is stored in the next breathe block before the distribution
+	validators, height, found := k.GetHeightValidatorsByIndex(ctx, daysBackwardForValidatorSnapshot-1)
+	if !found {
+		return 0, nil, sdk.ErrInternal("no validator snapshot to distribute the rewards to")
+	}
+
+	pendingRewards := make(map[string]int64)
+	store := ctx.KVStore(k.rewardStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, RewardBatchKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		for _, reward := range types.MustUnmarshalRewards(k.cdc, iterator.Value()) {
+			pendingRewards[reward.ValAddr.String()] += reward.Amount
+		}
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	projections := make([]types.RewardProjection, 0, len(validators))
+	for _, validator := range validators {
+		totalReward := k.bankKeeper.GetCoins(ctx, validator.DistributionAddr).AmountOf(bondDenom) -
+			pendingRewards[validator.OperatorAddr.String()]
+		projection := types.RewardProjection{
+			Validator:      validator.OperatorAddr,
+			DistributeAddr: validator.DistributionAddr,
+			FeeAddr:        validator.FeeAddr,
+		}
+		if totalReward > 0 {
+			delegations, found := k.GetSimplifiedDelegations(ctx, height, validator.OperatorAddr)
+			if !found {
+				return 0, nil, sdk.ErrInternal(fmt.Sprintf("no delegations found with height=%d, validator=%s", height, validator.OperatorAddr))
+			}
+			commission, rewards := calcSideChainRewards(validator, delegations, totalReward)
+			projection.TotalReward = totalReward
+			projection.Commission = commission.RawInt()
+			projection.Rewards = rewards
+			projection.Residue = totalReward - projection.Commission
+			for _, reward := range rewards {
+				projection.Residue -= reward.Amount
+			}
+		}
+		projections = append(projections, projection)
+	}
+	return height, projections, nil
+}
+
 func simDelsToSharers(simDels []types.SimplifiedDelegation) []types.Sharer {
 	sharers := make([]types.Sharer, len(simDels))
 	for i, del := range simDels {
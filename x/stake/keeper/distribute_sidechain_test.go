@@ -269,3 +269,152 @@ func TestDistributeInBlock(t *testing.T) {
 	_, found := k.getRewardValDistAddrs(ctx)
 	require.True(t, !found)
 }
+
+func TestProjectSideChainRewards(t *testing.T) {
+	ctx, am, k, height, validators, _, rewards, totalDelNum := prepare(t)
+	bondDenom := k.BondDenom(ctx)
+
+	// one day before the distribution of the validators at the height
+	k.RemoveValidatorsByHeight(ctx, 3000)
+	delegations := make([][]types.SimplifiedDelegation, len(validators))
+	for i, validator := range validators {
+		delegations[i], _ = k.GetSimplifiedDelegations(ctx, height, validator.OperatorAddr)
+	}
+
+	snapshotHeight, projections, sdkErr := k.ProjectSideChainRewards(ctx)
+	require.Nil(t, sdkErr)
+	require.Equal(t, height, snapshotHeight)
+	require.Len(t, projections, len(validators))
+	projected := make(map[string]types.Reward)
+	for i, projection := range projections {
+		require.Equal(t, rewards[i], projection.TotalReward)
+		require.Equal(t, sdk.NewDec(rewards[i]).Mul(validators[i].Commission.Rate).RawInt(), projection.Commission)
+		distributed := projection.Commission + projection.Residue
+		for _, reward := range projection.Rewards {
+			projected[reward.ValAddr.String()+reward.AccAddr.String()] = reward
+			distributed += reward.Amount
+		}
+		require.Equal(t, rewards[i], distributed)
+	}
+	require.Len(t, projected, totalDelNum)
+
+	// the projection is read-only
+	for i, validator := range validators {
+		require.Equal(t, rewards[i], am.GetAccount(ctx, validator.DistributionAddr).GetCoins().AmountOf(bondDenom))
+	}
+
+	// the projected rewards are the same as distributed
+	k.SetValidatorsByHeight(ctx, 3000, make([]types.Validator, 0))
+	k.DistributeInBreathBlock(ctx, "")
+	var savedRewards []types.Reward
+	for batchNo := int64(0); batchNo < k.countBatchRewards(ctx); batchNo++ {
+		bz := ctx.KVStore(k.rewardStoreKey).Get(getRewardBatchKey(batchNo))
+		savedRewards = append(savedRewards, types.MustUnmarshalRewards(k.cdc, bz)...)
+	}
+	require.Len(t, savedRewards, totalDelNum)
+	for _, reward := range savedRewards {
+		require.Equal(t, projected[reward.ValAddr.String()+reward.AccAddr.String()], reward)
+	}
+
+	// the rewards not paid yet are excluded from the next distribution
+	for i, validator := range validators {
+		k.SetSimplifiedDelegations(ctx, 4000, validator.OperatorAddr, delegations[i])
+	}
+	k.SetValidatorsByHeight(ctx, 4000, validators)
+	k.SetValidatorsByHeight(ctx, 5000, make([]types.Validator, 0))
+	_, _, err := k.bankKeeper.AddCoins(ctx, validators[0].DistributionAddr, sdk.Coins{sdk.NewCoin(bondDenom, 1e8)})
+	require.NoError(t, err)
+
+	snapshotHeight, projections, sdkErr = k.ProjectSideChainRewards(ctx)
+	require.Nil(t, sdkErr)
+	require.EqualValues(t, 4000, snapshotHeight)
+	require.EqualValues(t, 1e8, projections[0].TotalReward)
+	for _, projection := range projections[1:] {
+		require.Zero(t, projection.TotalReward)
+		require.Empty(t, projection.Rewards)
+	}
+
+	// the missing delegations of a rewarded validator fail the projection instead of panicking
+	k.RemoveSimplifiedDelegations(ctx, 4000, validators[0].OperatorAddr)
+	_, _, sdkErr = k.ProjectSideChainRewards(ctx)
+	require.NotNil(t, sdkErr)
+}
+
+func TestDistributeInBlockAutoCompound(t *testing.T) {
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainAutoCompound, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainAutoCompound, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	ctx, am, k := CreateTestInput(t, false, 100)
+	initCoins := sdk.NewDecWithoutFra(100).RawInt()
+	bondDenom := k.BondDenom(ctx)
+	pool := k.GetPool(ctx)
+
+	validators := make([]types.Validator, 2)
+	for i := range validators {
+		validators[i] = types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, 10e8)
+		validators[i].FeeAddr = sdk.AccAddress(addrVals[i])
+		validators[i].DistributionAddr = Addrs[10+i]
+	}
+	k.SetPool(ctx, pool)
+	validators[0].FeeAddr = Addrs[23]
+	validators[0] = TestingUpdateValidator(k, ctx, validators[0])
+	validators[1].Jailed = true
+	validators[1] = TestingUpdateValidator(k, ctx, validators[1])
+
+	compounded, paid, tooSmall := Addrs[20], Addrs[21], Addrs[22]
+	operator := sdk.AccAddress(validators[0].OperatorAddr)
+	rewards := []types.Reward{
+		{ValAddr: validators[0].OperatorAddr, AccAddr: compounded, Amount: 2e8},
+		{ValAddr: validators[0].OperatorAddr, AccAddr: paid, Amount: 2e8},
+		{ValAddr: validators[0].OperatorAddr, AccAddr: tooSmall, Amount: 1e7},
+		// the operator is not allowed to delegate if it is not the self-delegator
+		{ValAddr: validators[0].OperatorAddr, AccAddr: operator, Amount: 2e8},
+		// only the self-delegator can delegate to the jailed validator
+		{ValAddr: validators[1].OperatorAddr, AccAddr: compounded, Amount: 3e8},
+	}
+	k.setBatchRewards(ctx, 0, rewards)
+	k.setRewardValDistAddrs(ctx, []types.StoredValDistAddr{
+		{Validator: validators[0].OperatorAddr, DistributeAddr: validators[0].DistributionAddr},
+		{Validator: validators[1].OperatorAddr, DistributeAddr: validators[1].DistributionAddr},
+	})
+	_, _, err := k.bankKeeper.AddCoins(ctx, validators[0].DistributionAddr, sdk.Coins{sdk.NewCoin(bondDenom, 61e7)})
+	require.NoError(t, err)
+	_, _, err = k.bankKeeper.AddCoins(ctx, validators[1].DistributionAddr, sdk.Coins{sdk.NewCoin(bondDenom, 3e8)})
+	require.NoError(t, err)
+
+	k.SetAutoCompound(ctx, compounded, true)
+	k.SetAutoCompound(ctx, tooSmall, true)
+	k.SetAutoCompound(ctx, operator, true)
+	k.SetAutoCompound(ctx, paid, true)
+	k.SetAutoCompound(ctx, paid, false)
+	require.True(t, k.IsAutoCompound(ctx, compounded))
+	require.False(t, k.IsAutoCompound(ctx, paid))
+
+	operatorBalance := k.bankKeeper.GetCoins(ctx, operator).AmountOf(bondDenom)
+	k.DistributeInBlock(ctx, "")
+	require.False(t, k.hasNextBatchRewards(ctx))
+
+	delegation, found := k.GetDelegation(ctx, compounded, validators[0].OperatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2e8), delegation.Shares)
+	validator, _ := k.GetValidator(ctx, validators[0].OperatorAddr)
+	require.Equal(t, sdk.NewDec(12e8), validator.Tokens)
+
+	require.EqualValues(t, initCoins+3e8, am.GetAccount(ctx, compounded).GetCoins().AmountOf(bondDenom))
+	require.EqualValues(t, initCoins+2e8, am.GetAccount(ctx, paid).GetCoins().AmountOf(bondDenom))
+	require.EqualValues(t, initCoins+1e7, am.GetAccount(ctx, tooSmall).GetCoins().AmountOf(bondDenom))
+	_, found = k.GetDelegation(ctx, paid, validators[0].OperatorAddr)
+	require.False(t, found)
+	_, found = k.GetDelegation(ctx, tooSmall, validators[0].OperatorAddr)
+	require.False(t, found)
+	_, found = k.GetDelegation(ctx, compounded, validators[1].OperatorAddr)
+	require.False(t, found)
+	require.EqualValues(t, operatorBalance+2e8, k.bankKeeper.GetCoins(ctx, operator).AmountOf(bondDenom))
+	_, found = k.GetDelegation(ctx, operator, validators[0].OperatorAddr)
+	require.False(t, found)
+	for _, validator := range validators {
+		require.Equal(t, initCoins, am.GetAccount(ctx, validator.DistributionAddr).GetCoins().AmountOf(bondDenom))
+	}
+}
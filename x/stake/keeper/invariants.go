@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// RegisterInvariants registers the stake invariants
+func RegisterInvariants(ir sdk.InvariantRouter, k Keeper) {
+	ir.RegisterRoute(types.MsgRoute, "delegation-account", DelegationAccountInvariant(k))
+	ir.RegisterRoute(types.MsgRoute, "bonded-tokens", BondedTokensInvariant(k))
+	ir.RegisterRoute(types.MsgRoute, "positive-power", PositivePowerInvariant(k))
+}
+
+// DelegationAccountInvariant checks that the delegation account holds the tokens of the side chain validators and
+// unbonding delegations. The tokens of the main chain are not counted since the main chain slashes burn them without
+// moving the coins out of the account.
+func DelegationAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		if k.ScKeeper == nil {
+			return nil
+		}
+
+		bondDenom := k.BondDenom(ctx)
+		balance := k.bankKeeper.GetCoins(ctx, DelegationAccAddr).AmountOf(bondDenom)
+
+		expected := int64(0)
+		_, storePrefixes := k.ScKeeper.GetAllSideChainPrefixes(ctx)
+		for _, storePrefix := range storePrefixes {
+			sideChainCtx := ctx.WithSideChainKeyPrefix(storePrefix)
+			k.IterateValidators(sideChainCtx, func(_ int64, validator sdk.Validator) bool {
+				expected += validator.GetTokens().RawInt()
+				return false
+			})
+			k.IterateUnbondingDelegations(sideChainCtx, func(_ int64, ubd types.UnbondingDelegation) bool {
+				expected += ubd.Balance.Amount
+				return false
+			})
+		}
+
+		if balance < expected {
+			return fmt.Errorf("delegation account holds %d%s, less than the %d%s of the side chain validators and unbonding delegations",
+				balance, bondDenom, expected, bondDenom)
+		}
+		return nil
+	}
+}
+
+// BondedTokensInvariant checks that the bonded tokens of the pool equal the tokens of the bonded validators, on the
+// main chain and on each side chain
+func BondedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		if err := checkBondedTokens(ctx, k, "main chain"); err != nil {
+			return err
+		}
+		if k.ScKeeper == nil {
+			return nil
+		}
+
+		sideChainIds, storePrefixes := k.ScKeeper.GetAllSideChainPrefixes(ctx)
+		for i := range storePrefixes {
+			if err := checkBondedTokens(ctx.WithSideChainKeyPrefix(storePrefixes[i]), k, sideChainIds[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func checkBondedTokens(ctx sdk.Context, k Keeper, chainId string) error {
+	pool := k.GetPool(ctx)
+
+	bonded := sdk.ZeroDec()
+	k.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
+		if validator.GetStatus() == sdk.Bonded {
+			bonded = bonded.Add(validator.GetTokens())
+		}
+		return false
+	})
+
+	if !pool.BondedTokens.Equal(bonded) {
+		return fmt.Errorf("bonded tokens of the pool do not equal the tokens of the bonded validators on %s - pool.BondedTokens: %v, sum of bonded validator tokens: %v",
+			chainId, pool.BondedTokens, bonded)
+	}
+	return nil
+}
+
+// PositivePowerInvariant checks that all the bonded validators of the main chain have a positive power
+func PositivePowerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var err error
+		k.IterateValidatorsBonded(ctx, func(_ int64, validator sdk.Validator) bool {
+			if !validator.GetPower().GT(sdk.ZeroDec()) {
+				err = fmt.Errorf("validator with non-positive power stored. (pubkey %v)", validator.GetConsPubKey())
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestStakeInvariants(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100000)
+	bondDenom := keeper.BondDenom(ctx)
+	require.Nil(t, DelegationAccountInvariant(keeper)(ctx))
+	require.Nil(t, BondedTokensInvariant(keeper)(ctx))
+	require.Nil(t, PositivePowerInvariant(keeper)(ctx))
+
+	keeper.ScKeeper.SetSideChainIdAndStorePrefix(ctx, "bsc", []byte{0x99})
+	sideChainCtx := ctx.WithSideChainKeyPrefix([]byte{0x99})
+	keeper.SetPool(sideChainCtx, types.InitialPool())
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator.Tokens = sdk.NewDecWithoutFra(1000)
+	keeper.SetValidator(sideChainCtx, validator)
+	keeper.SetUnbondingDelegation(sideChainCtx, types.UnbondingDelegation{
+		DelegatorAddr:  addrDels[0],
+		ValidatorAddr:  addrVals[0],
+		InitialBalance: sdk.NewCoin(bondDenom, 100e8),
+		Balance:        sdk.NewCoin(bondDenom, 100e8),
+	})
+
+	// the delegation account must hold the tokens of the side chain validators and unbonding delegations
+	require.NotNil(t, DelegationAccountInvariant(keeper)(ctx))
+	_, _, err := keeper.bankKeeper.AddCoins(ctx, DelegationAccAddr, sdk.Coins{sdk.NewCoin(bondDenom, 1100e8)})
+	require.Nil(t, err)
+	require.Nil(t, DelegationAccountInvariant(keeper)(ctx))
+
+	// the side chain pool must track the tokens of the bonded validators
+	validator.Status = sdk.Bonded
+	keeper.SetValidator(sideChainCtx, validator)
+	require.NotNil(t, BondedTokensInvariant(keeper)(ctx))
+	pool := keeper.GetPool(sideChainCtx)
+	pool.BondedTokens = validator.Tokens
+	keeper.SetPool(sideChainCtx, pool)
+	require.Nil(t, BondedTokensInvariant(keeper)(ctx))
+}
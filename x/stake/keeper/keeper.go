@@ -64,6 +64,13 @@ func (k Keeper) initIbc() {
 	if err != nil {
 		panic(fmt.Sprintf("register ibc channel failed, channel=%s, err=%s", ChannelName, err.Error()))
 	}
+	err = k.ScKeeper.RegisterPayloadSchema(ChannelId, sTypes.PayloadSchema{
+		Syn: sTypes.RLPPayloadDecoder(types.IbcValidatorSetPackage{}),
+		Ack: sTypes.RLPPayloadDecoder(sTypes.CommonAckPackage{}),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("register payload schema failed, channel=%s, err=%s", ChannelName, err.Error()))
+	}
 }
 
 func (k *Keeper) SetupForSideChain(scKeeper *sidechain.Keeper, ibcKeeper *ibc.Keeper) {
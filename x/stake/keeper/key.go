@@ -42,6 +42,17 @@ var (
 
 	SideChainStorePrefixByIdKey = []byte{0x51} // prefix for each key to a side chain store prefix, by side chain id
 
+	AutoCompoundKey     = []byte{0x61} // prefix for each key to the auto-compounding setting of a delegator
+	CommissionChangeKey = []byte{0x62} // prefix for each key to the scheduled commission change of a validator
+
+	// Keys for the staking receipts, they are stored out of the side chain stores to keep the denominations unique
+	StakingReceiptKey         = []byte{0x63} // prefix for each key to a staking receipt, by denomination
+	StakingReceiptByValKey    = []byte{0x64} // prefix for each key to a staking receipt denomination, by side chain id and validator
+	StakingReceiptSequenceKey = []byte{0x65} // key for the sequence of the latest staking receipt
+
+	ValidatorSetHistoryKey = []byte{0x66} // prefix for each key to the validator set changes, by height
+	SelfBondDeficiencyKey  = []byte{0x67} // prefix for each key to the self bond deficiency of a validator
+
 	// Keys for reward store prefix
 	RewardBatchKey       = []byte{0x01} // key for batch of rewards
 	RewardValDistAddrKey = []byte{0x02} // key for rewards' validator <-> distribution address mapping
@@ -143,6 +154,13 @@ func GetValidatorHeightKey(height int64) []byte {
 	return append(ValidatorsByHeightKey, bz...)
 }
 
+// gets the key for the validator set changes at the height
+func GetValidatorSetHistoryKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(ValidatorSetHistoryKey, bz...)
+}
+
 // gets the prefix for all unbonding delegations from a delegator
 func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	bz := sdk.FormatTimeBytes(timestamp)
@@ -327,3 +345,35 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		GetREDsToValDstIndexKey(valDstAddr),
 		delAddr.Bytes()...)
 }
+
+// gets the key for the auto-compounding setting of a delegator
+// VALUE: none, the delegator's rewards are auto-compounded if the key exists
+func GetAutoCompoundKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundKey, delAddr.Bytes()...)
+}
+
+// gets the key for the scheduled commission change of a validator
+// VALUE: stake/types.CommissionChange
+func GetCommissionChangeKey(valAddr sdk.ValAddress) []byte {
+	return append(CommissionChangeKey, valAddr.Bytes()...)
+}
+
+// gets the key for the self bond deficiency of the validator
+// VALUE: stake/types.SelfBondDeficiency
+func GetSelfBondDeficiencyKey(valAddr sdk.ValAddress) []byte {
+	return append(SelfBondDeficiencyKey, valAddr.Bytes()...)
+}
+
+// gets the key for the staking receipt of the denomination
+// VALUE: stake/types.StakingReceipt
+func GetStakingReceiptKey(denom string) []byte {
+	return append(StakingReceiptKey, []byte(denom)...)
+}
+
+// gets the key for the staking receipt denomination of the validator on the side chain
+// VALUE: denomination (string)
+func GetStakingReceiptByValKey(sideChainId string, valAddr sdk.ValAddress) []byte {
+	key := append(StakingReceiptByValKey, byte(len(sideChainId)))
+	key = append(key, []byte(sideChainId)...)
+	return append(key, valAddr.Bytes()...)
+}
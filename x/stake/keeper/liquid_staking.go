@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var (
+	// LiquidStakingAccAddr holds the pooled delegations of the staking receipts, its rewards are compounded automatically
+	LiquidStakingAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainStakeLiquidStaking")))
+)
+
+// GetStakingReceipt returns the staking receipt of the denomination
+func (k Keeper) GetStakingReceipt(ctx sdk.Context, denom string) (receipt types.StakingReceipt, found bool) {
+	store := ctx.DepriveSideChainKeyPrefix().KVStore(k.storeKey)
+	bz := store.Get(GetStakingReceiptKey(denom))
+	if bz == nil {
+		return receipt, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &receipt)
+	return receipt, true
+}
+
+// GetStakingReceiptByValidator returns the staking receipt of the validator on the side chain
+func (k Keeper) GetStakingReceiptByValidator(ctx sdk.Context, sideChainId string, valAddr sdk.ValAddress) (receipt types.StakingReceipt, found bool) {
+	store := ctx.DepriveSideChainKeyPrefix().KVStore(k.storeKey)
+	denom := store.Get(GetStakingReceiptByValKey(sideChainId, valAddr))
+	if denom == nil {
+		return receipt, false
+	}
+	return k.GetStakingReceipt(ctx, string(denom))
+}
+
+func (k Keeper) setStakingReceipt(ctx sdk.Context, receipt types.StakingReceipt) {
+	store := ctx.DepriveSideChainKeyPrefix().KVStore(k.storeKey)
+	store.Set(GetStakingReceiptKey(receipt.Denom), k.cdc.MustMarshalBinaryLengthPrefixed(receipt))
+}
+
+// createStakingReceipt creates the receipt of the validator with the denomination of the next sequence
+func (k Keeper) createStakingReceipt(ctx sdk.Context, sideChainId string, valAddr sdk.ValAddress) types.StakingReceipt {
+	store := ctx.DepriveSideChainKeyPrefix().KVStore(k.storeKey)
+	var sequence int64
+	if bz := store.Get(StakingReceiptSequenceKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &sequence)
+	}
+	sequence++
+	store.Set(StakingReceiptSequenceKey, k.cdc.MustMarshalBinaryLengthPrefixed(sequence))
+
+	receipt := types.StakingReceipt{
+		Denom:         types.ReceiptDenom(sequence),
+		SideChainId:   sideChainId,
+		ValidatorAddr: valAddr,
+	}
+	store.Set(GetStakingReceiptByValKey(sideChainId, valAddr), []byte(receipt.Denom))
+	k.setStakingReceipt(ctx, receipt)
+	return receipt
+}
+
+// compoundPooledReward adds the reward of the pooled delegation to the pending rewards of the validator's receipt, and
+// delegates all the pending rewards once they can be delegated, e.g. they are no less than the minimal delegation.
+func (k Keeper) compoundPooledReward(ctx sdk.Context, sideChainId string, reward types.Reward) {
+	receipt, found := k.GetStakingReceiptByValidator(ctx, sideChainId, reward.ValAddr)
+	if !found {
+		k.Logger(ctx).Error("no staking receipt of the pooled delegation", "validator", reward.ValAddr.String())
+		return
+	}
+	receipt.PendingRewards += reward.Amount
+	pending := reward
+	pending.Amount = receipt.PendingRewards
+	if k.delegateReward(ctx, sideChainId, pending) {
+		receipt.PendingRewards = 0
+	}
+	k.setStakingReceipt(ctx, receipt)
+}
+
+// ReceiptExchangeRate returns the tokens a receipt is worth, including the pending rewards. The tokens of the pooled
+// delegation decrease if the validator is slashed, and increase when the rewards are compounded.
+func (k Keeper) ReceiptExchangeRate(ctx sdk.Context, receipt types.StakingReceipt) sdk.Dec {
+	if receipt.Supply == 0 {
+		return sdk.OneDec()
+	}
+	tokens := sdk.NewDec(receipt.PendingRewards)
+	if validator, found := k.GetValidator(ctx, receipt.ValidatorAddr); found {
+		if delegation, found := k.GetDelegation(ctx, LiquidStakingAccAddr, receipt.ValidatorAddr); found {
+			tokens = tokens.Add(validator.TokensFromShares(delegation.Shares))
+		}
+	}
+	rate, err := sdk.MulQuoDec(tokens, sdk.OneDec(), sdk.NewDec(receipt.Supply))
+	if err != nil {
+		panic(err)
+	}
+	return rate
+}
+
+// LiquidDelegate delegates the tokens of the delegator to the validator of the side chain in the context through the
+// liquid staking account, and mints the staking receipts of the validator to the delegator in proportion to the new
+// shares of the pooled delegation.
+func (k Keeper) LiquidDelegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Coin, validator types.Validator) (sdk.Coin, sdk.Error) {
+	sideChainId := ctx.SideChainId()
+	receipt, found := k.GetStakingReceiptByValidator(ctx, sideChainId, validator.OperatorAddr)
+	if !found {
+		receipt = k.createStakingReceipt(ctx, sideChainId, validator.OperatorAddr)
+	}
+
+	sharesBefore := sdk.ZeroDec()
+	if delegation, found := k.GetDelegation(ctx, LiquidStakingAccAddr, validator.OperatorAddr); found {
+		sharesBefore = delegation.Shares
+	}
+	// the pending rewards belong to the current holders, so they are valued as the shares they would be delegated for
+	if receipt.PendingRewards > 0 && !validator.Tokens.IsZero() {
+		sharesBefore = sharesBefore.Add(validator.SharesFromTokens(sdk.NewDec(receipt.PendingRewards)))
+	}
+
+	if err := k.transferBondTokens(ctx, delAddr, LiquidStakingAccAddr, bondAmt); err != nil {
+		return sdk.Coin{}, err
+	}
+	newShares, err := k.Delegate(ctx, LiquidStakingAccAddr, bondAmt, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// the rewards of the pooled delegations are delegated again, so that the receipts keep earning
+	k.SetAutoCompound(ctx, LiquidStakingAccAddr, true)
+
+	minted := newShares.RawInt()
+	if receipt.Supply != 0 && !sharesBefore.IsZero() {
+		amount, err := sdk.MulQuoDec(newShares, sdk.NewDec(receipt.Supply), sharesBefore)
+		if err != nil {
+			return sdk.Coin{}, sdk.ErrInternal(err.Error())
+		}
+		minted = amount.RawInt()
+	}
+	if minted <= 0 {
+		return sdk.Coin{}, types.ErrBadDelegationAmount(k.Codespace(), "the delegation is too small to mint any staking receipt")
+	}
+
+	coin := sdk.NewCoin(receipt.Denom, minted)
+	if _, _, err := k.bankKeeper.MintCoins(ctx, delAddr, sdk.Coins{coin}); err != nil {
+		return sdk.Coin{}, err
+	}
+	receipt.Supply += minted
+	k.setStakingReceipt(ctx, receipt)
+
+	if k.addrPool != nil {
+		k.addrPool.AddAddrs([]sdk.AccAddress{delAddr, LiquidStakingAccAddr})
+	}
+	return coin, nil
+}
+
+// LiquidUndelegate burns the staking receipts of the delegator, and unbonds the shares of the pooled delegation the
+// receipts are worth to an unbonding delegation of the delegator. The share of the pending rewards is paid at once.
+func (k Keeper) LiquidUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) (types.UnbondingDelegation, sdk.Error) {
+	receipt, found := k.GetStakingReceipt(ctx, amount.Denom)
+	if !found || receipt.SideChainId != ctx.SideChainId() {
+		return types.UnbondingDelegation{}, types.ErrNoStakingReceipt(k.Codespace())
+	}
+	if amount.Amount <= 0 || amount.Amount > receipt.Supply {
+		return types.UnbondingDelegation{}, types.ErrBadDelegationAmount(k.Codespace(), fmt.Sprintf("the amount must be positive and no more than the supply %d", receipt.Supply))
+	}
+	balance := k.bankKeeper.GetCoins(ctx, delAddr).AmountOf(receipt.Denom)
+	if balance < amount.Amount {
+		return types.UnbondingDelegation{}, sdk.ErrInsufficientCoins(fmt.Sprintf("No enough staking receipts to undelegate, balance: %d, amount: %d", balance, amount.Amount))
+	}
+
+	validator, found := k.GetValidator(ctx, receipt.ValidatorAddr)
+	if !found {
+		return types.UnbondingDelegation{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+	delegation, found := k.GetDelegation(ctx, LiquidStakingAccAddr, receipt.ValidatorAddr)
+	if !found {
+		return types.UnbondingDelegation{}, types.ErrNoDelegation(k.Codespace())
+	}
+	if _, found := k.GetUnbondingDelegation(ctx, delAddr, receipt.ValidatorAddr); found {
+		return types.UnbondingDelegation{}, types.ErrExistingUnbondingDelegation(k.Codespace())
+	}
+
+	// the shares and the pending rewards are rounded down in favor of the other holders of the receipts
+	shares, pending := delegation.Shares, receipt.PendingRewards
+	if amount.Amount != receipt.Supply {
+		var err error
+		if shares, err = sdk.MulQuoDec(delegation.Shares, sdk.NewDec(amount.Amount), sdk.NewDec(receipt.Supply)); err != nil {
+			return types.UnbondingDelegation{}, sdk.ErrInternal(err.Error())
+		}
+		pendingDec, err := sdk.MulQuoDec(sdk.NewDec(receipt.PendingRewards), sdk.NewDec(amount.Amount), sdk.NewDec(receipt.Supply))
+		if err != nil {
+			return types.UnbondingDelegation{}, sdk.ErrInternal(err.Error())
+		}
+		pending = pendingDec.RawInt()
+	}
+	if shares.IsZero() {
+		return types.UnbondingDelegation{}, types.ErrBadDelegationAmount(k.Codespace(), "the receipts are too few to undelegate any share")
+	}
+	// the same as undelegating, a small amount is only allowed if it is all the receipts of the delegator
+	minDelegationChange := k.MinDelegationChange(ctx)
+	if validator.TokensFromShares(shares).RawInt() < minDelegationChange && amount.Amount != balance {
+		return types.UnbondingDelegation{}, types.ErrBadDelegationAmount(k.Codespace(), fmt.Sprintf("the receipts must be worth no less than %d, or the amount is all the receipts", minDelegationChange))
+	}
+
+	if _, _, err := k.bankKeeper.BurnCoins(ctx, delAddr, sdk.Coins{amount}); err != nil {
+		return types.UnbondingDelegation{}, err
+	}
+	receipt.Supply -= amount.Amount
+	receipt.PendingRewards -= pending
+	k.setStakingReceipt(ctx, receipt)
+	if pending > 0 {
+		if _, err := k.bankKeeper.SendCoins(ctx, LiquidStakingAccAddr, delAddr, sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), pending)}); err != nil {
+			return types.UnbondingDelegation{}, err
+		}
+	}
+
+	returnAmount, err := k.unbond(ctx, LiquidStakingAccAddr, receipt.ValidatorAddr, shares)
+	if err != nil {
+		return types.UnbondingDelegation{}, err
+	}
+	if k.addrPool != nil {
+		k.addrPool.AddAddrs([]sdk.AccAddress{delAddr, LiquidStakingAccAddr})
+	}
+	return k.insertUnbondingDelegation(ctx, delAddr, receipt.ValidatorAddr, returnAmount), nil
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestLiquidStaking(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100)
+	initCoins := sdk.NewDecWithoutFra(100).RawInt()
+	bondDenom := keeper.BondDenom(ctx)
+
+	pool := keeper.GetPool(ctx)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, _ = validator.AddTokensFromDel(pool, 10e8)
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+
+	alice, bob := Addrs[20], Addrs[21]
+	receiptDenom := types.ReceiptDenom(1)
+
+	// the first delegation mints the receipts one to one
+	minted, err := keeper.LiquidDelegate(ctx, alice, sdk.NewCoin(bondDenom, 10e8), validator)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoin(receiptDenom, 10e8), minted)
+	require.Equal(t, initCoins-10e8, keeper.bankKeeper.GetCoins(ctx, alice).AmountOf(bondDenom))
+	require.EqualValues(t, 10e8, keeper.bankKeeper.GetCoins(ctx, alice).AmountOf(receiptDenom))
+	require.True(t, keeper.IsAutoCompound(ctx, LiquidStakingAccAddr))
+
+	receipt, found := keeper.GetStakingReceiptByValidator(ctx, "", addrVals[0])
+	require.True(t, found)
+	require.Equal(t, receiptDenom, receipt.Denom)
+	require.EqualValues(t, 10e8, receipt.Supply)
+	require.Equal(t, sdk.OneDec(), keeper.ReceiptExchangeRate(ctx, receipt))
+
+	// the receipts are worth half after the validator loses half of its tokens
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	validator = keeper.RemoveValidatorTokens(ctx, validator, sdk.NewDec(10e8))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), keeper.ReceiptExchangeRate(ctx, receipt))
+
+	// the later delegation gets the receipts at the current rate
+	minted, err = keeper.LiquidDelegate(ctx, bob, sdk.NewCoin(bondDenom, 5e8), validator)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoin(receiptDenom, 10e8), minted)
+	receipt, _ = keeper.GetStakingReceipt(ctx, receiptDenom)
+	require.EqualValues(t, 20e8, receipt.Supply)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), keeper.ReceiptExchangeRate(ctx, receipt))
+
+	// burn the receipts to unbond the tokens they are worth
+	ubd, err := keeper.LiquidUndelegate(ctx, alice, sdk.NewCoin(receiptDenom, 4e8))
+	require.Nil(t, err)
+	require.Equal(t, alice, ubd.DelegatorAddr)
+	require.Equal(t, sdk.NewCoin(bondDenom, 2e8), ubd.Balance)
+	require.EqualValues(t, 6e8, keeper.bankKeeper.GetCoins(ctx, alice).AmountOf(receiptDenom))
+	receipt, _ = keeper.GetStakingReceipt(ctx, receiptDenom)
+	require.EqualValues(t, 16e8, receipt.Supply)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), keeper.ReceiptExchangeRate(ctx, receipt))
+
+	_, err = keeper.LiquidUndelegate(ctx, alice, sdk.NewCoin(receiptDenom, 4e8))
+	require.Equal(t, types.ErrExistingUnbondingDelegation(types.DefaultCodespace).Code(), err.Code())
+	_, err = keeper.LiquidUndelegate(ctx, bob, sdk.NewCoin(receiptDenom, 11e8))
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+	_, err = keeper.LiquidUndelegate(ctx, bob, sdk.NewCoin(types.ReceiptDenom(2), 1e8))
+	require.Equal(t, types.ErrNoStakingReceipt(types.DefaultCodespace).Code(), err.Code())
+	// the receipts worth less than the min delegation change can only be burnt all at once
+	_, err = keeper.LiquidUndelegate(ctx, bob, sdk.NewCoin(receiptDenom, 1e8))
+	require.Equal(t, types.CodeInvalidDelegation, err.Code())
+
+	ubd, err = keeper.LiquidUndelegate(ctx, bob, sdk.NewCoin(receiptDenom, 10e8))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, 5e8), ubd.Balance)
+	require.EqualValues(t, 0, keeper.bankKeeper.GetCoins(ctx, bob).AmountOf(receiptDenom))
+	receipt, _ = keeper.GetStakingReceipt(ctx, receiptDenom)
+	require.EqualValues(t, 6e8, receipt.Supply)
+}
+
+func TestLiquidStakingPendingRewards(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100)
+	initCoins := sdk.NewDecWithoutFra(100).RawInt()
+	bondDenom := keeper.BondDenom(ctx)
+
+	pool := keeper.GetPool(ctx)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, _ = validator.AddTokensFromDel(pool, 10e8)
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+
+	alice, bob := Addrs[20], Addrs[21]
+	receiptDenom := types.ReceiptDenom(1)
+	_, err := keeper.LiquidDelegate(ctx, alice, sdk.NewCoin(bondDenom, 10e8), validator)
+	require.Nil(t, err)
+
+	// the reward less than the min delegation change is kept with the receipt
+	payReward := func(amount int64) {
+		_, _, err := keeper.bankKeeper.AddCoins(ctx, LiquidStakingAccAddr, sdk.Coins{sdk.NewCoin(bondDenom, amount)})
+		require.Nil(t, err)
+		keeper.compoundReward(ctx, "", types.Reward{ValAddr: addrVals[0], AccAddr: LiquidStakingAccAddr, Amount: amount})
+	}
+	payReward(5e7)
+	receipt, _ := keeper.GetStakingReceipt(ctx, receiptDenom)
+	require.EqualValues(t, 5e7, receipt.PendingRewards)
+	require.Equal(t, sdk.NewDecWithPrec(105, 2), keeper.ReceiptExchangeRate(ctx, receipt))
+
+	// the later delegation does not share the pending rewards
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	minted, err := keeper.LiquidDelegate(ctx, bob, sdk.NewCoin(bondDenom, 105e7), validator)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoin(receiptDenom, 10e8), minted)
+	receipt, _ = keeper.GetStakingReceipt(ctx, receiptDenom)
+	require.Equal(t, sdk.NewDecWithPrec(105, 2), keeper.ReceiptExchangeRate(ctx, receipt))
+
+	// the share of the pending rewards is paid when the receipts are burnt
+	ubd, err := keeper.LiquidUndelegate(ctx, alice, sdk.NewCoin(receiptDenom, 10e8))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoin(bondDenom, 1025e6), ubd.Balance)
+	require.Equal(t, initCoins-10e8+25e6, keeper.bankKeeper.GetCoins(ctx, alice).AmountOf(bondDenom))
+	receipt, _ = keeper.GetStakingReceipt(ctx, receiptDenom)
+	require.EqualValues(t, 25e6, receipt.PendingRewards)
+
+	// the pending rewards are delegated once they reach the min delegation change
+	payReward(8e7)
+	receipt, _ = keeper.GetStakingReceipt(ctx, receiptDenom)
+	require.EqualValues(t, 0, receipt.PendingRewards)
+	require.EqualValues(t, 0, keeper.bankKeeper.GetCoins(ctx, LiquidStakingAccAddr).AmountOf(bondDenom))
+	require.Equal(t, sdk.NewDecWithPrec(113, 2), keeper.ReceiptExchangeRate(ctx, receipt))
+}
@@ -56,6 +56,24 @@ func (k Keeper) RewardDistributionBatchSize(ctx sdk.Context) (res int64) {
 	return
 }
 
+// CommissionChangeDelay - the breathe blocks between announcing a commission change of a side chain validator and applying it
+func (k Keeper) CommissionChangeDelay(ctx sdk.Context) (res int64) {
+	k.paramstore.GetIfExists(ctx, types.KeyCommissionChangeDelay, &res)
+	return
+}
+
+// ValidatorHistoryRetention - the time the validator set changes are kept for
+func (k Keeper) ValidatorHistoryRetention(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.GetIfExists(ctx, types.KeyValidatorHistoryRetention, &res)
+	return
+}
+
+// SelfBondGracePeriod - the breathe blocks a validator can stay below the MinSelfDelegation before it is jailed
+func (k Keeper) SelfBondGracePeriod(ctx sdk.Context) (res int64) {
+	k.paramstore.GetIfExists(ctx, types.KeySelfBondGracePeriod, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.UnbondingTime = k.UnbondingTime(ctx)
@@ -64,6 +82,9 @@ func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.MinSelfDelegation = k.MinSelfDelegation(ctx)
 	res.MinDelegationChange = k.MinDelegationChange(ctx)
 	res.RewardDistributionBatchSize = k.RewardDistributionBatchSize(ctx)
+	res.CommissionChangeDelay = k.CommissionChangeDelay(ctx)
+	res.ValidatorHistoryRetention = k.ValidatorHistoryRetention(ctx)
+	res.SelfBondGracePeriod = k.SelfBondGracePeriod(ctx)
 	return
 }
 
@@ -105,6 +126,82 @@ func (p *paramBeforeBEP128Upgrade) KeyValuePairs() params.KeyValuePairs {
 	}
 }
 
+// in order to be compatible with before
+type paramBeforeCommissionScheduleUpgrade struct {
+	UnbondingTime time.Duration `json:"unbonding_time"`
+
+	MaxValidators               uint16 `json:"max_validators"`                 // maximum number of validators
+	BondDenom                   string `json:"bond_denom"`                     // bondable coin denomination
+	MinSelfDelegation           int64  `json:"min_self_delegation"`            // the minimal self-delegation amount
+	MinDelegationChange         int64  `json:"min_delegation_change"`          // the minimal delegation amount changed
+	RewardDistributionBatchSize int64  `json:"reward_distribution_batch_size"` // the batch size for distributing rewards in blocks
+}
+
+// Implements params.ParamSet
+func (p *paramBeforeCommissionScheduleUpgrade) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{types.KeyUnbondingTime, &p.UnbondingTime},
+		{types.KeyMaxValidators, &p.MaxValidators},
+		{types.KeyBondDenom, &p.BondDenom},
+		{types.KeyMinSelfDelegation, &p.MinSelfDelegation},
+		{types.KeyMinDelegationChange, &p.MinDelegationChange},
+		{types.KeyRewardDistributionBatchSize, &p.RewardDistributionBatchSize},
+	}
+}
+
+// in order to be compatible with before
+type paramBeforeValidatorSetHistoryUpgrade struct {
+	UnbondingTime time.Duration `json:"unbonding_time"`
+
+	MaxValidators               uint16 `json:"max_validators"`                 // maximum number of validators
+	BondDenom                   string `json:"bond_denom"`                     // bondable coin denomination
+	MinSelfDelegation           int64  `json:"min_self_delegation"`            // the minimal self-delegation amount
+	MinDelegationChange         int64  `json:"min_delegation_change"`          // the minimal delegation amount changed
+	RewardDistributionBatchSize int64  `json:"reward_distribution_batch_size"` // the batch size for distributing rewards in blocks
+	CommissionChangeDelay       int64  `json:"commission_change_delay"`        // the breathe blocks between announcing and applying a commission change
+}
+
+// Implements params.ParamSet
+func (p *paramBeforeValidatorSetHistoryUpgrade) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{types.KeyUnbondingTime, &p.UnbondingTime},
+		{types.KeyMaxValidators, &p.MaxValidators},
+		{types.KeyBondDenom, &p.BondDenom},
+		{types.KeyMinSelfDelegation, &p.MinSelfDelegation},
+		{types.KeyMinDelegationChange, &p.MinDelegationChange},
+		{types.KeyRewardDistributionBatchSize, &p.RewardDistributionBatchSize},
+		{types.KeyCommissionChangeDelay, &p.CommissionChangeDelay},
+	}
+}
+
+// in order to be compatible with before
+type paramBeforeSelfBondEnforcementUpgrade struct {
+	UnbondingTime time.Duration `json:"unbonding_time"`
+
+	MaxValidators               uint16 `json:"max_validators"`                 // maximum number of validators
+	BondDenom                   string `json:"bond_denom"`                     // bondable coin denomination
+	MinSelfDelegation           int64  `json:"min_self_delegation"`            // the minimal self-delegation amount
+	MinDelegationChange         int64  `json:"min_delegation_change"`          // the minimal delegation amount changed
+	RewardDistributionBatchSize int64  `json:"reward_distribution_batch_size"` // the batch size for distributing rewards in blocks
+	CommissionChangeDelay       int64  `json:"commission_change_delay"`        // the breathe blocks between announcing and applying a commission change
+
+	ValidatorHistoryRetention time.Duration `json:"validator_history_retention"` // the time the validator set changes are kept for, 0 keeps them forever
+}
+
+// Implements params.ParamSet
+func (p *paramBeforeSelfBondEnforcementUpgrade) KeyValuePairs() params.KeyValuePairs {
+	return params.KeyValuePairs{
+		{types.KeyUnbondingTime, &p.UnbondingTime},
+		{types.KeyMaxValidators, &p.MaxValidators},
+		{types.KeyBondDenom, &p.BondDenom},
+		{types.KeyMinSelfDelegation, &p.MinSelfDelegation},
+		{types.KeyMinDelegationChange, &p.MinDelegationChange},
+		{types.KeyRewardDistributionBatchSize, &p.RewardDistributionBatchSize},
+		{types.KeyCommissionChangeDelay, &p.CommissionChangeDelay},
+		{types.KeyValidatorHistoryRetention, &p.ValidatorHistoryRetention},
+	}
+}
+
 // set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	sdk.Upgrade(sdk.LaunchBscUpgrade, func() {
@@ -125,7 +222,46 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 			k.paramstore.SetParamSet(ctx, &pb)
 		}, nil, func() {
-			k.paramstore.SetParamSet(ctx, &params)
+			sdk.Upgrade(sdk.SideChainCommissionSchedule, func() {
+				var pb paramBeforeCommissionScheduleUpgrade
+				pb.UnbondingTime = params.UnbondingTime
+				pb.MaxValidators = params.MaxValidators
+				pb.BondDenom = params.BondDenom
+				pb.MinSelfDelegation = params.MinSelfDelegation
+				pb.MinDelegationChange = params.MinDelegationChange
+				pb.RewardDistributionBatchSize = params.RewardDistributionBatchSize
+
+				k.paramstore.SetParamSet(ctx, &pb)
+			}, nil, func() {
+				sdk.Upgrade(sdk.ValidatorSetHistory, func() {
+					var pb paramBeforeValidatorSetHistoryUpgrade
+					pb.UnbondingTime = params.UnbondingTime
+					pb.MaxValidators = params.MaxValidators
+					pb.BondDenom = params.BondDenom
+					pb.MinSelfDelegation = params.MinSelfDelegation
+					pb.MinDelegationChange = params.MinDelegationChange
+					pb.RewardDistributionBatchSize = params.RewardDistributionBatchSize
+					pb.CommissionChangeDelay = params.CommissionChangeDelay
+
+					k.paramstore.SetParamSet(ctx, &pb)
+				}, nil, func() {
+					sdk.Upgrade(sdk.SelfBondEnforcement, func() {
+						var pb paramBeforeSelfBondEnforcementUpgrade
+						pb.UnbondingTime = params.UnbondingTime
+						pb.MaxValidators = params.MaxValidators
+						pb.BondDenom = params.BondDenom
+						pb.MinSelfDelegation = params.MinSelfDelegation
+						pb.MinDelegationChange = params.MinDelegationChange
+						pb.RewardDistributionBatchSize = params.RewardDistributionBatchSize
+						pb.CommissionChangeDelay = params.CommissionChangeDelay
+						pb.ValidatorHistoryRetention = params.ValidatorHistoryRetention
+
+						k.paramstore.SetParamSet(ctx, &pb)
+					}, nil, func() {
+						k.paramstore.SetParamSet(ctx, &params)
+					})
+				})
+			})
 		})
 	})
 }
@@ -38,6 +38,12 @@ func TestSetParams(t *testing.T) {
 	k := NewKeeper(cdc, keyStake, keyStakeReward, tkeyStake, nil, nil, pk.Subspace(DefaultParamspace), types.DefaultCodespace)
 	sdk.UpgradeMgr.AddUpgradeHeight(sdk.LaunchBscUpgrade, 10)
 	sdk.UpgradeMgr.AddUpgradeHeight(sdk.BEP128, 100)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainCommissionSchedule, 200)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainCommissionSchedule, 0)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ValidatorSetHistory, 300)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.ValidatorSetHistory, 0)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SelfBondEnforcement, 400)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SelfBondEnforcement, 0)
 
 	sdk.UpgradeMgr.SetHeight(1)
 	k.SetParams(ctx, types.DefaultParams())
@@ -63,4 +69,21 @@ func TestSetParams(t *testing.T) {
 	require.True(t, k.paramstore.Has(ctx, types.KeyMinSelfDelegation))
 	require.True(t, k.paramstore.Has(ctx, types.KeyMinDelegationChange))
 	require.True(t, k.paramstore.Has(ctx, types.KeyRewardDistributionBatchSize))
+	require.False(t, k.paramstore.Has(ctx, types.KeyCommissionChangeDelay))
+
+	sdk.UpgradeMgr.SetHeight(200)
+	k.SetParams(ctx, types.DefaultParams())
+	require.True(t, k.paramstore.Has(ctx, types.KeyRewardDistributionBatchSize))
+	require.True(t, k.paramstore.Has(ctx, types.KeyCommissionChangeDelay))
+	require.False(t, k.paramstore.Has(ctx, types.KeyValidatorHistoryRetention))
+
+	sdk.UpgradeMgr.SetHeight(300)
+	k.SetParams(ctx, types.DefaultParams())
+	require.True(t, k.paramstore.Has(ctx, types.KeyValidatorHistoryRetention))
+	require.False(t, k.paramstore.Has(ctx, types.KeySelfBondGracePeriod))
+
+	sdk.UpgradeMgr.SetHeight(400)
+	k.SetParams(ctx, types.DefaultParams())
+	require.True(t, k.paramstore.Has(ctx, types.KeySelfBondGracePeriod))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
 }
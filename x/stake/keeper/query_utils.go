@@ -137,3 +137,77 @@ func (k Keeper) GetAllUnJailValidatorsCount(ctx sdk.Context) (count int64) {
 	}
 	return count
 }
+
+//_____________________________________________________________________________________
+
+// return a page of the validators in the order of the operator addresses, or from the highest power to the lowest
+// if byPower is set, the jailed validators are not in the power order. The validators can be filtered by the filter,
+// which can be nil.
+func (k Keeper) GetValidatorsPage(ctx sdk.Context, page sdk.PageRequest, byPower bool,
+	filter func(validator types.Validator) bool) (validators []types.Validator, nextKey []byte) {
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := ValidatorsKey
+	if byPower {
+		// the power index is sorted from the lowest power to the highest
+		prefix = ValidatorsByPowerIndexKey
+		page.Reverse = !page.Reverse
+	}
+
+	validators = make([]types.Validator, 0)
+	nextKey = sdk.Paginate(store, prefix, page, func(_, value []byte) bool {
+		var validator types.Validator
+		if byPower {
+			validator = k.mustGetValidator(ctx, value)
+		} else {
+			validator = types.MustUnmarshalValidator(k.cdc, value)
+		}
+		if filter != nil && !filter(validator) {
+			return false
+		}
+		validators = append(validators, validator)
+		return true
+	})
+	return validators, nextKey
+}
+
+// return a page of the delegations to a validator in the order of the delegator addresses, the delegations are only
+// indexed by the validators on the side chains
+func (k Keeper) GetValidatorDelegationsPage(ctx sdk.Context, valAddr sdk.ValAddress, page sdk.PageRequest) (
+	delegations []types.Delegation, nextKey []byte) {
+
+	store := ctx.KVStore(k.storeKey)
+	delegations = make([]types.Delegation, 0)
+	nextKey = sdk.Paginate(store, GetDelegationsKeyByVal(valAddr), page, func(key, value []byte) bool {
+		delegations = append(delegations, types.MustUnmarshalDelegationValAsKey(k.cdc, key, value))
+		return true
+	})
+	return delegations, nextKey
+}
+
+// return a page of the unbonding delegations from a validator in the order of the delegator addresses
+func (k Keeper) GetValidatorUnbondingDelegationsPage(ctx sdk.Context, valAddr sdk.ValAddress, page sdk.PageRequest) (
+	ubds []types.UnbondingDelegation, nextKey []byte) {
+
+	store := ctx.KVStore(k.storeKey)
+	ubds = make([]types.UnbondingDelegation, 0)
+	nextKey = sdk.Paginate(store, GetUBDsByValIndexKey(valAddr), page, func(indexKey, _ []byte) bool {
+		key := GetUBDKeyFromValIndexKey(indexKey)
+		ubds = append(ubds, types.MustUnmarshalUBD(k.cdc, key, store.Get(key)))
+		return true
+	})
+	return ubds, nextKey
+}
+
+// return a page of the delegations of a delegator in the order of the validator addresses
+func (k Keeper) GetDelegatorDelegationsPage(ctx sdk.Context, delAddr sdk.AccAddress, page sdk.PageRequest) (
+	delegations []types.Delegation, nextKey []byte) {
+
+	store := ctx.KVStore(k.storeKey)
+	delegations = make([]types.Delegation, 0)
+	nextKey = sdk.Paginate(store, GetDelegationsKey(delAddr), page, func(key, value []byte) bool {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, key, value))
+		return true
+	})
+	return delegations, nextKey
+}
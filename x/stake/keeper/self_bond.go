@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// GetSelfBondDeficiency returns the self bond deficiency of the validator
+func (k Keeper) GetSelfBondDeficiency(ctx sdk.Context, valAddr sdk.ValAddress) (deficiency types.SelfBondDeficiency, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetSelfBondDeficiencyKey(valAddr))
+	if bz == nil {
+		return deficiency, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deficiency)
+	return deficiency, true
+}
+
+// GetAllSelfBondDeficiencies returns the validators in the self bond grace period, ordered by the validator address
+func (k Keeper) GetAllSelfBondDeficiencies(ctx sdk.Context) (deficiencies []types.SelfBondDeficiency) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SelfBondDeficiencyKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deficiency types.SelfBondDeficiency
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deficiency)
+		deficiencies = append(deficiencies, deficiency)
+	}
+	return deficiencies
+}
+
+func (k Keeper) setSelfBondDeficiency(ctx sdk.Context, deficiency types.SelfBondDeficiency) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSelfBondDeficiencyKey(deficiency.ValidatorAddr), k.cdc.MustMarshalBinaryLengthPrefixed(deficiency))
+}
+
+func (k Keeper) removeSelfBondDeficiency(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSelfBondDeficiencyKey(valAddr))
+}
+
+// EnforceSelfBond checks the self delegations of the unjailed validators in a breathe block. A validator whose self
+// delegation drops below the MinSelfDelegation gets SelfBondGracePeriod breathe blocks to restore it, and it is jailed
+// once the grace period counts down to zero. The deficiencies of the restored, jailed or removed validators are dropped.
+func (k Keeper) EnforceSelfBond(ctx sdk.Context) (warned, jailed []types.SelfBondDeficiency) {
+	for _, deficiency := range k.GetAllSelfBondDeficiencies(ctx) {
+		validator, found := k.GetValidator(ctx, deficiency.ValidatorAddr)
+		if !found || validator.Jailed {
+			k.removeSelfBondDeficiency(ctx, deficiency.ValidatorAddr)
+		}
+	}
+
+	minSelfDelegation := k.MinSelfDelegation(ctx)
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Jailed {
+			continue
+		}
+
+		var selfDelegation int64
+		if delegation, found := k.GetDelegation(ctx, validator.FeeAddr, validator.OperatorAddr); found {
+			selfDelegation = validator.TokensFromShares(delegation.Shares).RawInt()
+		}
+
+		deficiency, found := k.GetSelfBondDeficiency(ctx, validator.OperatorAddr)
+		if selfDelegation >= minSelfDelegation {
+			if found {
+				k.removeSelfBondDeficiency(ctx, validator.OperatorAddr)
+			}
+			continue
+		}
+
+		if !found {
+			deficiency = types.SelfBondDeficiency{
+				ValidatorAddr:          validator.OperatorAddr,
+				StartHeight:            ctx.BlockHeight(),
+				RemainingBreatheBlocks: k.SelfBondGracePeriod(ctx),
+			}
+		} else {
+			deficiency.RemainingBreatheBlocks--
+		}
+		deficiency.SelfDelegation = selfDelegation
+		deficiency.MinSelfDelegation = minSelfDelegation
+
+		if deficiency.RemainingBreatheBlocks > 0 {
+			k.setSelfBondDeficiency(ctx, deficiency)
+			warned = append(warned, deficiency)
+			continue
+		}
+
+		k.jailValidator(ctx, validator)
+		k.OnSelfDelDropBelowMin(ctx, validator.OperatorAddr)
+		k.removeSelfBondDeficiency(ctx, validator.OperatorAddr)
+		jailed = append(jailed, deficiency)
+	}
+	return warned, jailed
+}
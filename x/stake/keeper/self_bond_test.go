@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestEnforceSelfBond(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(100)
+	bondDenom := keeper.BondDenom(ctx)
+
+	// the first validator has enough self delegation while the second one doesn't
+	for i, amount := range []int64{20000e8, 5000e8} {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+		_, err := keeper.Delegate(ctx, validator.FeeAddr, sdk.NewCoin(bondDenom, amount), validator, true)
+		require.Nil(t, err)
+	}
+
+	warned, jailed := keeper.EnforceSelfBond(ctx)
+	require.Empty(t, jailed)
+	require.Len(t, warned, 1)
+	require.Equal(t, addrVals[1], warned[0].ValidatorAddr)
+	require.EqualValues(t, 5000e8, warned[0].SelfDelegation)
+	require.EqualValues(t, 10000e8, warned[0].MinSelfDelegation)
+	require.EqualValues(t, 100, warned[0].StartHeight)
+	require.Equal(t, types.DefaultSelfBondGracePeriod, warned[0].RemainingBreatheBlocks)
+
+	// a raised minimum puts the first validator in the grace period too
+	params := keeper.GetParams(ctx)
+	params.MinSelfDelegation = 30000e8
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(200)
+	warned, jailed = keeper.EnforceSelfBond(ctx)
+	require.Empty(t, jailed)
+	require.Len(t, warned, 2)
+	require.Len(t, keeper.GetAllSelfBondDeficiencies(ctx), 2)
+	deficiency, found := keeper.GetSelfBondDeficiency(ctx, addrVals[1])
+	require.True(t, found)
+	require.EqualValues(t, 100, deficiency.StartHeight)
+	require.Equal(t, types.DefaultSelfBondGracePeriod-1, deficiency.RemainingBreatheBlocks)
+
+	// the first validator restores its self delegation in time
+	validator, _ := keeper.GetValidator(ctx, addrVals[0])
+	_, err := keeper.Delegate(ctx, validator.FeeAddr, sdk.NewCoin(bondDenom, 10000e8), validator, true)
+	require.Nil(t, err)
+	warned, jailed = keeper.EnforceSelfBond(ctx)
+	require.Empty(t, jailed)
+	require.Len(t, warned, 1)
+	_, found = keeper.GetSelfBondDeficiency(ctx, addrVals[0])
+	require.False(t, found)
+
+	// the second validator is jailed once the grace period runs out
+	warned, jailed = keeper.EnforceSelfBond(ctx)
+	require.Empty(t, warned)
+	require.Len(t, jailed, 1)
+	require.Equal(t, addrVals[1], jailed[0].ValidatorAddr)
+	validator, _ = keeper.GetValidator(ctx, addrVals[1])
+	require.True(t, validator.Jailed)
+	require.Empty(t, keeper.GetAllSelfBondDeficiencies(ctx))
+
+	// the jailed validator is not checked any more
+	warned, jailed = keeper.EnforceSelfBond(ctx)
+	require.Empty(t, warned)
+	require.Empty(t, jailed)
+}
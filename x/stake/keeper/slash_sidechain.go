@@ -10,12 +10,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
-func (k Keeper) SlashSideChain(ctx sdk.Context, sideChainId string, sideConsAddr []byte, slashAmount sdk.Dec) (sdk.Validator, sdk.Dec, error) {
+// SlashSideChain slashes the self delegation of the validator, and its unbonding delegation if the delegation is not
+// enough. It returns the validator, the slashed amount and the loss of the delegator.
+func (k Keeper) SlashSideChain(ctx sdk.Context, sideChainId string, sideConsAddr []byte, slashAmount sdk.Dec) (sdk.Validator, sdk.Dec, []sdk.DelegatorLoss, error) {
 	logger := ctx.Logger().With("module", "x/stake")
 
 	sideCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, sideChainId)
 	if err != nil {
-		return nil, sdk.ZeroDec(), errors.New("invalid side chain id")
+		return nil, sdk.ZeroDec(), nil, errors.New("invalid side chain id")
 	}
 
 	validator, found := k.GetValidatorBySideConsAddr(sideCtx, sideConsAddr)
@@ -27,18 +29,19 @@ func (k Keeper) SlashSideChain(ctx sdk.Context, sideChainId string, sideConsAddr
 		logger.Error(fmt.Sprintf(
 			"WARNING: Ignored attempt to slash a nonexistent validator with address %s, we recommend you investigate immediately",
 			sdk.HexEncode(sideConsAddr)))
-		return nil, sdk.ZeroDec(), nil
+		return nil, sdk.ZeroDec(), nil, nil
 	}
 
 	// should not be slashing unbonded
 	if validator.IsUnbonded() {
-		return nil, sdk.ZeroDec(), errors.New(fmt.Sprintf("should not be slashing unbonded validator: %s", validator.GetOperator()))
+		return nil, sdk.ZeroDec(), nil, errors.New(fmt.Sprintf("should not be slashing unbonded validator: %s", validator.GetOperator()))
 	}
 
 	if !validator.Jailed {
 		k.JailSideChain(sideCtx, sideConsAddr)
 	}
 
+	loss := sdk.DelegatorLoss{DelegatorAddr: validator.FeeAddr}
 	selfDelegation, found := k.GetDelegation(sideCtx, validator.FeeAddr, validator.OperatorAddr)
 	remainingSlashAmount := slashAmount
 	if found {
@@ -47,9 +50,10 @@ func (k Keeper) SlashSideChain(ctx sdk.Context, sideChainId string, sideConsAddr
 		if slashSelfDelegationShares.RawInt() > 0 {
 			unbondAmount, err := k.unbond(sideCtx, selfDelegation.DelegatorAddr, validator.OperatorAddr, slashSelfDelegationShares)
 			if err != nil {
-				return nil, sdk.ZeroDec(), errors.New(fmt.Sprintf("error unbonding delegator: %v", err))
+				return nil, sdk.ZeroDec(), nil, errors.New(fmt.Sprintf("error unbonding delegator: %v", err))
 			}
 			remainingSlashAmount = remainingSlashAmount.Sub(unbondAmount)
+			loss.DelegationAmt = unbondAmount.RawInt()
 		}
 	}
 
@@ -60,16 +64,21 @@ func (k Keeper) SlashSideChain(ctx sdk.Context, sideChainId string, sideConsAddr
 			ubd.Balance.Amount = ubd.Balance.Amount - slashUnBondingAmount
 			k.SetUnbondingDelegation(sideCtx, ubd)
 			remainingSlashAmount = remainingSlashAmount.Sub(sdk.NewDec(slashUnBondingAmount))
+			loss.UnbondingAmt = slashUnBondingAmount
 		}
 	}
 
 	slashedAmt := slashAmount.Sub(remainingSlashAmount)
+	var losses []sdk.DelegatorLoss
+	if loss.DelegationAmt > 0 || loss.UnbondingAmt > 0 {
+		losses = append(losses, loss)
+	}
 
 	bondDenom := k.BondDenom(ctx)
 	delegationAccBalance := k.bankKeeper.GetCoins(ctx, DelegationAccAddr)
 	slashedCoin := sdk.NewCoin(bondDenom, slashedAmt.RawInt())
 	if err := k.bankKeeper.SetCoins(ctx, DelegationAccAddr, delegationAccBalance.Minus(sdk.Coins{slashedCoin})); err != nil {
-		return nil, slashedAmt, err
+		return nil, slashedAmt, nil, err
 	}
 	if ctx.IsDeliverTx() && k.addrPool != nil {
 		k.addrPool.AddAddrs([]sdk.AccAddress{DelegationAccAddr})
@@ -87,11 +96,11 @@ func (k Keeper) SlashSideChain(ctx sdk.Context, sideChainId string, sideConsAddr
 			},
 		}
 		if _, err := k.SaveValidatorSetToIbc(ctx, sideChainId, ibcPackage); err != nil {
-			return nil, sdk.ZeroDec(), errors.New(err.Error())
+			return nil, sdk.ZeroDec(), nil, errors.New(err.Error())
 		}
 	}
 
-	return validator, slashedAmt, nil
+	return validator, slashedAmt, losses, nil
 
 }
 
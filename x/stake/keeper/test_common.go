@@ -135,6 +135,9 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initCoins int64) (sdk.Context
 	keeper.SetParams(ctx, types.DefaultParams())
 	sdk.UpgradeMgr.AddUpgradeHeight(sdk.LaunchBscUpgrade, 1)
 	sdk.UpgradeMgr.AddUpgradeHeight(sdk.BEP128, 100)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainCommissionSchedule, 100)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ValidatorSetHistory, 100)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SelfBondEnforcement, 100)
 	sdk.UpgradeMgr.Height = 100
 	keeper.SetParams(ctx, types.DefaultParams())
 	keeper.SetupForSideChain(&scKeeper, &ibcKeeper)
@@ -80,6 +80,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (newVals []ty
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(newPower)
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+			if !found {
+				k.recordValidatorSetChange(ctx, operator, types.ValidatorJoined, 0, newPower, validator.Commission.Rate)
+			} else {
+				var oldPower int64
+				k.cdc.MustUnmarshalBinaryLengthPrefixed(oldPowerBytes, &oldPower)
+				k.recordValidatorSetChange(ctx, operator, types.ValidatorPowerChanged, oldPower, newPower, validator.Commission.Rate)
+			}
 			// Note: side chain validators do not have ConsPubKey, and we do not need to collect the updates as well.
 			if validator.ConsPubKey != nil {
 				updates = append(updates, validator.ABCIValidatorUpdate())
@@ -104,6 +111,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (newVals []ty
 
 		// fetch the validator
 		validator := k.mustGetValidator(ctx, sdk.ValAddress(operator))
+		k.recordValidatorSetChange(ctx, validator.OperatorAddr, types.ValidatorLeft, k.GetLastValidatorPower(ctx, validator.OperatorAddr), 0, validator.Commission.Rate)
 
 		// bonded to unbonding
 		k.bondedToUnbonding(ctx, validator)
@@ -170,6 +178,10 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.DeleteValidatorByPowerIndex(ctx, validator)
+
+	// the validator leaves the set when the updates are applied next time
+	power := k.GetLastValidatorPower(ctx, validator.OperatorAddr)
+	k.recordValidatorSetChange(ctx, validator.OperatorAddr, types.ValidatorJailed, power, power, validator.Commission.Rate)
 }
 
 // remove a validator from jail
@@ -181,6 +193,9 @@ func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = false
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
+
+	power := k.GetLastValidatorPower(ctx, validator.OperatorAddr)
+	k.recordValidatorSetChange(ctx, validator.OperatorAddr, types.ValidatorUnjailed, power, power, validator.Commission.Rate)
 }
 
 // perform all the store operations for when a validator status becomes bonded
@@ -230,6 +230,8 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context, validator types.Valid
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
+	power := k.GetLastValidatorPower(ctx, validator.OperatorAddr)
+	k.recordValidatorSetChange(ctx, validator.OperatorAddr, types.ValidatorCommissionChanged, power, power, newRate)
 	return commission, nil
 }
 
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// GetValidatorSetChanges returns the validator set changes at the height
+func (k Keeper) GetValidatorSetChanges(ctx sdk.Context, height int64) (changes []types.ValidatorSetChange) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorSetHistoryKey(height))
+	if bz == nil {
+		return nil
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &changes)
+	return changes
+}
+
+// IterateValidatorSetHistory iterates the validator set changes height by height from the startHeight to the
+// endHeight, both inclusive. An endHeight of 0 iterates to the latest height.
+func (k Keeper) IterateValidatorSetHistory(ctx sdk.Context, startHeight, endHeight int64,
+	fn func(height int64, changes []types.ValidatorSetChange) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(ValidatorSetHistoryKey)
+	if endHeight > 0 {
+		end = GetValidatorSetHistoryKey(endHeight + 1)
+	}
+	iterator := store.Iterator(GetValidatorSetHistoryKey(startHeight), end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var changes []types.ValidatorSetChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &changes)
+		height := int64(binary.BigEndian.Uint64(iterator.Key()[len(ValidatorSetHistoryKey):]))
+		if fn(height, changes) {
+			break
+		}
+	}
+}
+
+// PruneValidatorSetHistory removes the validator set changes older than the ValidatorHistoryRetention
+func (k Keeper) PruneValidatorSetHistory(ctx sdk.Context) {
+	if !sdk.IsUpgrade(sdk.ValidatorSetHistory) {
+		return
+	}
+	retention := k.ValidatorHistoryRetention(ctx)
+	if retention == 0 {
+		return
+	}
+	cutoff := ctx.BlockHeader().Time.Add(-retention)
+
+	var expired []int64
+	k.IterateValidatorSetHistory(ctx, 0, 0, func(height int64, changes []types.ValidatorSetChange) bool {
+		// all the changes at a height share the same time
+		if len(changes) != 0 && !changes[0].Time.Before(cutoff) {
+			return true
+		}
+		expired = append(expired, height)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, height := range expired {
+		store.Delete(GetValidatorSetHistoryKey(height))
+	}
+}
+
+// recordValidatorSetChange appends a change of the validator to the history at the current height
+func (k Keeper) recordValidatorSetChange(ctx sdk.Context, valAddr sdk.ValAddress, changeType types.ValidatorChangeType,
+	powerBefore, powerAfter int64, commission sdk.Dec) {
+	if !sdk.IsUpgrade(sdk.ValidatorSetHistory) {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	changes := append(k.GetValidatorSetChanges(ctx, height), types.ValidatorSetChange{
+		Height:        height,
+		Time:          ctx.BlockHeader().Time,
+		ValidatorAddr: valAddr,
+		Type:          changeType,
+		PowerBefore:   powerBefore,
+		PowerAfter:    powerAfter,
+		Commission:    commission,
+	})
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorSetHistoryKey(height), k.cdc.MustMarshalBinaryLengthPrefixed(changes))
+}
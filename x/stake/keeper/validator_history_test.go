@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestValidatorSetHistory(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 100)
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(startTime)
+
+	pool := keeper.GetPool(ctx)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, _ = validator.AddTokensFromDel(pool, 10e8)
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	changes := keeper.GetValidatorSetChanges(ctx, 100)
+	require.Len(t, changes, 1)
+	require.Equal(t, types.ValidatorJoined, changes[0].Type)
+	require.Equal(t, addrVals[0], changes[0].ValidatorAddr)
+	require.EqualValues(t, 0, changes[0].PowerBefore)
+	require.EqualValues(t, 10e8, changes[0].PowerAfter)
+
+	// the power changes with the bonded tokens
+	ctx = ctx.WithBlockHeight(101).WithBlockTime(startTime.Add(time.Hour))
+	keeper.DeleteValidatorByPowerIndex(ctx, validator)
+	pool = keeper.GetPool(ctx)
+	validator, pool, _ = validator.AddTokensFromDel(pool, 5e8)
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+
+	changes = keeper.GetValidatorSetChanges(ctx, 101)
+	require.Len(t, changes, 1)
+	require.Equal(t, types.ValidatorPowerChanged, changes[0].Type)
+	require.EqualValues(t, 10e8, changes[0].PowerBefore)
+	require.EqualValues(t, 15e8, changes[0].PowerAfter)
+
+	// a jailed validator leaves the validator set
+	ctx = ctx.WithBlockHeight(102).WithBlockTime(startTime.Add(2 * time.Hour))
+	keeper.Jail(ctx, validator.GetConsAddr())
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	changes = keeper.GetValidatorSetChanges(ctx, 102)
+	require.Len(t, changes, 2)
+	require.Equal(t, types.ValidatorJailed, changes[0].Type)
+	require.EqualValues(t, 15e8, changes[0].PowerBefore)
+	require.Equal(t, types.ValidatorLeft, changes[1].Type)
+	require.EqualValues(t, 15e8, changes[1].PowerBefore)
+	require.EqualValues(t, 0, changes[1].PowerAfter)
+
+	ctx = ctx.WithBlockHeight(103).WithBlockTime(startTime.Add(3 * time.Hour))
+	keeper.Unjail(ctx, validator.GetConsAddr())
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	changes = keeper.GetValidatorSetChanges(ctx, 103)
+	require.Len(t, changes, 2)
+	require.Equal(t, types.ValidatorUnjailed, changes[0].Type)
+	require.Equal(t, types.ValidatorJoined, changes[1].Type)
+	require.EqualValues(t, 15e8, changes[1].PowerAfter)
+
+	// the commission changes are recorded with the new rate
+	ctx = ctx.WithBlockHeight(104).WithBlockTime(startTime.Add(4 * time.Hour))
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	validator.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+	_, err := keeper.UpdateValidatorCommission(ctx, validator, sdk.NewDecWithPrec(2, 1))
+	require.Nil(t, err)
+
+	changes = keeper.GetValidatorSetChanges(ctx, 104)
+	require.Len(t, changes, 1)
+	require.Equal(t, types.ValidatorCommissionChanged, changes[0].Type)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), changes[0].Commission)
+
+	var heights []int64
+	keeper.IterateValidatorSetHistory(ctx, 101, 103, func(height int64, changes []types.ValidatorSetChange) bool {
+		heights = append(heights, height)
+		return false
+	})
+	require.Equal(t, []int64{101, 102, 103}, heights)
+
+	// the changes older than the retention are pruned
+	ctx = ctx.WithBlockHeight(200).WithBlockTime(startTime.Add(keeper.ValidatorHistoryRetention(ctx) + 90*time.Minute))
+	keeper.PruneValidatorSetHistory(ctx)
+
+	heights = nil
+	keeper.IterateValidatorSetHistory(ctx, 0, 0, func(height int64, changes []types.ValidatorSetChange) bool {
+		heights = append(heights, height)
+		return false
+	})
+	require.Equal(t, []int64{102, 103, 104}, heights)
+}
@@ -0,0 +1,24 @@
+package stake
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func RegisterUpgradeBeginBlocker(keeper Keeper) {
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.SideChainCommissionSchedule, func(ctx sdk.Context) {
+		params := keeper.GetParams(ctx)
+		params.CommissionChangeDelay = types.DefaultCommissionChangeDelay
+		keeper.SetParams(ctx, params)
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.ValidatorSetHistory, func(ctx sdk.Context) {
+		params := keeper.GetParams(ctx)
+		params.ValidatorHistoryRetention = types.DefaultValidatorHistoryRetention
+		keeper.SetParams(ctx, params)
+	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.SelfBondEnforcement, func(ctx sdk.Context) {
+		params := keeper.GetParams(ctx)
+		params.SelfBondGracePeriod = types.DefaultSelfBondGracePeriod
+		keeper.SetParams(ctx, params)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 )
 
 // query endpoints supported by the staking Querier
@@ -30,8 +31,21 @@ const (
 	QueryTopValidators                 = "topValidators"
 	QueryAllValidatorsCount            = "allValidatorsCount"
 	QueryAllUnJailValidatorsCount      = "allUnJailValidatorsCount"
+	QuerySideChainRewardProjection     = "sideChainRewardProjection"
+	QuerySideChainCommissionChanges    = "sideChainCommissionChanges"
+	QuerySideChainStakingReceipt       = "sideChainStakingReceipt"
+	QueryValidatorSetHistory           = "validatorSetHistory"
+	QuerySelfBondDeficiencies          = "selfBondDeficiencies"
+
+	QueryValidatorsPage                    = "validatorsPage"
+	QueryValidatorDelegationsPage          = "validatorDelegationsPage"
+	QueryValidatorUnbondingDelegationsPage = "validatorUnbondingDelegationsPage"
+	QueryDelegatorDelegationsPage          = "delegatorDelegationsPage"
 )
 
+// the max number of the validator set changes returned by a 'custom/stake/validatorSetHistory' query
+const maxValidatorSetHistoryLimit = 1000
+
 // creates a querier for staking REST endpoints
 func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
@@ -148,6 +162,69 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 				return res, err
 			}
 			return queryAllUnJailValidatorsCount(ctx, cdc, k)
+		case QuerySideChainRewardProjection:
+			p := new(QueryRewardProjectionParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return querySideChainRewardProjection(ctx, cdc, p, k)
+		case QuerySideChainCommissionChanges:
+			p := new(QueryValidatorParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return querySideChainCommissionChanges(ctx, cdc, p, k)
+		case QuerySideChainStakingReceipt:
+			p := new(QueryStakingReceiptParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return querySideChainStakingReceipt(ctx, cdc, p, k)
+		case QueryValidatorSetHistory:
+			p := new(QueryValidatorSetHistoryParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryValidatorSetHistory(ctx, cdc, p, k)
+		case QuerySelfBondDeficiencies:
+			p := new(QueryValidatorParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return querySelfBondDeficiencies(ctx, cdc, p, k)
+		case QueryValidatorsPage:
+			p := new(QueryValidatorsPageParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryValidatorsPage(ctx, cdc, p, k)
+		case QueryValidatorDelegationsPage:
+			p := new(QueryValidatorPageParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryValidatorDelegationsPage(ctx, cdc, p, k)
+		case QueryValidatorUnbondingDelegationsPage:
+			p := new(QueryValidatorPageParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryValidatorUnbondingDelegationsPage(ctx, cdc, p, k)
+		case QueryDelegatorDelegationsPage:
+			p := new(QueryDelegatorPageParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryDelegatorDelegationsPage(ctx, cdc, p, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown stake query endpoint")
 		}
@@ -223,6 +300,96 @@ type QueryTopValidatorsParams struct {
 	Top int
 }
 
+// defines the params for 'custom/stake/sideChainRewardProjection', the projection can be filtered by the
+// validator and the delegator
+type QueryRewardProjectionParams struct {
+	BaseParams
+	ValidatorAddr sdk.ValAddress
+	DelegatorAddr sdk.AccAddress
+}
+
+// RewardProjectionResponse is the projected rewards of the validators in the snapshot at the height
+type RewardProjectionResponse struct {
+	SnapshotHeight int64                    `json:"snapshot_height"`
+	Projections    []types.RewardProjection `json:"projections"`
+}
+
+// defines the params for 'custom/stake/sideChainStakingReceipt', the receipt is queried by the denomination, or by
+// the validator if the denomination is empty
+type QueryStakingReceiptParams struct {
+	BaseParams
+	ValidatorAddr sdk.ValAddress
+	Denom         string
+}
+
+// StakingReceiptResponse is the staking receipt and the tokens each receipt is worth
+type StakingReceiptResponse struct {
+	Receipt      types.StakingReceipt `json:"receipt"`
+	ExchangeRate sdk.Dec              `json:"exchange_rate"`
+}
+
+// defines the params for 'custom/stake/validatorSetHistory', the changes from the StartHeight to the EndHeight are
+// returned height by height until there are Limit changes, the changes can be filtered by the validator
+type QueryValidatorSetHistoryParams struct {
+	BaseParams
+	ValidatorAddr sdk.ValAddress
+	StartHeight   int64
+	EndHeight     int64 // 0 for the latest height
+	Limit         int
+}
+
+// ValidatorSetHistoryResponse is a page of the validator set changes, the next page starts from the NextHeight, which
+// is 0 if there are no more changes
+type ValidatorSetHistoryResponse struct {
+	Changes    []types.ValidatorSetChange `json:"changes"`
+	NextHeight int64                      `json:"next_height"`
+}
+
+// defines the params for 'custom/stake/validatorsPage', the validators can be filtered by the status and whether
+// they are jailed, and are ordered by the power instead of the operator address if OrderByPower is set
+type QueryValidatorsPageParams struct {
+	BaseParams
+	Page         sdk.PageRequest
+	Status       string // Bonded, Unbonding or Unbonded, empty for all the statuses
+	Jailed       *bool  // nil for both the jailed and the unjailed validators
+	OrderByPower bool
+}
+
+// defines the params for the following queries:
+// - 'custom/stake/validatorDelegationsPage'
+// - 'custom/stake/validatorUnbondingDelegationsPage'
+type QueryValidatorPageParams struct {
+	BaseParams
+	ValidatorAddr sdk.ValAddress
+	Page          sdk.PageRequest
+}
+
+// defines the params for 'custom/stake/delegatorDelegationsPage'
+type QueryDelegatorPageParams struct {
+	BaseParams
+	DelegatorAddr sdk.AccAddress
+	Page          sdk.PageRequest
+}
+
+// ValidatorsPageResponse is a page of the validators, the next page starts from the NextKey, which is empty if there
+// are no more validators
+type ValidatorsPageResponse struct {
+	Validators []types.Validator `json:"validators"`
+	NextKey    cmn.HexBytes      `json:"next_key"`
+}
+
+// DelegationsPageResponse is a page of the delegations, the next page starts from the NextKey
+type DelegationsPageResponse struct {
+	Delegations []types.DelegationResponse `json:"delegations"`
+	NextKey     cmn.HexBytes               `json:"next_key"`
+}
+
+// UnbondingDelegationsPageResponse is a page of the unbonding delegations, the next page starts from the NextKey
+type UnbondingDelegationsPageResponse struct {
+	UnbondingDelegations []types.UnbondingDelegation `json:"unbonding_delegations"`
+	NextKey              cmn.HexBytes                `json:"next_key"`
+}
+
 func queryValidators(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
 	stakeParams := k.GetParams(ctx)
 	validators := k.GetValidators(ctx, stakeParams.MaxValidators)
@@ -421,6 +588,200 @@ func queryAllUnJailValidatorsCount(ctx sdk.Context, cdc *codec.Codec, k keep.Kee
 	return res, nil
 }
 
+func querySideChainRewardProjection(ctx sdk.Context, cdc *codec.Codec, params *QueryRewardProjectionParams, k keep.Keeper) ([]byte, sdk.Error) {
+	if len(params.SideChainId) == 0 {
+		return nil, types.ErrInvalidSideChainId(k.Codespace())
+	}
+	height, projections, err := k.ProjectSideChainRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]types.RewardProjection, 0, len(projections))
+	for _, projection := range projections {
+		if len(params.ValidatorAddr) != 0 && !projection.Validator.Equals(params.ValidatorAddr) {
+			continue
+		}
+		if len(params.DelegatorAddr) != 0 {
+			var rewards []types.Reward
+			for _, reward := range projection.Rewards {
+				if reward.AccAddr.Equals(params.DelegatorAddr) {
+					rewards = append(rewards, reward)
+				}
+			}
+			if len(rewards) == 0 {
+				continue
+			}
+			projection.Rewards = rewards
+		}
+		filtered = append(filtered, projection)
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, RewardProjectionResponse{SnapshotHeight: height, Projections: filtered})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func querySideChainCommissionChanges(ctx sdk.Context, cdc *codec.Codec, params *QueryValidatorParams, k keep.Keeper) ([]byte, sdk.Error) {
+	if len(params.SideChainId) == 0 {
+		return nil, types.ErrInvalidSideChainId(k.Codespace())
+	}
+
+	changes := make([]types.CommissionChange, 0)
+	if len(params.ValidatorAddr) != 0 {
+		if change, found := k.GetCommissionChange(ctx, params.ValidatorAddr); found {
+			changes = append(changes, change)
+		}
+	} else {
+		changes = append(changes, k.GetAllCommissionChanges(ctx)...)
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, changes)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func querySelfBondDeficiencies(ctx sdk.Context, cdc *codec.Codec, params *QueryValidatorParams, k keep.Keeper) ([]byte, sdk.Error) {
+	deficiencies := make([]types.SelfBondDeficiency, 0)
+	if len(params.ValidatorAddr) != 0 {
+		if deficiency, found := k.GetSelfBondDeficiency(ctx, params.ValidatorAddr); found {
+			deficiencies = append(deficiencies, deficiency)
+		}
+	} else {
+		deficiencies = append(deficiencies, k.GetAllSelfBondDeficiencies(ctx)...)
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, deficiencies)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func querySideChainStakingReceipt(ctx sdk.Context, cdc *codec.Codec, params *QueryStakingReceiptParams, k keep.Keeper) ([]byte, sdk.Error) {
+	if len(params.SideChainId) == 0 {
+		return nil, types.ErrInvalidSideChainId(k.Codespace())
+	}
+
+	var receipt types.StakingReceipt
+	var found bool
+	if len(params.Denom) != 0 {
+		receipt, found = k.GetStakingReceipt(ctx, params.Denom)
+	} else {
+		receipt, found = k.GetStakingReceiptByValidator(ctx, params.SideChainId, params.ValidatorAddr)
+	}
+	if !found || receipt.SideChainId != params.SideChainId {
+		return nil, types.ErrNoStakingReceipt(k.Codespace())
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, StakingReceiptResponse{Receipt: receipt, ExchangeRate: k.ReceiptExchangeRate(ctx, receipt)})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryValidatorSetHistory(ctx sdk.Context, cdc *codec.Codec, params *QueryValidatorSetHistoryParams, k keep.Keeper) ([]byte, sdk.Error) {
+	if params.StartHeight < 0 || params.EndHeight < 0 || (params.EndHeight != 0 && params.EndHeight < params.StartHeight) {
+		return nil, sdk.ErrUnknownRequest("invalid height range")
+	}
+	limit := params.Limit
+	if limit <= 0 || limit > maxValidatorSetHistoryLimit {
+		limit = maxValidatorSetHistoryLimit
+	}
+
+	response := ValidatorSetHistoryResponse{Changes: make([]types.ValidatorSetChange, 0)}
+	// the changes of a height are never split into two pages
+	k.IterateValidatorSetHistory(ctx, params.StartHeight, params.EndHeight, func(height int64, changes []types.ValidatorSetChange) bool {
+		if len(response.Changes) >= limit {
+			response.NextHeight = height
+			return true
+		}
+		for _, change := range changes {
+			if len(params.ValidatorAddr) == 0 || change.ValidatorAddr.Equals(params.ValidatorAddr) {
+				response.Changes = append(response.Changes, change)
+			}
+		}
+		return false
+	})
+
+	res, errRes := codec.MarshalJSONIndent(cdc, response)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryValidatorsPage(ctx sdk.Context, cdc *codec.Codec, params *QueryValidatorsPageParams, k keep.Keeper) ([]byte, sdk.Error) {
+	var status sdk.BondStatus
+	if len(params.Status) != 0 {
+		var errRes error
+		if status, errRes = sdk.BondStatusFromString(params.Status); errRes != nil {
+			return nil, sdk.ErrUnknownRequest(errRes.Error())
+		}
+	}
+
+	validators, nextKey := k.GetValidatorsPage(ctx, params.Page, params.OrderByPower, func(validator types.Validator) bool {
+		if len(params.Status) != 0 && validator.Status != status {
+			return false
+		}
+		return params.Jailed == nil || validator.Jailed == *params.Jailed
+	})
+
+	res, errRes := codec.MarshalJSONIndent(cdc, ValidatorsPageResponse{Validators: validators, NextKey: nextKey})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryValidatorDelegationsPage(ctx sdk.Context, cdc *codec.Codec, params *QueryValidatorPageParams, k keep.Keeper) ([]byte, sdk.Error) {
+	// the delegations are only indexed by the validators on the side chains
+	if len(params.SideChainId) == 0 {
+		return nil, types.ErrInvalidSideChainId(k.Codespace())
+	}
+
+	delegations, nextKey := k.GetValidatorDelegationsPage(ctx, params.ValidatorAddr, params.Page)
+	delResponses, err := delegationsToDelegationResponses(ctx, k, delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, DelegationsPageResponse{Delegations: delResponses, NextKey: nextKey})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryValidatorUnbondingDelegationsPage(ctx sdk.Context, cdc *codec.Codec, params *QueryValidatorPageParams, k keep.Keeper) ([]byte, sdk.Error) {
+	ubds, nextKey := k.GetValidatorUnbondingDelegationsPage(ctx, params.ValidatorAddr, params.Page)
+
+	res, errRes := codec.MarshalJSONIndent(cdc, UnbondingDelegationsPageResponse{UnbondingDelegations: ubds, NextKey: nextKey})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryDelegatorDelegationsPage(ctx sdk.Context, cdc *codec.Codec, params *QueryDelegatorPageParams, k keep.Keeper) ([]byte, sdk.Error) {
+	delegations, nextKey := k.GetDelegatorDelegationsPage(ctx, params.DelegatorAddr, params.Page)
+	delResponses, err := delegationsToDelegationResponses(ctx, k, delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, DelegationsPageResponse{Delegations: delResponses, NextKey: nextKey})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
 func prepareSideChainCtx(ctx sdk.Context, k keep.Keeper, sideChainId string) (sdk.Context, sdk.Error) {
 	scCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, sideChainId)
 	if err != nil {
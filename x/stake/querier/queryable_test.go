@@ -73,6 +73,14 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{"parameters"}, query)
 	require.Nil(t, err)
 
+	// the reward projection is only for the side chains
+	_, err = querier(ctx, []string{"sideChainRewardProjection"}, query)
+	require.NotNil(t, err)
+	_, err = querier(ctx, []string{"sideChainCommissionChanges"}, query)
+	require.NotNil(t, err)
+	_, err = querier(ctx, []string{"sideChainStakingReceipt"}, query)
+	require.NotNil(t, err)
+
 	queryValParams := newTestValidatorQuery(addrVal1)
 	bz, errRes := json.Marshal(queryValParams)
 	require.Nil(t, errRes)
@@ -415,3 +423,114 @@ func TestQueryRedelegations(t *testing.T) {
 
 	require.Equal(t, redelegation, redsRes[0])
 }
+
+func TestQueryValidatorSetHistory(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+
+	// a validator joins at each of the heights 100, 101 and 102
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockHeight(int64(100 + i))
+		pool := keeper.GetPool(ctx)
+		validator := types.NewValidator(sdk.ValAddress(keep.Addrs[i]), keep.PKs[i], types.Description{})
+		validator, pool, _ = validator.AddTokensFromDel(pool, sdk.NewDecWithoutFra(10).RawInt())
+		keeper.SetPool(ctx, pool)
+		keep.TestingUpdateValidator(keeper, ctx, validator)
+	}
+
+	queryHistory := func(params QueryValidatorSetHistoryParams) (ValidatorSetHistoryResponse, sdk.Error) {
+		var response ValidatorSetHistoryResponse
+		res, err := queryValidatorSetHistory(ctx, cdc, &params, keeper)
+		if err != nil {
+			return response, err
+		}
+		require.Nil(t, cdc.UnmarshalJSON(res, &response))
+		return response, nil
+	}
+
+	response, err := queryHistory(QueryValidatorSetHistoryParams{Limit: 2})
+	require.Nil(t, err)
+	require.Len(t, response.Changes, 2)
+	require.EqualValues(t, 102, response.NextHeight)
+
+	response, err = queryHistory(QueryValidatorSetHistoryParams{StartHeight: response.NextHeight, Limit: 2})
+	require.Nil(t, err)
+	require.Len(t, response.Changes, 1)
+	require.Equal(t, sdk.ValAddress(keep.Addrs[2]), response.Changes[0].ValidatorAddr)
+	require.EqualValues(t, 0, response.NextHeight)
+
+	response, err = queryHistory(QueryValidatorSetHistoryParams{ValidatorAddr: sdk.ValAddress(keep.Addrs[1]), EndHeight: 101})
+	require.Nil(t, err)
+	require.Len(t, response.Changes, 1)
+	require.EqualValues(t, 101, response.Changes[0].Height)
+	require.Equal(t, types.ValidatorJoined, response.Changes[0].Type)
+
+	_, err = queryHistory(QueryValidatorSetHistoryParams{StartHeight: 102, EndHeight: 101})
+	require.NotNil(t, err)
+}
+
+func TestQueryValidatorsPage(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	querier := NewQuerier(keeper, cdc)
+
+	amts := []int64{9, 8, 7}
+	for i, amt := range amts {
+		pool := keeper.GetPool(ctx)
+		validator := types.NewValidator(sdk.ValAddress(keep.Addrs[i]), keep.PKs[i], types.Description{})
+		validator, pool, _ = validator.AddTokensFromDel(pool, sdk.NewDecWithoutFra(amt).RawInt())
+		keeper.SetPool(ctx, pool)
+		validator = keep.TestingUpdateValidator(keeper, ctx, validator)
+		keeper.SetValidatorByConsAddr(ctx, validator)
+	}
+	keeper.Jail(ctx, sdk.ConsAddress(keep.PKs[2].Address()))
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	queryPage := func(params QueryValidatorsPageParams) ValidatorsPageResponse {
+		bz, errRes := json.Marshal(params)
+		require.Nil(t, errRes)
+		res, err := querier(ctx, []string{QueryValidatorsPage}, abci.RequestQuery{Data: bz})
+		require.Nil(t, err)
+		var response ValidatorsPageResponse
+		require.Nil(t, cdc.UnmarshalJSON(res, &response))
+		return response
+	}
+
+	// page through the validators in the order of the addresses
+	response := queryPage(QueryValidatorsPageParams{Page: sdk.PageRequest{Limit: 2}})
+	require.Len(t, response.Validators, 2)
+	require.NotEmpty(t, response.NextKey)
+	validators := response.Validators
+	response = queryPage(QueryValidatorsPageParams{Page: sdk.PageRequest{Key: response.NextKey, Limit: 2}})
+	require.Len(t, response.Validators, 1)
+	require.Empty(t, response.NextKey)
+	validators = append(validators, response.Validators...)
+	require.ElementsMatch(t, keeper.GetAllValidators(ctx), validators)
+
+	// the jailed validator is not in the power order
+	response = queryPage(QueryValidatorsPageParams{OrderByPower: true})
+	require.Len(t, response.Validators, 2)
+	require.Equal(t, sdk.ValAddress(keep.Addrs[0]), response.Validators[0].OperatorAddr)
+	response = queryPage(QueryValidatorsPageParams{Page: sdk.PageRequest{Limit: 1, Reverse: true}, OrderByPower: true})
+	require.Len(t, response.Validators, 1)
+	require.Equal(t, sdk.ValAddress(keep.Addrs[1]), response.Validators[0].OperatorAddr)
+
+	// filter the validators
+	response = queryPage(QueryValidatorsPageParams{Status: "Bonded"})
+	require.Len(t, response.Validators, 2)
+	jailed := true
+	response = queryPage(QueryValidatorsPageParams{Jailed: &jailed})
+	require.Len(t, response.Validators, 1)
+	require.Equal(t, sdk.ValAddress(keep.Addrs[2]), response.Validators[0].OperatorAddr)
+
+	bz, errRes := json.Marshal(QueryValidatorsPageParams{Status: "Active"})
+	require.Nil(t, errRes)
+	_, err := querier(ctx, []string{QueryValidatorsPage}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, err)
+
+	// the delegations are only indexed by the validators on the side chains
+	bz, errRes = json.Marshal(QueryValidatorPageParams{ValidatorAddr: addrVal1})
+	require.Nil(t, errRes)
+	_, err = querier(ctx, []string{QueryValidatorDelegationsPage}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, err)
+}
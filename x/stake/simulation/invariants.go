@@ -38,7 +38,6 @@ func SupplyInvariants(ck bank.Keeper, k stake.Keeper, d distribution.Keeper, am
 		pool := k.GetPool(ctx)
 
 		loose := sdk.ZeroDec()
-		bonded := sdk.ZeroDec()
 		am.IterateAccounts(ctx, func(acc sdk.Account) bool {
 			loose = loose.Add(sdk.NewDecFromInt(acc.GetCoins().AmountOf("steak")))
 			return false
@@ -49,8 +48,6 @@ func SupplyInvariants(ck bank.Keeper, k stake.Keeper, d distribution.Keeper, am
 		})
 		k.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
 			switch validator.GetStatus() {
-			case sdk.Bonded:
-				bonded = bonded.Add(validator.GetPower())
 			case sdk.Unbonding:
 				loose = loose.Add(validator.GetTokens())
 			case sdk.Unbonded:
@@ -80,11 +77,7 @@ func SupplyInvariants(ck bank.Keeper, k stake.Keeper, d distribution.Keeper, am
 		}
 
 		// Bonded tokens should equal sum of tokens with bonded validators
-		if !pool.BondedTokens.Equal(bonded) {
-			return fmt.Errorf("expected bonded tokens to equal total steak held by bonded validators - pool.BondedTokens: %v, sum of bonded validator tokens: %v", pool.BondedTokens, bonded)
-		}
-
-		return nil
+		return stake.BondedTokensInvariant(k)(ctx)
 	}
 }
 
@@ -92,15 +85,7 @@ func SupplyInvariants(ck bank.Keeper, k stake.Keeper, d distribution.Keeper, am
 func PositivePowerInvariant(k stake.Keeper) simulation.Invariant {
 	return func(app *baseapp.BaseApp) error {
 		ctx := app.NewContext(sdk.RunTxModeDeliver, abci.Header{})
-		var err error
-		k.IterateValidatorsBonded(ctx, func(_ int64, validator sdk.Validator) bool {
-			if !validator.GetPower().GT(sdk.ZeroDec()) {
-				err = fmt.Errorf("validator with non-positive power stored. (pubkey %v)", validator.GetConsPubKey())
-				return true
-			}
-			return false
-		})
-		return err
+		return stake.PositivePowerInvariant(k)(ctx)
 	}
 }
 
@@ -12,6 +12,8 @@ type (
 	Validator                  = types.Validator
 	Description                = types.Description
 	Commission                 = types.Commission
+	CommissionChange           = types.CommissionChange
+	SelfBondDeficiency         = types.SelfBondDeficiency
 	Delegation                 = types.Delegation
 	UnbondingDelegation        = types.UnbondingDelegation
 	Redelegation               = types.Redelegation
@@ -23,6 +25,7 @@ type (
 	MsgEditValidator           = types.MsgEditValidator
 	MsgDelegate                = types.MsgDelegate
 	MsgBeginUnbonding          = types.MsgBeginUnbonding
+	MsgCancelUnbonding         = types.MsgCancelUnbonding
 	MsgBeginRedelegate         = types.MsgBeginRedelegate
 	GenesisState               = types.GenesisState
 	QueryDelegatorParams       = querier.QueryDelegatorParams
@@ -32,11 +35,33 @@ type (
 	QueryTopValidatorsParams   = querier.QueryTopValidatorsParams
 	BaseParams                 = querier.BaseParams
 
-	MsgCreateSideChainValidator = types.MsgCreateSideChainValidator
-	MsgEditSideChainValidator   = types.MsgEditSideChainValidator
-	MsgSideChainDelegate        = types.MsgSideChainDelegate
-	MsgSideChainRedelegate      = types.MsgSideChainRedelegate
-	MsgSideChainUndelegate      = types.MsgSideChainUndelegate
+	QueryRewardProjectionParams    = querier.QueryRewardProjectionParams
+	RewardProjectionResponse       = querier.RewardProjectionResponse
+	QueryStakingReceiptParams      = querier.QueryStakingReceiptParams
+	StakingReceiptResponse         = querier.StakingReceiptResponse
+	QueryValidatorSetHistoryParams = querier.QueryValidatorSetHistoryParams
+	ValidatorSetHistoryResponse    = querier.ValidatorSetHistoryResponse
+	ValidatorSetChange             = types.ValidatorSetChange
+	ValidatorChangeType            = types.ValidatorChangeType
+	RewardProjection               = types.RewardProjection
+
+	QueryValidatorsPageParams        = querier.QueryValidatorsPageParams
+	QueryValidatorPageParams         = querier.QueryValidatorPageParams
+	QueryDelegatorPageParams         = querier.QueryDelegatorPageParams
+	ValidatorsPageResponse           = querier.ValidatorsPageResponse
+	DelegationsPageResponse          = querier.DelegationsPageResponse
+	UnbondingDelegationsPageResponse = querier.UnbondingDelegationsPageResponse
+
+	MsgCreateSideChainValidator  = types.MsgCreateSideChainValidator
+	MsgEditSideChainValidator    = types.MsgEditSideChainValidator
+	MsgSideChainDelegate         = types.MsgSideChainDelegate
+	MsgSideChainRedelegate       = types.MsgSideChainRedelegate
+	MsgSideChainUndelegate       = types.MsgSideChainUndelegate
+	MsgSideChainCancelUnbonding  = types.MsgSideChainCancelUnbonding
+	MsgSideChainSetAutoCompound  = types.MsgSideChainSetAutoCompound
+	MsgSideChainLiquidDelegate   = types.MsgSideChainLiquidDelegate
+	MsgSideChainLiquidUndelegate = types.MsgSideChainLiquidUndelegate
+	StakingReceipt               = types.StakingReceipt
 
 	SideDistributionEvent      = types.SideDistributionEvent
 	DistributionData           = types.DistributionData
@@ -64,7 +89,11 @@ type (
 )
 
 var (
-	NewKeeper = keeper.NewKeeper
+	NewKeeper          = keeper.NewKeeper
+	RegisterInvariants = keeper.RegisterInvariants
+
+	BondedTokensInvariant  = keeper.BondedTokensInvariant
+	PositivePowerInvariant = keeper.PositivePowerInvariant
 
 	GetValidatorKey              = keeper.GetValidatorKey
 	GetValidatorByConsAddrKey    = keeper.GetValidatorByConsAddrKey
@@ -117,6 +146,7 @@ var (
 	NewMsgEditValidator             = types.NewMsgEditValidator
 	NewMsgDelegate                  = types.NewMsgDelegate
 	NewMsgBeginUnbonding            = types.NewMsgBeginUnbonding
+	NewMsgCancelUnbonding           = types.NewMsgCancelUnbonding
 	NewMsgBeginRedelegate           = types.NewMsgBeginRedelegate
 
 	NewMsgCreateSideChainValidator           = types.NewMsgCreateSideChainValidator
@@ -125,11 +155,16 @@ var (
 	NewMsgSideChainDelegate                  = types.NewMsgSideChainDelegate
 	NewMsgSideChainRedelegate                = types.NewMsgSideChainRedelegate
 	NewMsgSideChainUndelegate                = types.NewMsgSideChainUndelegate
+	NewMsgSideChainCancelUnbonding           = types.NewMsgSideChainCancelUnbonding
+	NewMsgSideChainSetAutoCompound           = types.NewMsgSideChainSetAutoCompound
+	NewMsgSideChainLiquidDelegate            = types.NewMsgSideChainLiquidDelegate
+	NewMsgSideChainLiquidUndelegate          = types.NewMsgSideChainLiquidUndelegate
 
 	NewQuerier    = querier.NewQuerier
 	NewBaseParams = querier.NewBaseParams
 
-	DelegationAccAddr = keeper.DelegationAccAddr
+	DelegationAccAddr    = keeper.DelegationAccAddr
+	LiquidStakingAccAddr = keeper.LiquidStakingAccAddr
 )
 
 const (
@@ -147,6 +182,11 @@ const (
 	QueryPool                          = querier.QueryPool
 	QueryParameters                    = querier.QueryParameters
 
+	QueryValidatorsPage                    = querier.QueryValidatorsPage
+	QueryValidatorDelegationsPage          = querier.QueryValidatorDelegationsPage
+	QueryValidatorUnbondingDelegationsPage = querier.QueryValidatorUnbondingDelegationsPage
+	QueryDelegatorDelegationsPage          = querier.QueryDelegatorDelegationsPage
+
 	Topic = types.Topic
 )
 
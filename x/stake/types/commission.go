@@ -132,3 +132,19 @@ func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) sdk.Er
 
 	return nil
 }
+
+// CommissionChange is a commission rate change of a side chain validator announced ahead. It is applied in the breathe
+// block in which its RemainingBreatheBlocks counts down to zero.
+type CommissionChange struct {
+	ValidatorAddr          sdk.ValAddress `json:"validator_addr"`
+	Rate                   sdk.Dec        `json:"rate"`
+	AnnounceHeight         int64          `json:"announce_height"`
+	RemainingBreatheBlocks int64          `json:"remaining_breathe_blocks"`
+}
+
+// String implements the Stringer interface for a CommissionChange.
+func (c CommissionChange) String() string {
+	return fmt.Sprintf("validator: %s, rate: %s, announceHeight: %d, remainingBreatheBlocks: %d",
+		c.ValidatorAddr, c.Rate, c.AnnounceHeight, c.RemainingBreatheBlocks,
+	)
+}
@@ -174,6 +174,10 @@ func ErrExistingUnbondingDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "existing unbonding delegation found")
 }
 
+func ErrUnbondingDelegationMature(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "the unbonding delegation is already mature")
+}
+
 func ErrBadRedelegationAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unexpected address length for this (address, srcValidator, dstValidator) tuple")
 }
@@ -231,3 +235,7 @@ func ErrInvalidSideChainId(codespace sdk.CodespaceType) sdk.Error {
 func ErrInvalidCrosschainPackage(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidCrossChainPackage, "invalid cross chain package")
 }
+
+func ErrNoStakingReceipt(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "no staking receipt of the denomination on the side chain")
+}
@@ -9,13 +9,21 @@ const (
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
 
+	EventTypeScheduleCommissionChange = "schedule_commission_change"
+	EventTypeApplyCommissionChange    = "apply_commission_change"
+
+	EventTypeSelfBondWarning = "self_bond_warning"
+	EventTypeSelfBondJail    = "self_bond_jail"
+
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
+	AttributeKeySelfDelegation    = "self_delegation"
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyRemainingBlocks   = "remaining_breathe_blocks"
 
 	AttributeKeySideChainId = "side_chain_id"
 )
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorChangeType is the kind of a change to the validator set
+type ValidatorChangeType byte
+
+const (
+	ValidatorJoined            ValidatorChangeType = 0x01
+	ValidatorLeft              ValidatorChangeType = 0x02
+	ValidatorPowerChanged      ValidatorChangeType = 0x03
+	ValidatorJailed            ValidatorChangeType = 0x04
+	ValidatorUnjailed          ValidatorChangeType = 0x05
+	ValidatorCommissionChanged ValidatorChangeType = 0x06
+)
+
+// String implements the Stringer interface.
+func (t ValidatorChangeType) String() string {
+	switch t {
+	case ValidatorJoined:
+		return "Joined"
+	case ValidatorLeft:
+		return "Left"
+	case ValidatorPowerChanged:
+		return "PowerChanged"
+	case ValidatorJailed:
+		return "Jailed"
+	case ValidatorUnjailed:
+		return "Unjailed"
+	case ValidatorCommissionChanged:
+		return "CommissionChanged"
+	default:
+		return ""
+	}
+}
+
+// ValidatorChangeTypeFromString returns the change type of the name
+func ValidatorChangeTypeFromString(str string) (ValidatorChangeType, error) {
+	for t := ValidatorJoined; t <= ValidatorCommissionChanged; t++ {
+		if t.String() == str {
+			return t, nil
+		}
+	}
+	return ValidatorChangeType(0xff), fmt.Errorf("'%s' is not a valid validator change type", str)
+}
+
+// Marshals to JSON using string.
+func (t ValidatorChangeType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// Unmarshals from JSON using string.
+func (t *ValidatorChangeType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	bz, err := ValidatorChangeTypeFromString(s)
+	if err != nil {
+		return err
+	}
+	*t = bz
+	return nil
+}
+
+// ValidatorSetChange records a change of a validator in the validator set at a height. The powers are the bonded tokens
+// before and after the change, and Commission is the new rate of a commission change.
+type ValidatorSetChange struct {
+	Height        int64               `json:"height"`
+	Time          time.Time           `json:"time"`
+	ValidatorAddr sdk.ValAddress      `json:"validator_addr"`
+	Type          ValidatorChangeType `json:"type"`
+	PowerBefore   int64               `json:"power_before"`
+	PowerAfter    int64               `json:"power_after"`
+	Commission    sdk.Dec             `json:"commission"`
+}
+
+func (c ValidatorSetChange) String() string {
+	return fmt.Sprintf(`ValidatorSetChange:
+  Height:        %d
+  Time:          %v
+  Validator:     %s
+  Type:          %s
+  Power Before:  %d
+  Power After:   %d
+  Commission:    %s`, c.Height, c.Time, c.ValidatorAddr, c.Type, c.PowerBefore, c.PowerAfter, c.Commission)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReceiptDenomPrefix is the prefix of the denominations of the staking receipts, the receipt of a validator is
+// denominated as STK-000001 with the sequence the receipt is created in.
+const ReceiptDenomPrefix = "STK"
+
+// ReceiptDenom returns the denomination of the staking receipt of the sequence
+func ReceiptDenom(sequence int64) string {
+	return fmt.Sprintf("%s-%06X", ReceiptDenomPrefix, sequence)
+}
+
+// StakingReceipt is the receipt token of the liquid delegations to a side chain validator. The liquid delegations are
+// pooled in one delegation of the liquid staking account, and a receipt is a share of the pooled delegation.
+type StakingReceipt struct {
+	Denom          string         `json:"denom"`
+	SideChainId    string         `json:"side_chain_id"`
+	ValidatorAddr  sdk.ValAddress `json:"validator_addr"`
+	Supply         int64          `json:"supply"`          // the total amount of the receipts minted and not burnt
+	PendingRewards int64          `json:"pending_rewards"` // the rewards of the pooled delegation not delegated yet
+}
+
+func (r StakingReceipt) String() string {
+	return fmt.Sprintf("denom: %s, sideChainId: %s, validator: %s, supply: %d, pendingRewards: %d",
+		r.Denom, r.SideChainId, r.ValidatorAddr, r.Supply, r.PendingRewards,
+	)
+}
@@ -403,6 +403,57 @@ func (msg MsgBeginUnbonding) GetInvolvedAddresses() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.DelegatorAddr, sdk.AccAddress(msg.ValidatorAddr)}
 }
 
+//______________________________________________________________________
+
+// MsgCancelUnbonding - struct for moving some or all of a pending unbonding back to the delegation
+type MsgCancelUnbonding struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Amount        sdk.Coin       `json:"amount"`
+}
+
+func NewMsgCancelUnbonding(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgCancelUnbonding {
+	return MsgCancelUnbonding{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		Amount:        amount,
+	}
+}
+
+//nolint
+func (msg MsgCancelUnbonding) Route() string { return MsgRoute }
+func (msg MsgCancelUnbonding) Type() string  { return "cancel_unbonding" }
+func (msg MsgCancelUnbonding) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCancelUnbonding) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgCancelUnbonding) ValidateBasic() sdk.Error {
+	if len(msg.DelegatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.DelegatorAddr)))
+	}
+	if len(msg.ValidatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected validator address length is %d, actual length is %d", sdk.AddrLen, len(msg.ValidatorAddr)))
+	}
+	if msg.Amount.Amount <= 0 {
+		return ErrBadDelegationAmount(DefaultCodespace, "cancel unbonding amount must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCancelUnbonding) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr, sdk.AccAddress(msg.ValidatorAddr)}
+}
+
 type MsgRemoveValidator struct {
 	LauncherAddr sdk.AccAddress  `json:"launcher_addr"`
 	ValAddr      sdk.ValAddress  `json:"val_addr"`
@@ -9,11 +9,15 @@ import (
 )
 
 const (
-	MsgTypeCreateSideChainValidator = "side_create_validator"
-	MsgTypeEditSideChainValidator   = "side_edit_validator"
-	MsgTypeSideChainDelegate        = "side_delegate"
-	MsgTypeSideChainRedelegate      = "side_redelegate"
-	MsgTypeSideChainUndelegate      = "side_undelegate"
+	MsgTypeCreateSideChainValidator  = "side_create_validator"
+	MsgTypeEditSideChainValidator    = "side_edit_validator"
+	MsgTypeSideChainDelegate         = "side_delegate"
+	MsgTypeSideChainRedelegate       = "side_redelegate"
+	MsgTypeSideChainUndelegate       = "side_undelegate"
+	MsgTypeSideChainSetAutoCompound  = "side_set_auto_compound"
+	MsgTypeSideChainLiquidDelegate   = "side_liquid_delegate"
+	MsgTypeSideChainLiquidUndelegate = "side_liquid_undelegate"
+	MsgTypeSideChainCancelUnbonding  = "side_cancel_unbonding"
 )
 
 type SideChainIder interface {
@@ -367,3 +371,213 @@ func (msg MsgSideChainUndelegate) GetInvolvedAddresses() []sdk.AccAddress {
 func (msg MsgSideChainUndelegate) GetSideChainId() string {
 	return msg.SideChainId
 }
+
+//______________________________________________________________________
+// MsgSideChainCancelUnbonding moves some or all of a pending unbonding delegation back to the validator it was
+// unbonding from.
+type MsgSideChainCancelUnbonding struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Amount        sdk.Coin       `json:"amount"`
+	SideChainId   string         `json:"side_chain_id"`
+}
+
+func NewMsgSideChainCancelUnbonding(sideChainId string, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgSideChainCancelUnbonding {
+	return MsgSideChainCancelUnbonding{
+		DelegatorAddr: delegatorAddr,
+		ValidatorAddr: valAddr,
+		Amount:        amount,
+		SideChainId:   sideChainId,
+	}
+}
+
+//nolint
+func (msg MsgSideChainCancelUnbonding) Route() string { return MsgRoute }
+func (msg MsgSideChainCancelUnbonding) Type() string  { return MsgTypeSideChainCancelUnbonding }
+func (msg MsgSideChainCancelUnbonding) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgSideChainCancelUnbonding) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSideChainCancelUnbonding) ValidateBasic() sdk.Error {
+	if len(msg.DelegatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.DelegatorAddr)))
+	}
+	if len(msg.ValidatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected validator address length is %d, actual length is %d", sdk.AddrLen, len(msg.ValidatorAddr)))
+	}
+	if msg.Amount.Amount <= 0 {
+		return ErrBadDelegationAmount(DefaultCodespace, "cancel unbonding amount must be positive")
+	}
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "side chain id must be included and max length is 20 bytes")
+	}
+	return nil
+}
+
+func (msg MsgSideChainCancelUnbonding) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr, sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+func (msg MsgSideChainCancelUnbonding) GetSideChainId() string {
+	return msg.SideChainId
+}
+
+//______________________________________________________________________
+// MsgSideChainSetAutoCompound turns on or off the auto-compounding of the delegator's rewards on the side chain, the
+// rewards of each distribution batch are delegated to the same validators if it is on.
+type MsgSideChainSetAutoCompound struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	Enabled       bool           `json:"enabled"`
+	SideChainId   string         `json:"side_chain_id"`
+}
+
+func NewMsgSideChainSetAutoCompound(sideChainId string, delegatorAddr sdk.AccAddress, enabled bool) MsgSideChainSetAutoCompound {
+	return MsgSideChainSetAutoCompound{
+		DelegatorAddr: delegatorAddr,
+		Enabled:       enabled,
+		SideChainId:   sideChainId,
+	}
+}
+
+//nolint
+func (msg MsgSideChainSetAutoCompound) Route() string { return MsgRoute }
+func (msg MsgSideChainSetAutoCompound) Type() string  { return MsgTypeSideChainSetAutoCompound }
+func (msg MsgSideChainSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgSideChainSetAutoCompound) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSideChainSetAutoCompound) ValidateBasic() sdk.Error {
+	if len(msg.DelegatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.DelegatorAddr)))
+	}
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "side chain id must be included and max length is 20 bytes")
+	}
+	return nil
+}
+
+func (msg MsgSideChainSetAutoCompound) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+func (msg MsgSideChainSetAutoCompound) GetSideChainId() string {
+	return msg.SideChainId
+}
+
+//______________________________________________________________________
+// MsgSideChainLiquidDelegate delegates to the validator through the liquid staking account, the delegator gets the
+// transferable staking receipts of the validator instead of a delegation.
+type MsgSideChainLiquidDelegate struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Delegation    sdk.Coin       `json:"delegation"`
+	SideChainId   string         `json:"side_chain_id"`
+}
+
+func NewMsgSideChainLiquidDelegate(sideChainId string, delAddr sdk.AccAddress, valAddr sdk.ValAddress, delegation sdk.Coin) MsgSideChainLiquidDelegate {
+	return MsgSideChainLiquidDelegate{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		Delegation:    delegation,
+		SideChainId:   sideChainId,
+	}
+}
+
+//nolint
+func (msg MsgSideChainLiquidDelegate) Route() string { return MsgRoute }
+func (msg MsgSideChainLiquidDelegate) Type() string  { return MsgTypeSideChainLiquidDelegate }
+func (msg MsgSideChainLiquidDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgSideChainLiquidDelegate) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSideChainLiquidDelegate) ValidateBasic() sdk.Error {
+	if len(msg.DelegatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.DelegatorAddr)))
+	}
+	if len(msg.ValidatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected validator address length is %d, actual length is %d", sdk.AddrLen, len(msg.ValidatorAddr)))
+	}
+	if msg.Delegation.Amount <= 0 {
+		return ErrBadDelegationAmount(DefaultCodespace, "delegation amount must be positive")
+	}
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "side chain id must be included and max length is 20 bytes")
+	}
+	return nil
+}
+
+func (msg MsgSideChainLiquidDelegate) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr, sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+func (msg MsgSideChainLiquidDelegate) GetSideChainId() string {
+	return msg.SideChainId
+}
+
+//______________________________________________________________________
+// MsgSideChainLiquidUndelegate burns the staking receipts and undelegates the tokens they are worth to the delegator.
+type MsgSideChainLiquidUndelegate struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	Amount        sdk.Coin       `json:"amount"` // the staking receipts to burn
+	SideChainId   string         `json:"side_chain_id"`
+}
+
+func NewMsgSideChainLiquidUndelegate(sideChainId string, delAddr sdk.AccAddress, amount sdk.Coin) MsgSideChainLiquidUndelegate {
+	return MsgSideChainLiquidUndelegate{
+		DelegatorAddr: delAddr,
+		Amount:        amount,
+		SideChainId:   sideChainId,
+	}
+}
+
+//nolint
+func (msg MsgSideChainLiquidUndelegate) Route() string { return MsgRoute }
+func (msg MsgSideChainLiquidUndelegate) Type() string  { return MsgTypeSideChainLiquidUndelegate }
+func (msg MsgSideChainLiquidUndelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+func (msg MsgSideChainLiquidUndelegate) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSideChainLiquidUndelegate) ValidateBasic() sdk.Error {
+	if len(msg.DelegatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.DelegatorAddr)))
+	}
+	if msg.Amount.Amount <= 0 {
+		return ErrBadDelegationAmount(DefaultCodespace, "undelegation amount must be positive")
+	}
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "side chain id must be included and max length is 20 bytes")
+	}
+	return nil
+}
+
+func (msg MsgSideChainLiquidUndelegate) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+func (msg MsgSideChainLiquidUndelegate) GetSideChainId() string {
+	return msg.SideChainId
+}
@@ -206,6 +206,56 @@ func TestMsgBeginUnbonding(t *testing.T) {
 	}
 }
 
+func TestMsgCancelUnbonding(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(addr1), addr2, coinPos, true},
+		{"negative amount", sdk.AccAddress(addr1), addr2, coinNeg, false},
+		{"zero amount", sdk.AccAddress(addr1), addr2, coinZero, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), addr1, coinPos, false},
+		{"empty validator", sdk.AccAddress(addr1), emptyAddr, coinPos, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelUnbonding(tc.delegatorAddr, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgSideChainCancelUnbonding(t *testing.T) {
+	tests := []struct {
+		name          string
+		sideChainId   string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", "bsc", sdk.AccAddress(addr1), addr2, coinPos, true},
+		{"zero amount", "bsc", sdk.AccAddress(addr1), addr2, coinZero, false},
+		{"empty validator", "bsc", sdk.AccAddress(addr1), emptyAddr, coinPos, false},
+		{"empty side chain id", "", sdk.AccAddress(addr1), addr2, coinPos, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSideChainCancelUnbonding(tc.sideChainId, tc.delegatorAddr, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 func TestMsgSideChainDelegate_Type(t *testing.T) {
 	msg := NewMsgSideChainDelegate("aaa", sdk.AccAddress(addr1), addr2, coinPos)
 	bz, err := json.Marshal(msg)
@@ -216,3 +266,76 @@ func TestMsgSideChainDelegate_Type(t *testing.T) {
 	require.NoError(t, err)
 	t.Log(string(bz2))
 }
+
+func TestMsgSideChainSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		name          string
+		sideChainId   string
+		delegatorAddr sdk.AccAddress
+		expectPass    bool
+	}{
+		{"regular", "bsc", sdk.AccAddress(addr1), true},
+		{"empty delegator", "bsc", sdk.AccAddress(emptyAddr), false},
+		{"empty side chain id", "", sdk.AccAddress(addr1), false},
+		{"too long side chain id", "abcdefghijklmnopqrstu", sdk.AccAddress(addr1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSideChainSetAutoCompound(tc.sideChainId, tc.delegatorAddr, true)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgSideChainLiquidDelegate(t *testing.T) {
+	tests := []struct {
+		name          string
+		sideChainId   string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		bond          sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", "bsc", sdk.AccAddress(addr1), addr2, coinPos, true},
+		{"empty delegator", "bsc", sdk.AccAddress(emptyAddr), addr2, coinPos, false},
+		{"empty validator", "bsc", sdk.AccAddress(addr1), emptyAddr, coinPos, false},
+		{"zero amount", "bsc", sdk.AccAddress(addr1), addr2, coinZero, false},
+		{"empty side chain id", "", sdk.AccAddress(addr1), addr2, coinPos, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSideChainLiquidDelegate(tc.sideChainId, tc.delegatorAddr, tc.validatorAddr, tc.bond)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgSideChainLiquidUndelegate(t *testing.T) {
+	tests := []struct {
+		name          string
+		sideChainId   string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", "bsc", sdk.AccAddress(addr1), sdk.NewCoin(ReceiptDenom(1), 1000), true},
+		{"empty delegator", "bsc", sdk.AccAddress(emptyAddr), sdk.NewCoin(ReceiptDenom(1), 1000), false},
+		{"zero amount", "bsc", sdk.AccAddress(addr1), sdk.NewCoin(ReceiptDenom(1), 0), false},
+		{"too long side chain id", "abcdefghijklmnopqrstu", sdk.AccAddress(addr1), sdk.NewCoin(ReceiptDenom(1), 1000), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSideChainLiquidUndelegate(tc.sideChainId, tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -30,6 +30,17 @@ const (
 
 	// defaultRewardDistributionBatchSize represents the default batch size for distributing delegators' staking rewards in blocks
 	defaultRewardDistributionBatchSize = 1000
+
+	// DefaultCommissionChangeDelay represents the default number of breathe blocks between announcing a commission
+	// change of a side chain validator and applying it
+	DefaultCommissionChangeDelay int64 = 2
+
+	// DefaultValidatorHistoryRetention represents the default time the validator set changes are kept for
+	DefaultValidatorHistoryRetention time.Duration = 60 * 60 * 24 * 90 * time.Second
+
+	// DefaultSelfBondGracePeriod represents the default number of breathe blocks a validator can stay below the
+	// MinSelfDelegation before it is jailed
+	DefaultSelfBondGracePeriod int64 = 3
 )
 
 // nolint - Keys for parameter access
@@ -40,6 +51,9 @@ var (
 	KeyMinSelfDelegation           = []byte("MinSelfDelegation")
 	KeyMinDelegationChange         = []byte("MinDelegationChanged")
 	KeyRewardDistributionBatchSize = []byte("RewardDistributionBatchSize")
+	KeyCommissionChangeDelay       = []byte("CommissionChangeDelay")
+	KeyValidatorHistoryRetention   = []byte("ValidatorHistoryRetention")
+	KeySelfBondGracePeriod         = []byte("SelfBondGracePeriod")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -53,6 +67,10 @@ type Params struct {
 	MinSelfDelegation           int64  `json:"min_self_delegation"`            // the minimal self-delegation amount
 	MinDelegationChange         int64  `json:"min_delegation_change"`          // the minimal delegation amount changed
 	RewardDistributionBatchSize int64  `json:"reward_distribution_batch_size"` // the batch size for distributing rewards in blocks
+	CommissionChangeDelay       int64  `json:"commission_change_delay"`        // the breathe blocks between announcing and applying a commission change
+
+	ValidatorHistoryRetention time.Duration `json:"validator_history_retention"` // the time the validator set changes are kept for, 0 keeps them forever
+	SelfBondGracePeriod       int64         `json:"self_bond_grace_period"`      // the breathe blocks a validator can stay below the min self delegation
 }
 
 func (p *Params) GetParamAttribute() (string, bool) {
@@ -84,6 +102,19 @@ func (p *Params) UpdateCheck() error {
 		return fmt.Errorf("the reward_distribution_batch_size should be in range 1000 to 5000")
 	}
 
+	if types.IsUpgrade(types.SideChainCommissionSchedule) && (p.CommissionChangeDelay < 1 || p.CommissionChangeDelay > 30) {
+		return fmt.Errorf("the commission_change_delay should be in range 1 to 30")
+	}
+
+	if types.IsUpgrade(types.ValidatorSetHistory) && p.ValidatorHistoryRetention != 0 &&
+		(p.ValidatorHistoryRetention < 24*time.Hour || p.ValidatorHistoryRetention > 3650*24*time.Hour) {
+		return fmt.Errorf("the validator_history_retention should be 0 or in range 1 day to 3650 days")
+	}
+
+	if types.IsUpgrade(types.SelfBondEnforcement) && (p.SelfBondGracePeriod < 1 || p.SelfBondGracePeriod > 30) {
+		return fmt.Errorf("the self_bond_grace_period should be in range 1 to 30")
+	}
+
 	return nil
 }
 
@@ -96,6 +127,9 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeyMinSelfDelegation, &p.MinSelfDelegation},
 		{KeyMinDelegationChange, &p.MinDelegationChange},
 		{KeyRewardDistributionBatchSize, &p.RewardDistributionBatchSize},
+		{KeyCommissionChangeDelay, &p.CommissionChangeDelay},
+		{KeyValidatorHistoryRetention, &p.ValidatorHistoryRetention},
+		{KeySelfBondGracePeriod, &p.SelfBondGracePeriod},
 	}
 }
 
@@ -115,6 +149,9 @@ func DefaultParams() Params {
 		MinSelfDelegation:           defaultMinSelfDelegation,
 		MinDelegationChange:         defaultMinDelegationChange,
 		RewardDistributionBatchSize: defaultRewardDistributionBatchSize,
+		CommissionChangeDelay:       DefaultCommissionChangeDelay,
+		ValidatorHistoryRetention:   DefaultValidatorHistoryRetention,
+		SelfBondGracePeriod:         DefaultSelfBondGracePeriod,
 	}
 }
 
@@ -129,6 +166,9 @@ func (p Params) HumanReadableString() string {
 	resp += fmt.Sprintf("Minimal self-delegation amount: %d\n", p.MinSelfDelegation)
 	resp += fmt.Sprintf("The minimum value allowed to change the delegation amount: %d\n", p.MinDelegationChange)
 	resp += fmt.Sprintf("The batch size to distribute staking rewards: %d\n", p.RewardDistributionBatchSize)
+	resp += fmt.Sprintf("The breathe blocks to apply a commission change after its announcement: %d\n", p.CommissionChangeDelay)
+	resp += fmt.Sprintf("The time to keep the validator set changes for: %s\n", p.ValidatorHistoryRetention)
+	resp += fmt.Sprintf("The breathe blocks to jail a validator after its self delegation drops below the minimum: %d\n", p.SelfBondGracePeriod)
 	return resp
 }
 
@@ -53,3 +53,15 @@ func MustUnmarshalValDistAddrs(cdc *codec.Codec, value []byte) (valDistAddrs []S
 	}
 	return valDistAddrs
 }
+
+// RewardProjection is the projected distribution of the rewards collected by a validator in the next breathe block
+type RewardProjection struct {
+	Validator      sdk.ValAddress `json:"validator"`
+	DistributeAddr sdk.AccAddress `json:"distribute_addr"`
+	FeeAddr        sdk.AccAddress `json:"fee_addr"`
+	TotalReward    int64          `json:"total_reward"`
+	Commission     int64          `json:"commission"`
+	// Residue is the reward left in the distribution address for the rounding
+	Residue int64    `json:"residue"`
+	Rewards []Reward `json:"rewards"`
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SelfBondDeficiency is a validator whose self delegation is below the MinSelfDelegation. The validator is jailed in
+// the breathe block in which its RemainingBreatheBlocks counts down to zero, unless the self delegation is restored.
+type SelfBondDeficiency struct {
+	ValidatorAddr          sdk.ValAddress `json:"validator_addr"`
+	SelfDelegation         int64          `json:"self_delegation"`
+	MinSelfDelegation      int64          `json:"min_self_delegation"`
+	StartHeight            int64          `json:"start_height"`
+	RemainingBreatheBlocks int64          `json:"remaining_breathe_blocks"`
+}
+
+// String implements the Stringer interface for a SelfBondDeficiency.
+func (d SelfBondDeficiency) String() string {
+	return fmt.Sprintf("validator: %s, selfDelegation: %d, minSelfDelegation: %d, startHeight: %d, remainingBreatheBlocks: %d",
+		d.ValidatorAddr, d.SelfDelegation, d.MinSelfDelegation, d.StartHeight, d.RemainingBreatheBlocks,
+	)
+}